@@ -29,7 +29,7 @@ func NewInfraClient(sessionContext utl.SessionContext, connector vapiProtocolCli
 	default:
 		return nil
 	}
-	return &InfraClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &InfraClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c InfraClientContext) Get(basePathParam *string, filterParam *string, typeFilterParam *string) (model0.Infra, error) {
@@ -47,7 +47,7 @@ func (c InfraClientContext) Get(basePathParam *string, filterParam *string, type
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.InfraClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, basePathParam, filterParam, typeFilterParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, basePathParam, filterParam, typeFilterParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c InfraClientContext) Patch(infraParam model0.Infra, enforceRevisionCheckP
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.InfraClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, infraParam, enforceRevisionCheckParam)
+		err = client.Patch(c.OrgID, c.ProjectID, infraParam, enforceRevisionCheckParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
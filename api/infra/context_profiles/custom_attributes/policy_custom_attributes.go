@@ -34,7 +34,7 @@ func NewDefaultClient(sessionContext utl.SessionContext, connector vapiProtocolC
 	default:
 		return nil
 	}
-	return &PolicyCustomAttributesClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &PolicyCustomAttributesClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c PolicyCustomAttributesClientContext) Create(policyCustomAttributesParam model0.PolicyCustomAttributes, actionParam string) error {
@@ -56,7 +56,7 @@ func (c PolicyCustomAttributesClientContext) Create(policyCustomAttributesParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DefaultClient)
-		err = client.Create(utl.DefaultOrgID, c.ProjectID, policyCustomAttributesParam, actionParam)
+		err = client.Create(c.OrgID, c.ProjectID, policyCustomAttributesParam, actionParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -88,7 +88,7 @@ func (c PolicyCustomAttributesClientContext) List(attributeKeyParam *string, att
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DefaultClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, attributeKeyParam, attributeSourceParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, attributeKeyParam, attributeSourceParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -115,7 +115,7 @@ func (c PolicyCustomAttributesClientContext) Patch(policyCustomAttributesParam m
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DefaultClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, policyCustomAttributesParam)
+		err = client.Patch(c.OrgID, c.ProjectID, policyCustomAttributesParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
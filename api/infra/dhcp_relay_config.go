@@ -34,7 +34,7 @@ func NewDhcpRelayConfigsClient(sessionContext utl.SessionContext, connector vapi
 	default:
 		return nil
 	}
-	return &DhcpRelayConfigClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &DhcpRelayConfigClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c DhcpRelayConfigClientContext) Get(dhcpRelayConfigIdParam string) (model0.DhcpRelayConfig, error) {
@@ -62,7 +62,7 @@ func (c DhcpRelayConfigClientContext) Get(dhcpRelayConfigIdParam string) (model0
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpRelayConfigsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, dhcpRelayConfigIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, dhcpRelayConfigIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c DhcpRelayConfigClientContext) Patch(dhcpRelayConfigIdParam string, dhcpR
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpRelayConfigsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, dhcpRelayConfigIdParam, dhcpRelayConfigParam)
+		err = client.Patch(c.OrgID, c.ProjectID, dhcpRelayConfigIdParam, dhcpRelayConfigParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c DhcpRelayConfigClientContext) Update(dhcpRelayConfigIdParam string, dhcp
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpRelayConfigsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, dhcpRelayConfigIdParam, dhcpRelayConfigParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, dhcpRelayConfigIdParam, dhcpRelayConfigParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c DhcpRelayConfigClientContext) Delete(dhcpRelayConfigIdParam string) erro
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpRelayConfigsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, dhcpRelayConfigIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, dhcpRelayConfigIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c DhcpRelayConfigClientContext) List(cursorParam *string, includeMarkForDe
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpRelayConfigsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
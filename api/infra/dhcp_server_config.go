@@ -34,7 +34,7 @@ func NewDhcpServerConfigsClient(sessionContext utl.SessionContext, connector vap
 	default:
 		return nil
 	}
-	return &DhcpServerConfigClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &DhcpServerConfigClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c DhcpServerConfigClientContext) Get(dhcpServerConfigIdParam string) (model0.DhcpServerConfig, error) {
@@ -62,7 +62,7 @@ func (c DhcpServerConfigClientContext) Get(dhcpServerConfigIdParam string) (mode
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpServerConfigsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, dhcpServerConfigIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, dhcpServerConfigIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c DhcpServerConfigClientContext) Patch(dhcpServerConfigIdParam string, dhc
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpServerConfigsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, dhcpServerConfigIdParam, dhcpServerConfigParam)
+		err = client.Patch(c.OrgID, c.ProjectID, dhcpServerConfigIdParam, dhcpServerConfigParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c DhcpServerConfigClientContext) Update(dhcpServerConfigIdParam string, dh
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpServerConfigsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, dhcpServerConfigIdParam, dhcpServerConfigParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, dhcpServerConfigIdParam, dhcpServerConfigParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c DhcpServerConfigClientContext) Delete(dhcpServerConfigIdParam string) er
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpServerConfigsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, dhcpServerConfigIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, dhcpServerConfigIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c DhcpServerConfigClientContext) List(cursorParam *string, includeMarkForD
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpServerConfigsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
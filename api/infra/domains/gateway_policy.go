@@ -34,7 +34,7 @@ func NewGatewayPoliciesClient(sessionContext utl.SessionContext, connector vapiP
 	default:
 		return nil
 	}
-	return &GatewayPolicyClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &GatewayPolicyClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c GatewayPolicyClientContext) Get(domainIdParam string, gatewayPolicyIdParam string) (model0.GatewayPolicy, error) {
@@ -62,7 +62,7 @@ func (c GatewayPolicyClientContext) Get(domainIdParam string, gatewayPolicyIdPar
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayPoliciesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c GatewayPolicyClientContext) Patch(domainIdParam string, gatewayPolicyIdP
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayPoliciesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam, gatewayPolicyParam)
+		err = client.Patch(c.OrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam, gatewayPolicyParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c GatewayPolicyClientContext) Update(domainIdParam string, gatewayPolicyId
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayPoliciesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam, gatewayPolicyParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam, gatewayPolicyParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c GatewayPolicyClientContext) Delete(domainIdParam string, gatewayPolicyId
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayPoliciesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, domainIdParam, gatewayPolicyIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c GatewayPolicyClientContext) List(domainIdParam string, cursorParam *stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayPoliciesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, domainIdParam, cursorParam, includeMarkForDeleteObjectsParam, includeRuleCountParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, domainIdParam, cursorParam, includeMarkForDeleteObjectsParam, includeRuleCountParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewGroupsClient(sessionContext utl.SessionContext, connector vapiProtocolCl
 	default:
 		return nil
 	}
-	return &GroupClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &GroupClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c GroupClientContext) Get(domainIdParam string, groupIdParam string) (model0.Group, error) {
@@ -62,7 +62,7 @@ func (c GroupClientContext) Get(domainIdParam string, groupIdParam string) (mode
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GroupsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, domainIdParam, groupIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c GroupClientContext) Patch(domainIdParam string, groupIdParam string, gro
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GroupsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, groupParam)
+		err = client.Patch(c.OrgID, c.ProjectID, domainIdParam, groupIdParam, groupParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c GroupClientContext) Update(domainIdParam string, groupIdParam string, gr
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GroupsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, groupParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, domainIdParam, groupIdParam, groupParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c GroupClientContext) Delete(domainIdParam string, groupIdParam string, fa
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GroupsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, failIfSubtreeExistsParam, forceParam)
+		err = client.Delete(c.OrgID, c.ProjectID, domainIdParam, groupIdParam, failIfSubtreeExistsParam, forceParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c GroupClientContext) List(domainIdParam string, cursorParam *string, incl
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GroupsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, domainIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, memberTypesParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, domainIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, memberTypesParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
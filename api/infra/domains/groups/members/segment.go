@@ -0,0 +1,70 @@
+//nolint:revive
+package members
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/groups/members"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups/members"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	client2 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/domains/groups/members"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type SegmentClientContext utl.ClientContext
+
+func NewSegmentsClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *SegmentClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewSegmentsClient(connector)
+
+	case utl.Global:
+		client = client1.NewSegmentsClient(connector)
+
+	case utl.Multitenancy:
+		client = client2.NewSegmentsClient(connector)
+
+	default:
+		return nil
+	}
+	return &SegmentClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
+}
+
+func (c SegmentClientContext) List(domainIdParam string, groupIdParam string, cursorParam *string, enforcementPointPathParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.PolicyGroupMembersListResult, error) {
+	var err error
+	var obj model0.PolicyGroupMembersListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.SegmentsClient)
+		obj, err = client.List(domainIdParam, groupIdParam, cursorParam, enforcementPointPathParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Global:
+		client := c.Client.(client1.SegmentsClient)
+		gmObj, err := client.List(domainIdParam, groupIdParam, cursorParam, enforcementPointPathParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.PolicyGroupMembersListResultBindingType(), model0.PolicyGroupMembersListResultBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.PolicyGroupMembersListResult)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.SegmentsClient)
+		obj, err = client.List(c.OrgID, c.ProjectID, domainIdParam, groupIdParam, cursorParam, enforcementPointPathParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
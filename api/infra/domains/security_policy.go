@@ -34,7 +34,7 @@ func NewSecurityPoliciesClient(sessionContext utl.SessionContext, connector vapi
 	default:
 		return nil
 	}
-	return &SecurityPolicyClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SecurityPolicyClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SecurityPolicyClientContext) Get(domainIdParam string, securityPolicyIdParam string) (model0.SecurityPolicy, error) {
@@ -62,7 +62,7 @@ func (c SecurityPolicyClientContext) Get(domainIdParam string, securityPolicyIdP
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SecurityPoliciesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, domainIdParam, securityPolicyIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c SecurityPolicyClientContext) Patch(domainIdParam string, securityPolicyI
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SecurityPoliciesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, securityPolicyParam)
+		err = client.Patch(c.OrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, securityPolicyParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c SecurityPolicyClientContext) Update(domainIdParam string, securityPolicy
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SecurityPoliciesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, securityPolicyParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, securityPolicyParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c SecurityPolicyClientContext) Delete(domainIdParam string, securityPolicy
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SecurityPoliciesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, domainIdParam, securityPolicyIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c SecurityPolicyClientContext) List(domainIdParam string, cursorParam *str
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SecurityPoliciesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, domainIdParam, cursorParam, includeMarkForDeleteObjectsParam, includeRuleCountParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, domainIdParam, cursorParam, includeMarkForDeleteObjectsParam, includeRuleCountParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
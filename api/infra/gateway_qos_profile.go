@@ -34,7 +34,7 @@ func NewGatewayQosProfilesClient(sessionContext utl.SessionContext, connector va
 	default:
 		return nil
 	}
-	return &GatewayQosProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &GatewayQosProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c GatewayQosProfileClientContext) Get(qosProfileIdParam string) (model0.GatewayQosProfile, error) {
@@ -62,7 +62,7 @@ func (c GatewayQosProfileClientContext) Get(qosProfileIdParam string) (model0.Ga
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayQosProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, qosProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c GatewayQosProfileClientContext) Patch(qosProfileIdParam string, gatewayQ
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayQosProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam, gatewayQosProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, qosProfileIdParam, gatewayQosProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c GatewayQosProfileClientContext) Update(qosProfileIdParam string, gateway
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayQosProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam, gatewayQosProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, qosProfileIdParam, gatewayQosProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c GatewayQosProfileClientContext) Delete(qosProfileIdParam string, overrid
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayQosProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, qosProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c GatewayQosProfileClientContext) List(cursorParam *string, includeMarkFor
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.GatewayQosProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -29,7 +29,7 @@ func NewIpBlocksClient(sessionContext utl.SessionContext, connector vapiProtocol
 	default:
 		return nil
 	}
-	return &IpAddressBlockClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &IpAddressBlockClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c IpAddressBlockClientContext) Get(ipBlockIdParam string) (model0.IpAddressBlock, error) {
@@ -47,7 +47,7 @@ func (c IpAddressBlockClientContext) Get(ipBlockIdParam string) (model0.IpAddres
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpBlocksClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, ipBlockIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, ipBlockIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c IpAddressBlockClientContext) Patch(ipBlockIdParam string, ipAddressBlock
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpBlocksClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, ipBlockIdParam, ipAddressBlockParam)
+		err = client.Patch(c.OrgID, c.ProjectID, ipBlockIdParam, ipAddressBlockParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -89,7 +89,7 @@ func (c IpAddressBlockClientContext) Update(ipBlockIdParam string, ipAddressBloc
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpBlocksClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, ipBlockIdParam, ipAddressBlockParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, ipBlockIdParam, ipAddressBlockParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -108,7 +108,7 @@ func (c IpAddressBlockClientContext) Delete(ipBlockIdParam string) error {
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpBlocksClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, ipBlockIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, ipBlockIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c IpAddressBlockClientContext) List(cursorParam *string, includeMarkForDel
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpBlocksClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
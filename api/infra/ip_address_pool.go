@@ -29,7 +29,7 @@ func NewIpPoolsClient(sessionContext utl.SessionContext, connector vapiProtocolC
 	default:
 		return nil
 	}
-	return &IpAddressPoolClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &IpAddressPoolClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c IpAddressPoolClientContext) Get(ipPoolIdParam string) (model0.IpAddressPool, error) {
@@ -47,7 +47,7 @@ func (c IpAddressPoolClientContext) Get(ipPoolIdParam string) (model0.IpAddressP
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpPoolsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, ipPoolIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c IpAddressPoolClientContext) Patch(ipPoolIdParam string, ipAddressPoolPar
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpPoolsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipAddressPoolParam)
+		err = client.Patch(c.OrgID, c.ProjectID, ipPoolIdParam, ipAddressPoolParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -89,7 +89,7 @@ func (c IpAddressPoolClientContext) Update(ipPoolIdParam string, ipAddressPoolPa
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpPoolsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipAddressPoolParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, ipPoolIdParam, ipAddressPoolParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -108,7 +108,7 @@ func (c IpAddressPoolClientContext) Delete(ipPoolIdParam string) error {
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpPoolsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, ipPoolIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c IpAddressPoolClientContext) List(cursorParam *string, includeMarkForDele
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpPoolsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
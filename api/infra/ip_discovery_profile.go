@@ -34,7 +34,7 @@ func NewIpDiscoveryProfilesClient(sessionContext utl.SessionContext, connector v
 	default:
 		return nil
 	}
-	return &IPDiscoveryProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &IPDiscoveryProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c IPDiscoveryProfileClientContext) Get(ipDiscoveryProfileIdParam string) (model0.IPDiscoveryProfile, error) {
@@ -62,7 +62,7 @@ func (c IPDiscoveryProfileClientContext) Get(ipDiscoveryProfileIdParam string) (
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.IpDiscoveryProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, ipDiscoveryProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, ipDiscoveryProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c IPDiscoveryProfileClientContext) Patch(ipDiscoveryProfileIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.IpDiscoveryProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, ipDiscoveryProfileIdParam, ipDiscoveryProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, ipDiscoveryProfileIdParam, ipDiscoveryProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c IPDiscoveryProfileClientContext) Update(ipDiscoveryProfileIdParam string
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.IpDiscoveryProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, ipDiscoveryProfileIdParam, ipDiscoveryProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, ipDiscoveryProfileIdParam, ipDiscoveryProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c IPDiscoveryProfileClientContext) Delete(ipDiscoveryProfileIdParam string
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.IpDiscoveryProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, ipDiscoveryProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, ipDiscoveryProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c IPDiscoveryProfileClientContext) List(cursorParam *string, includeMarkFo
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.IpDiscoveryProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -29,7 +29,7 @@ func NewIpAllocationsClient(sessionContext utl.SessionContext, connector vapiPro
 	default:
 		return nil
 	}
-	return &IpAddressAllocationClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &IpAddressAllocationClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c IpAddressAllocationClientContext) Get(ipPoolIdParam string, ipAllocationIdParam string) (model0.IpAddressAllocation, error) {
@@ -47,7 +47,7 @@ func (c IpAddressAllocationClientContext) Get(ipPoolIdParam string, ipAllocation
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpAllocationsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c IpAddressAllocationClientContext) Patch(ipPoolIdParam string, ipAllocati
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpAllocationsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam, ipAddressAllocationParam)
+		err = client.Patch(c.OrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam, ipAddressAllocationParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -89,7 +89,7 @@ func (c IpAddressAllocationClientContext) Update(ipPoolIdParam string, ipAllocat
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpAllocationsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam, ipAddressAllocationParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam, ipAddressAllocationParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -108,7 +108,7 @@ func (c IpAddressAllocationClientContext) Delete(ipPoolIdParam string, ipAllocat
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpAllocationsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, ipPoolIdParam, ipAllocationIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c IpAddressAllocationClientContext) List(ipPoolIdParam string, cursorParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpAllocationsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, ipPoolIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
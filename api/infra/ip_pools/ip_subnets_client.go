@@ -30,7 +30,7 @@ func NewIpSubnetsClient(sessionContext utl.SessionContext, connector vapiProtoco
 	default:
 		return nil
 	}
-	return &StructValueClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &StructValueClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c StructValueClientContext) Get(ipPoolIdParam string, ipSubnetIdParam string) (*model0.StructValue, error) {
@@ -48,7 +48,7 @@ func (c StructValueClientContext) Get(ipPoolIdParam string, ipSubnetIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpSubnetsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -70,7 +70,7 @@ func (c StructValueClientContext) Delete(ipPoolIdParam string, ipSubnetIdParam s
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpSubnetsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -89,7 +89,7 @@ func (c StructValueClientContext) Patch(ipPoolIdParam string, ipSubnetIdParam st
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpSubnetsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam, ipAddressPoolSubnetParam)
+		err = client.Patch(c.OrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam, ipAddressPoolSubnetParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -109,7 +109,7 @@ func (c StructValueClientContext) Update(ipPoolIdParam string, ipSubnetIdParam s
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpSubnetsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam, ipAddressPoolSubnetParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, ipPoolIdParam, ipSubnetIdParam, ipAddressPoolSubnetParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -129,7 +129,7 @@ func (c StructValueClientContext) List(ipPoolIdParam string, cursorParam *string
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.IpSubnetsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, ipPoolIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, ipPoolIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewIpv6DadProfilesClient(sessionContext utl.SessionContext, connector vapiP
 	default:
 		return nil
 	}
-	return &Ipv6DadProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &Ipv6DadProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c Ipv6DadProfileClientContext) Get(dadProfileIdParam string) (model0.Ipv6DadProfile, error) {
@@ -62,7 +62,7 @@ func (c Ipv6DadProfileClientContext) Get(dadProfileIdParam string) (model0.Ipv6D
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6DadProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, dadProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, dadProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c Ipv6DadProfileClientContext) Patch(dadProfileIdParam string, ipv6DadProf
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6DadProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, dadProfileIdParam, ipv6DadProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, dadProfileIdParam, ipv6DadProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c Ipv6DadProfileClientContext) Update(dadProfileIdParam string, ipv6DadPro
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6DadProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, dadProfileIdParam, ipv6DadProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, dadProfileIdParam, ipv6DadProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c Ipv6DadProfileClientContext) Delete(dadProfileIdParam string, overridePa
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6DadProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, dadProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, dadProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c Ipv6DadProfileClientContext) List(cursorParam *string, includeMarkForDel
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6DadProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewIpv6NdraProfilesClient(sessionContext utl.SessionContext, connector vapi
 	default:
 		return nil
 	}
-	return &Ipv6NdraProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &Ipv6NdraProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c Ipv6NdraProfileClientContext) Get(ndraProfileIdParam string) (model0.Ipv6NdraProfile, error) {
@@ -62,7 +62,7 @@ func (c Ipv6NdraProfileClientContext) Get(ndraProfileIdParam string) (model0.Ipv
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6NdraProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, ndraProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, ndraProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c Ipv6NdraProfileClientContext) Patch(ndraProfileIdParam string, ipv6NdraP
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6NdraProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, ndraProfileIdParam, ipv6NdraProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, ndraProfileIdParam, ipv6NdraProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c Ipv6NdraProfileClientContext) Update(ndraProfileIdParam string, ipv6Ndra
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6NdraProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, ndraProfileIdParam, ipv6NdraProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, ndraProfileIdParam, ipv6NdraProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c Ipv6NdraProfileClientContext) Delete(ndraProfileIdParam string, override
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6NdraProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, ndraProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, ndraProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c Ipv6NdraProfileClientContext) List(cursorParam *string, includeMarkForDe
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Ipv6NdraProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
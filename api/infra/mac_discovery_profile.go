@@ -34,7 +34,7 @@ func NewMacDiscoveryProfilesClient(sessionContext utl.SessionContext, connector
 	default:
 		return nil
 	}
-	return &MacDiscoveryProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &MacDiscoveryProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c MacDiscoveryProfileClientContext) Get(macDiscoveryProfileIdParam string) (model0.MacDiscoveryProfile, error) {
@@ -62,7 +62,7 @@ func (c MacDiscoveryProfileClientContext) Get(macDiscoveryProfileIdParam string)
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.MacDiscoveryProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, macDiscoveryProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, macDiscoveryProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c MacDiscoveryProfileClientContext) Patch(macDiscoveryProfileIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.MacDiscoveryProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, macDiscoveryProfileIdParam, macDiscoveryProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, macDiscoveryProfileIdParam, macDiscoveryProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c MacDiscoveryProfileClientContext) Update(macDiscoveryProfileIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.MacDiscoveryProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, macDiscoveryProfileIdParam, macDiscoveryProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, macDiscoveryProfileIdParam, macDiscoveryProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c MacDiscoveryProfileClientContext) Delete(macDiscoveryProfileIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.MacDiscoveryProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, macDiscoveryProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, macDiscoveryProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c MacDiscoveryProfileClientContext) List(cursorParam *string, includeMarkF
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.MacDiscoveryProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewContextProfilesClient(sessionContext utl.SessionContext, connector vapiP
 	default:
 		return nil
 	}
-	return &PolicyContextProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &PolicyContextProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c PolicyContextProfileClientContext) Get(contextProfileIdParam string) (model0.PolicyContextProfile, error) {
@@ -62,7 +62,7 @@ func (c PolicyContextProfileClientContext) Get(contextProfileIdParam string) (mo
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ContextProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, contextProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, contextProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c PolicyContextProfileClientContext) Patch(contextProfileIdParam string, p
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ContextProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, contextProfileIdParam, policyContextProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, contextProfileIdParam, policyContextProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c PolicyContextProfileClientContext) Update(contextProfileIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ContextProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, contextProfileIdParam, policyContextProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, contextProfileIdParam, policyContextProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c PolicyContextProfileClientContext) Delete(contextProfileIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ContextProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, contextProfileIdParam, forceParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, contextProfileIdParam, forceParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c PolicyContextProfileClientContext) List(cursorParam *string, includeMark
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ContextProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
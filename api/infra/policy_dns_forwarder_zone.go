@@ -34,7 +34,7 @@ func NewDnsForwarderZonesClient(sessionContext utl.SessionContext, connector vap
 	default:
 		return nil
 	}
-	return &PolicyDnsForwarderZoneClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &PolicyDnsForwarderZoneClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c PolicyDnsForwarderZoneClientContext) Get(dnsForwarderZoneIdParam string) (model0.PolicyDnsForwarderZone, error) {
@@ -62,7 +62,7 @@ func (c PolicyDnsForwarderZoneClientContext) Get(dnsForwarderZoneIdParam string)
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderZonesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, dnsForwarderZoneIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, dnsForwarderZoneIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c PolicyDnsForwarderZoneClientContext) Patch(dnsForwarderZoneIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderZonesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, dnsForwarderZoneIdParam, policyDnsForwarderZoneParam)
+		err = client.Patch(c.OrgID, c.ProjectID, dnsForwarderZoneIdParam, policyDnsForwarderZoneParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c PolicyDnsForwarderZoneClientContext) Update(dnsForwarderZoneIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderZonesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, dnsForwarderZoneIdParam, policyDnsForwarderZoneParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, dnsForwarderZoneIdParam, policyDnsForwarderZoneParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c PolicyDnsForwarderZoneClientContext) Delete(dnsForwarderZoneIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderZonesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, dnsForwarderZoneIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, dnsForwarderZoneIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c PolicyDnsForwarderZoneClientContext) List(cursorParam *string, includeMa
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderZonesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewQosProfilesClient(sessionContext utl.SessionContext, connector vapiProto
 	default:
 		return nil
 	}
-	return &QosProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &QosProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c QosProfileClientContext) Get(qosProfileIdParam string) (model0.QosProfile, error) {
@@ -62,7 +62,7 @@ func (c QosProfileClientContext) Get(qosProfileIdParam string) (model0.QosProfil
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.QosProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, qosProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c QosProfileClientContext) Patch(qosProfileIdParam string, qosProfileParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.QosProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam, qosProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, qosProfileIdParam, qosProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c QosProfileClientContext) Update(qosProfileIdParam string, qosProfilePara
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.QosProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam, qosProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, qosProfileIdParam, qosProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c QosProfileClientContext) Delete(qosProfileIdParam string, overrideParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.QosProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, qosProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, qosProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c QosProfileClientContext) List(cursorParam *string, includedFieldsParam *
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.QosProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
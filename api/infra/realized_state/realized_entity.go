@@ -34,7 +34,7 @@ func NewRealizedEntitiesClient(sessionContext utl.SessionContext, connector vapi
 	default:
 		return nil
 	}
-	return &RealizedEntityClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &RealizedEntityClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c RealizedEntityClientContext) List(intentPathParam string, sitePathParam *string) (model0.GenericPolicyRealizedResourceListResult, error) {
@@ -61,7 +61,7 @@ func (c RealizedEntityClientContext) List(intentPathParam string, sitePathParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.RealizedEntitiesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, intentPathParam, sitePathParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, intentPathParam, sitePathParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
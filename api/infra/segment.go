@@ -34,7 +34,7 @@ func NewSegmentsClient(sessionContext utl.SessionContext, connector vapiProtocol
 	default:
 		return nil
 	}
-	return &SegmentClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SegmentClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SegmentClientContext) Get(segmentIdParam string) (model0.Segment, error) {
@@ -62,7 +62,7 @@ func (c SegmentClientContext) Get(segmentIdParam string) (model0.Segment, error)
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, segmentIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, segmentIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c SegmentClientContext) Patch(segmentIdParam string, segmentParam model0.S
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentParam)
+		err = client.Patch(c.OrgID, c.ProjectID, segmentIdParam, segmentParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c SegmentClientContext) Update(segmentIdParam string, segmentParam model0.
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, segmentIdParam, segmentParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c SegmentClientContext) Delete(segmentIdParam string) error {
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, segmentIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, segmentIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c SegmentClientContext) List(cursorParam *string, includeMarkForDeleteObje
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, segmentTypeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, segmentTypeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
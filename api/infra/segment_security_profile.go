@@ -34,7 +34,7 @@ func NewSegmentSecurityProfilesClient(sessionContext utl.SessionContext, connect
 	default:
 		return nil
 	}
-	return &SegmentSecurityProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SegmentSecurityProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SegmentSecurityProfileClientContext) Get(segmentSecurityProfileIdParam string) (model0.SegmentSecurityProfile, error) {
@@ -62,7 +62,7 @@ func (c SegmentSecurityProfileClientContext) Get(segmentSecurityProfileIdParam s
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentSecurityProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, segmentSecurityProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, segmentSecurityProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c SegmentSecurityProfileClientContext) Patch(segmentSecurityProfileIdParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentSecurityProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, segmentSecurityProfileIdParam, segmentSecurityProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, segmentSecurityProfileIdParam, segmentSecurityProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c SegmentSecurityProfileClientContext) Update(segmentSecurityProfileIdPara
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentSecurityProfilesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, segmentSecurityProfileIdParam, segmentSecurityProfileParam, overrideParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, segmentSecurityProfileIdParam, segmentSecurityProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c SegmentSecurityProfileClientContext) Delete(segmentSecurityProfileIdPara
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentSecurityProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, segmentSecurityProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, segmentSecurityProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c SegmentSecurityProfileClientContext) List(cursorParam *string, includeMa
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SegmentSecurityProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewStateClient(sessionContext utl.SessionContext, connector vapiProtocolCli
 	default:
 		return nil
 	}
-	return &SegmentConfigurationStateClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SegmentConfigurationStateClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SegmentConfigurationStateClientContext) Get(segmentsIdParam string, cursorParam *string, edgePathParam *string, enforcementPointPathParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.SegmentConfigurationState, error) {
@@ -62,7 +62,7 @@ func (c SegmentConfigurationStateClientContext) Get(segmentsIdParam string, curs
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.StateClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, segmentsIdParam, cursorParam, edgePathParam, enforcementPointPathParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, segmentsIdParam, cursorParam, edgePathParam, enforcementPointPathParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 		if err != nil {
 			return obj, err
 		}
@@ -97,7 +97,7 @@ func (c SegmentConfigurationStateClientContext) List(configurationStateParam *st
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.StateClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, configurationStateParam, enforcementPointPathParam, sourceParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, configurationStateParam, enforcementPointPathParam, sourceParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -29,7 +29,7 @@ func NewSegmentDiscoveryProfileBindingMapsClient(sessionContext utl.SessionConte
 	default:
 		return nil
 	}
-	return &SegmentDiscoveryProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SegmentDiscoveryProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SegmentDiscoveryProfileBindingMapClientContext) Get(infraSegmentIdParam string, segmentDiscoveryProfileBindingMapIdParam string) (model0.SegmentDiscoveryProfileBindingMap, error) {
@@ -47,7 +47,7 @@ func (c SegmentDiscoveryProfileBindingMapClientContext) Get(infraSegmentIdParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentDiscoveryProfileBindingMapsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c SegmentDiscoveryProfileBindingMapClientContext) Delete(infraSegmentIdPar
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentDiscoveryProfileBindingMapsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -88,7 +88,7 @@ func (c SegmentDiscoveryProfileBindingMapClientContext) Patch(infraSegmentIdPara
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentDiscoveryProfileBindingMapsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam, segmentDiscoveryProfileBindingMapParam)
+		err = client.Patch(c.OrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam, segmentDiscoveryProfileBindingMapParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -108,7 +108,7 @@ func (c SegmentDiscoveryProfileBindingMapClientContext) Update(infraSegmentIdPar
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentDiscoveryProfileBindingMapsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam, segmentDiscoveryProfileBindingMapParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, infraSegmentIdParam, segmentDiscoveryProfileBindingMapIdParam, segmentDiscoveryProfileBindingMapParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c SegmentDiscoveryProfileBindingMapClientContext) List(infraSegmentIdParam
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentDiscoveryProfileBindingMapsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, infraSegmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
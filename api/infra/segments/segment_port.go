@@ -29,7 +29,7 @@ func NewPortsClient(sessionContext utl.SessionContext, connector vapiProtocolCli
 	default:
 		return nil
 	}
-	return &SegmentPortClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SegmentPortClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SegmentPortClientContext) Get(segmentIdParam string, portIdParam string) (model0.SegmentPort, error) {
@@ -47,7 +47,7 @@ func (c SegmentPortClientContext) Get(segmentIdParam string, portIdParam string)
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.PortsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, segmentIdParam, portIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c SegmentPortClientContext) Patch(segmentIdParam string, portIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.PortsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam, segmentPortParam)
+		err = client.Patch(c.OrgID, c.ProjectID, segmentIdParam, portIdParam, segmentPortParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -89,7 +89,7 @@ func (c SegmentPortClientContext) Update(segmentIdParam string, portIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.PortsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam, segmentPortParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, segmentIdParam, portIdParam, segmentPortParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -108,7 +108,7 @@ func (c SegmentPortClientContext) Delete(segmentIdParam string, portIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.PortsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, segmentIdParam, portIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, segmentIdParam, portIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c SegmentPortClientContext) List(segmentIdParam string, cursorParam *strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.PortsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -29,7 +29,7 @@ func NewSegmentQosProfileBindingMapsClient(sessionContext utl.SessionContext, co
 	default:
 		return nil
 	}
-	return &SegmentQosProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SegmentQosProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SegmentQosProfileBindingMapClientContext) Get(segmentIdParam string, segmentQosProfileBindingMapIdParam string) (model0.SegmentQosProfileBindingMap, error) {
@@ -47,7 +47,7 @@ func (c SegmentQosProfileBindingMapClientContext) Get(segmentIdParam string, seg
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentQosProfileBindingMapsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c SegmentQosProfileBindingMapClientContext) Delete(segmentIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentQosProfileBindingMapsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -88,7 +88,7 @@ func (c SegmentQosProfileBindingMapClientContext) Patch(segmentIdParam string, s
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentQosProfileBindingMapsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam, segmentQosProfileBindingMapParam)
+		err = client.Patch(c.OrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam, segmentQosProfileBindingMapParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -108,7 +108,7 @@ func (c SegmentQosProfileBindingMapClientContext) Update(segmentIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentQosProfileBindingMapsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam, segmentQosProfileBindingMapParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, segmentIdParam, segmentQosProfileBindingMapIdParam, segmentQosProfileBindingMapParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c SegmentQosProfileBindingMapClientContext) List(segmentIdParam string, cu
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentQosProfileBindingMapsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, segmentIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, segmentIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -29,7 +29,7 @@ func NewSegmentSecurityProfileBindingMapsClient(sessionContext utl.SessionContex
 	default:
 		return nil
 	}
-	return &SegmentSecurityProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SegmentSecurityProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SegmentSecurityProfileBindingMapClientContext) Get(segmentIdParam string, segmentSecurityProfileBindingMapIdParam string) (model0.SegmentSecurityProfileBindingMap, error) {
@@ -47,7 +47,7 @@ func (c SegmentSecurityProfileBindingMapClientContext) Get(segmentIdParam string
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentSecurityProfileBindingMapsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -69,7 +69,7 @@ func (c SegmentSecurityProfileBindingMapClientContext) Delete(segmentIdParam str
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentSecurityProfileBindingMapsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -88,7 +88,7 @@ func (c SegmentSecurityProfileBindingMapClientContext) Patch(segmentIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentSecurityProfileBindingMapsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam, segmentSecurityProfileBindingMapParam)
+		err = client.Patch(c.OrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam, segmentSecurityProfileBindingMapParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -108,7 +108,7 @@ func (c SegmentSecurityProfileBindingMapClientContext) Update(segmentIdParam str
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentSecurityProfileBindingMapsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam, segmentSecurityProfileBindingMapParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, segmentIdParam, segmentSecurityProfileBindingMapIdParam, segmentSecurityProfileBindingMapParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c SegmentSecurityProfileBindingMapClientContext) List(segmentIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.SegmentSecurityProfileBindingMapsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, segmentIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, segmentIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewServicesClient(sessionContext utl.SessionContext, connector vapiProtocol
 	default:
 		return nil
 	}
-	return &ServiceClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &ServiceClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c ServiceClientContext) Get(serviceIdParam string) (model0.Service, error) {
@@ -62,7 +62,7 @@ func (c ServiceClientContext) Get(serviceIdParam string) (model0.Service, error)
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ServicesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, serviceIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, serviceIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c ServiceClientContext) Patch(serviceIdParam string, serviceParam model0.S
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ServicesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, serviceIdParam, serviceParam)
+		err = client.Patch(c.OrgID, c.ProjectID, serviceIdParam, serviceParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c ServiceClientContext) Update(serviceIdParam string, serviceParam model0.
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ServicesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, serviceIdParam, serviceParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, serviceIdParam, serviceParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c ServiceClientContext) Delete(serviceIdParam string) error {
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ServicesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, serviceIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, serviceIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c ServiceClientContext) List(cursorParam *string, defaultServiceParam *boo
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.ServicesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, defaultServiceParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, defaultServiceParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
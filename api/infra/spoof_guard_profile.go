@@ -34,7 +34,7 @@ func NewSpoofguardProfilesClient(sessionContext utl.SessionContext, connector va
 	default:
 		return nil
 	}
-	return &SpoofGuardProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &SpoofGuardProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c SpoofGuardProfileClientContext) Get(spoofguardProfileIdParam string) (model0.SpoofGuardProfile, error) {
@@ -62,7 +62,7 @@ func (c SpoofGuardProfileClientContext) Get(spoofguardProfileIdParam string) (mo
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SpoofguardProfilesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, spoofguardProfileIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, spoofguardProfileIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c SpoofGuardProfileClientContext) Patch(spoofguardProfileIdParam string, s
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SpoofguardProfilesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, spoofguardProfileIdParam, spoofGuardProfileParam, overrideParam)
+		err = client.Patch(c.OrgID, c.ProjectID, spoofguardProfileIdParam, spoofGuardProfileParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -115,7 +115,7 @@ func (c SpoofGuardProfileClientContext) Delete(spoofguardProfileIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SpoofguardProfilesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, spoofguardProfileIdParam, overrideParam)
+		err = client.Delete(c.OrgID, c.ProjectID, spoofguardProfileIdParam, overrideParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -147,7 +147,7 @@ func (c SpoofGuardProfileClientContext) List(cursorParam *string, includeMarkFor
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.SpoofguardProfilesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewTier1sClient(sessionContext utl.SessionContext, connector vapiProtocolCl
 	default:
 		return nil
 	}
-	return &Tier1ClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &Tier1ClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c Tier1ClientContext) Get(tier1IdParam string) (model0.Tier1, error) {
@@ -62,7 +62,7 @@ func (c Tier1ClientContext) Get(tier1IdParam string) (model0.Tier1, error) {
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Tier1sClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, tier1IdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c Tier1ClientContext) Patch(tier1IdParam string, tier1Param model0.Tier1)
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Tier1sClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, tier1Param)
+		err = client.Patch(c.OrgID, c.ProjectID, tier1IdParam, tier1Param)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c Tier1ClientContext) Update(tier1IdParam string, tier1Param model0.Tier1)
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Tier1sClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, tier1IdParam, tier1Param)
+		obj, err = client.Update(c.OrgID, c.ProjectID, tier1IdParam, tier1Param)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c Tier1ClientContext) Delete(tier1IdParam string) error {
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Tier1sClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, tier1IdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c Tier1ClientContext) List(cursorParam *string, includeMarkForDeleteObject
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.Tier1sClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewLocaleServicesClient(sessionContext utl.SessionContext, connector vapiPr
 	default:
 		return nil
 	}
-	return &LocaleServicesClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &LocaleServicesClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c LocaleServicesClientContext) Get(tier1IdParam string, localeServicesIdParam string) (model0.LocaleServices, error) {
@@ -62,7 +62,7 @@ func (c LocaleServicesClientContext) Get(tier1IdParam string, localeServicesIdPa
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.LocaleServicesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c LocaleServicesClientContext) Patch(tier1IdParam string, localeServicesId
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.LocaleServicesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, localeServicesParam)
+		err = client.Patch(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, localeServicesParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c LocaleServicesClientContext) Update(tier1IdParam string, localeServicesI
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.LocaleServicesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, localeServicesParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, localeServicesParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c LocaleServicesClientContext) Delete(tier1IdParam string, localeServicesI
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.LocaleServicesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c LocaleServicesClientContext) List(tier1IdParam string, cursorParam *stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.LocaleServicesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, tier1IdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, tier1IdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewInterfacesClient(sessionContext utl.SessionContext, connector vapiProtoc
 	default:
 		return nil
 	}
-	return &Tier1InterfaceClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &Tier1InterfaceClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c Tier1InterfaceClientContext) Get(tier1IdParam string, localeServicesIdParam string, interfaceIdParam string) (model0.Tier1Interface, error) {
@@ -62,7 +62,7 @@ func (c Tier1InterfaceClientContext) Get(tier1IdParam string, localeServicesIdPa
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.InterfacesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c Tier1InterfaceClientContext) Patch(tier1IdParam string, localeServicesId
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.InterfacesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam, tier1InterfaceParam)
+		err = client.Patch(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam, tier1InterfaceParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c Tier1InterfaceClientContext) Update(tier1IdParam string, localeServicesI
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.InterfacesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam, tier1InterfaceParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam, tier1InterfaceParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c Tier1InterfaceClientContext) Delete(tier1IdParam string, localeServicesI
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.InterfacesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, interfaceIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c Tier1InterfaceClientContext) List(tier1IdParam string, localeServicesIdP
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.InterfacesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, tier1IdParam, localeServicesIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewNatRulesClient(sessionContext utl.SessionContext, connector vapiProtocol
 	default:
 		return nil
 	}
-	return &PolicyNatRuleClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &PolicyNatRuleClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c PolicyNatRuleClientContext) Get(tier1IdParam string, natIdParam string, natRuleIdParam string) (model0.PolicyNatRule, error) {
@@ -62,7 +62,7 @@ func (c PolicyNatRuleClientContext) Get(tier1IdParam string, natIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.NatRulesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c PolicyNatRuleClientContext) Patch(tier1IdParam string, natIdParam string
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.NatRulesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam, policyNatRuleParam)
+		err = client.Patch(c.OrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam, policyNatRuleParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c PolicyNatRuleClientContext) Update(tier1IdParam string, natIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.NatRulesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam, policyNatRuleParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam, policyNatRuleParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c PolicyNatRuleClientContext) Delete(tier1IdParam string, natIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.NatRulesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, tier1IdParam, natIdParam, natRuleIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c PolicyNatRuleClientContext) List(tier1IdParam string, natIdParam string,
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.NatRulesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, tier1IdParam, natIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, tier1IdParam, natIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
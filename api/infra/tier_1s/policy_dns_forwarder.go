@@ -34,7 +34,7 @@ func NewDnsForwarderClient(sessionContext utl.SessionContext, connector vapiProt
 	default:
 		return nil
 	}
-	return &PolicyDnsForwarderClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &PolicyDnsForwarderClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c PolicyDnsForwarderClientContext) Get(tier1IdParam string) (model0.PolicyDnsForwarder, error) {
@@ -62,7 +62,7 @@ func (c PolicyDnsForwarderClientContext) Get(tier1IdParam string) (model0.Policy
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, tier1IdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c PolicyDnsForwarderClientContext) Patch(tier1IdParam string, policyDnsFor
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, policyDnsForwarderParam)
+		err = client.Patch(c.OrgID, c.ProjectID, tier1IdParam, policyDnsForwarderParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c PolicyDnsForwarderClientContext) Update(tier1IdParam string, policyDnsFo
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, tier1IdParam, policyDnsForwarderParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, tier1IdParam, policyDnsForwarderParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c PolicyDnsForwarderClientContext) Delete(tier1IdParam string) error {
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DnsForwarderClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, tier1IdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -35,7 +35,7 @@ func NewDhcpStaticBindingConfigsClient(sessionContext utl.SessionContext, connec
 	default:
 		return nil
 	}
-	return &StructValueClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &StructValueClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c StructValueClientContext) Get(tier1IdParam string, segmentIdParam string, bindingIdParam string) (*model0.StructValue, error) {
@@ -60,7 +60,7 @@ func (c StructValueClientContext) Get(tier1IdParam string, segmentIdParam string
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpStaticBindingConfigsClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -86,7 +86,7 @@ func (c StructValueClientContext) Delete(tier1IdParam string, segmentIdParam str
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpStaticBindingConfigsClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -109,7 +109,7 @@ func (c StructValueClientContext) Patch(tier1IdParam string, segmentIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpStaticBindingConfigsClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam, dhcpStaticBindingConfigParam)
+		err = client.Patch(c.OrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam, dhcpStaticBindingConfigParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -133,7 +133,7 @@ func (c StructValueClientContext) Update(tier1IdParam string, segmentIdParam str
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpStaticBindingConfigsClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam, dhcpStaticBindingConfigParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, tier1IdParam, segmentIdParam, bindingIdParam, dhcpStaticBindingConfigParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -165,7 +165,7 @@ func (c StructValueClientContext) List(tier1IdParam string, segmentIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.DhcpStaticBindingConfigsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, tier1IdParam, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -34,7 +34,7 @@ func NewStaticRoutesClient(sessionContext utl.SessionContext, connector vapiProt
 	default:
 		return nil
 	}
-	return &StaticRoutesClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &StaticRoutesClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, OrgID: sessionContext.GetOrgID()}
 }
 
 func (c StaticRoutesClientContext) Get(tier1IdParam string, routeIdParam string) (model0.StaticRoutes, error) {
@@ -62,7 +62,7 @@ func (c StaticRoutesClientContext) Get(tier1IdParam string, routeIdParam string)
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.StaticRoutesClient)
-		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam, routeIdParam)
+		obj, err = client.Get(c.OrgID, c.ProjectID, tier1IdParam, routeIdParam)
 		if err != nil {
 			return obj, err
 		}
@@ -92,7 +92,7 @@ func (c StaticRoutesClientContext) Patch(tier1IdParam string, routeIdParam strin
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.StaticRoutesClient)
-		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, routeIdParam, staticRoutesParam)
+		err = client.Patch(c.OrgID, c.ProjectID, tier1IdParam, routeIdParam, staticRoutesParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -128,7 +128,7 @@ func (c StaticRoutesClientContext) Update(tier1IdParam string, routeIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.StaticRoutesClient)
-		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, tier1IdParam, routeIdParam, staticRoutesParam)
+		obj, err = client.Update(c.OrgID, c.ProjectID, tier1IdParam, routeIdParam, staticRoutesParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -151,7 +151,7 @@ func (c StaticRoutesClientContext) Delete(tier1IdParam string, routeIdParam stri
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.StaticRoutesClient)
-		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam, routeIdParam)
+		err = client.Delete(c.OrgID, c.ProjectID, tier1IdParam, routeIdParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -183,7 +183,7 @@ func (c StaticRoutesClientContext) List(tier1IdParam string, cursorParam *string
 
 	case utl.Multitenancy:
 		client := c.Client.(client2.StaticRoutesClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, tier1IdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.List(c.OrgID, c.ProjectID, tier1IdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
 	default:
 		err = errors.New("invalid infrastructure for model")
@@ -16,11 +16,21 @@ const (
 type SessionContext struct {
 	ClientType ClientType
 	ProjectID  string
+	OrgID      string
 }
 type ClientContext struct {
 	Client     interface{}
 	ClientType ClientType
 	ProjectID  string
+	OrgID      string
+}
+
+// Returns organization of the context, or default organization if not specified
+func (c SessionContext) GetOrgID() string {
+	if c.OrgID == "" {
+		return DefaultOrgID
+	}
+	return c.OrgID
 }
 
 func ConvertModelBindingType(obj interface{}, sourceType bindings.BindingType, destType bindings.BindingType) (interface{}, error) {
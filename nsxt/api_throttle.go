@@ -0,0 +1,214 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Base for exponential backoff when retry_min_delay is not configured, in ms
+const retryBackoffBaseDelay = 50
+
+// Upper bound for waiting on server Retry-After hint
+const maxRetryAfterInterval = 5 * time.Minute
+
+// Exponential backoff with jitter: the upper bound of retry interval doubles
+// with each attempt, starting from min delay and capped by max delay, while
+// actual interval is randomized within [min delay, upper bound]
+func getRetryBackoffInterval(attempt uint, minDelay int, maxDelay int) time.Duration {
+	if maxDelay <= 0 {
+		return 0
+	}
+	if minDelay > maxDelay {
+		minDelay = maxDelay
+	}
+
+	base := minDelay
+	if base <= 0 {
+		base = retryBackoffBaseDelay
+	}
+	ceiling := maxDelay
+	if attempt < 31 && base<<attempt < maxDelay {
+		ceiling = base << attempt
+	}
+	if ceiling <= minDelay {
+		return time.Duration(minDelay) * time.Millisecond
+	}
+
+	interval := minDelay + rand.Intn(ceiling-minDelay+1)
+	return time.Duration(interval) * time.Millisecond
+}
+
+// Parses Retry-After header of the response, which holds either number of
+// seconds or HTTP date
+func getRetryAfterInterval(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	var interval time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		interval = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		interval = time.Until(date)
+	} else {
+		log.Printf("[DEBUG]: Ignoring invalid Retry-After header %s", value)
+		return 0, false
+	}
+
+	if interval < 0 {
+		interval = 0
+	}
+	if interval > maxRetryAfterInterval {
+		interval = maxRetryAfterInterval
+	}
+	return interval, true
+}
+
+// nsxtAPIThrottle limits rate and concurrency of API requests, separately
+// for each NSX manager
+type nsxtAPIThrottle struct {
+	lock           sync.Mutex
+	rateLimit      int
+	maxConcurrency int
+	hosts          map[string]*hostThrottle
+}
+
+type hostThrottle struct {
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+	slots  chan struct{}
+}
+
+func newNsxtAPIThrottle(rateLimit int, maxConcurrency int) *nsxtAPIThrottle {
+	return &nsxtAPIThrottle{
+		rateLimit:      rateLimit,
+		maxConcurrency: maxConcurrency,
+		hosts:          make(map[string]*hostThrottle),
+	}
+}
+
+func configureAPIThrottle(d *schema.ResourceData, clients *nsxtClients) {
+	rateLimit := d.Get("api_rate_limit").(int)
+	maxConcurrency := d.Get("api_max_concurrency").(int)
+	if rateLimit <= 0 && maxConcurrency <= 0 {
+		return
+	}
+
+	clients.APIThrottle = newNsxtAPIThrottle(rateLimit, maxConcurrency)
+}
+
+func (t *nsxtAPIThrottle) getHostThrottle(host string) *hostThrottle {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	h, ok := t.hosts[host]
+	if !ok {
+		h = &hostThrottle{
+			tokens: float64(t.rateLimit),
+			last:   time.Now(),
+		}
+		if t.maxConcurrency > 0 {
+			h.slots = make(chan struct{}, t.maxConcurrency)
+		}
+		t.hosts[host] = h
+	}
+	return h
+}
+
+// Token bucket with burst size equal to rate limit: returns the time caller
+// needs to wait before issuing the request
+func (h *hostThrottle) reserve(rateLimit int) time.Duration {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	now := time.Now()
+	h.tokens += now.Sub(h.last).Seconds() * float64(rateLimit)
+	if h.tokens > float64(rateLimit) {
+		h.tokens = float64(rateLimit)
+	}
+	h.last = now
+	h.tokens--
+	if h.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-h.tokens / float64(rateLimit) * float64(time.Second))
+}
+
+// apiThrottleTransport applies provider throttling settings to requests
+type apiThrottleTransport struct {
+	transport http.RoundTripper
+	throttle  *nsxtAPIThrottle
+}
+
+func newAPIThrottleTransport(transport http.RoundTripper, throttle *nsxtAPIThrottle) *apiThrottleTransport {
+	return &apiThrottleTransport{
+		transport: transport,
+		throttle:  throttle,
+	}
+}
+
+func (t *apiThrottleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	h := t.throttle.getHostThrottle(req.URL.Host)
+	ctx := req.Context()
+
+	if h.slots != nil {
+		select {
+		case h.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if h.slots != nil {
+			<-h.slots
+		}
+	}
+
+	if t.throttle.rateLimit > 0 {
+		if wait := h.reserve(t.throttle.rateLimit); wait > 0 {
+			log.Printf("[DEBUG]: Delaying request to %s by %v due to API rate limit", req.URL.Host, wait)
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	// Concurrency slot is held until response body is consumed
+	resp.Body = &throttledBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+type throttledBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *throttledBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
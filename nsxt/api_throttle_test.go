@@ -0,0 +1,101 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+func TestMockNsxRetryAfter(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMetaWithConfig(t, server.Host(), map[string]interface{}{
+		"max_retries":     2,
+		"retry_max_delay": 0,
+	})
+
+	server.throttleRequests(1, "1")
+	start := time.Now()
+	if _, err := infra.NewDomainsClient(getPolicyConnector(m)).Get("default"); err != nil {
+		t.Fatalf("Request failed after retry: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected retry to honor Retry-After, but request completed after %v", elapsed)
+	}
+}
+
+func TestMockNsxAPIThrottle(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMetaWithConfig(t, server.Host(), map[string]interface{}{
+		"api_max_concurrency": 2,
+		"api_rate_limit":      20,
+	})
+
+	server.lock.Lock()
+	server.requestLatency = 20 * time.Millisecond
+	server.lock.Unlock()
+	requestsBefore, _ := server.requestStats()
+	count := 30
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	start := time.Now()
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := infra.NewDomainsClient(getPolicyConnector(m)).Get("default")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+	}
+
+	requests, maxInflight := server.requestStats()
+	if requests-requestsBefore != count {
+		t.Fatalf("Expected %d requests, got %d", count, requests-requestsBefore)
+	}
+	if maxInflight > 2 {
+		t.Fatalf("Expected at most 2 concurrent requests, got %d", maxInflight)
+	}
+	// Burst of 20 requests is allowed, the rest is paced at 20 per second
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("Expected requests to be rate limited, but all completed within %v", elapsed)
+	}
+}
+
+func TestMockNsxAPIThrottleSessionRenewal(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMetaWithConfig(t, server.Host(), map[string]interface{}{
+		"api_max_concurrency": 1,
+	})
+
+	server.expireSessions()
+	done := make(chan error, 1)
+	go func() {
+		_, err := infra.NewDomainsClient(getPolicyConnector(m)).Get("default")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Request failed after session expiry: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Request did not complete after session expiry with concurrency limit")
+	}
+	if server.sessionCount() != 1 {
+		t.Fatalf("Expected single session after renewal, got %d", server.sessionCount())
+	}
+}
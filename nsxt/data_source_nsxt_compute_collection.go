@@ -4,8 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceNsxtComputeCollection() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtComputeCollectionRead,
+		ReadContext: dataSourceNsxtComputeCollectionRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -44,7 +45,7 @@ func nullIfEmpty(s string) *string {
 	return &s
 }
 
-func dataSourceNsxtComputeCollectionRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtComputeCollectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := fabric.NewComputeCollectionsClient(connector)
 	objID := d.Get("id").(string)
@@ -60,17 +61,17 @@ func dataSourceNsxtComputeCollectionRead(d *schema.ResourceData, m interface{})
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return fmt.Errorf("failed to read ComputeCollection %s: %v", objID, err)
+			return diag.Errorf("failed to read ComputeCollection %s: %v", objID, err)
 		}
 		obj = objGet
 	} else {
 		objList, err := client.List(objLocalID, nil, nil, objName, nil, nil, nil, objOrigin, objType, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("failed to read Compute Collections: %v", err)
+			return diag.Errorf("failed to read Compute Collections: %v", err)
 		} else if *objList.ResultCount == 0 {
-			return fmt.Errorf("no Compute Collections that matched the specified parameters found")
+			return diag.Errorf("no Compute Collections that matched the specified parameters found")
 		} else if *objList.ResultCount > 1 {
-			return fmt.Errorf("found multiple Compute Collections that matched specified parameters")
+			return diag.Errorf("found multiple Compute Collections that matched specified parameters")
 		}
 		obj = objList.Results[0]
 	}
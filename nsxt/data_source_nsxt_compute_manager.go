@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceNsxtComputeManager() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtComputeManagerRead,
+		ReadContext: dataSourceNsxtComputeManagerRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -29,7 +30,7 @@ func dataSourceNsxtComputeManager() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtComputeManagerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := fabric.NewComputeManagersClient(connector)
 
@@ -42,14 +43,14 @@ func dataSourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) err
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return fmt.Errorf("failed to read ComputeManager %s: %v", objID, err)
+			return diag.Errorf("failed to read ComputeManager %s: %v", objID, err)
 		}
 		obj = objGet
 	} else {
 		// Get by full name/prefix
 		objList, err := client.List(nil, nil, nil, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("failed to read Compute Managers: %v", err)
+			return diag.Errorf("failed to read Compute Managers: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.ComputeManager
@@ -69,16 +70,16 @@ func dataSourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) err
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("found multiple Compute Managers matching the criteria")
+				return diag.Errorf("found multiple Compute Managers matching the criteria")
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("found multiple Compute Managers matching the criteria")
+				return diag.Errorf("found multiple Compute Managers matching the criteria")
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("No Compute Manager matches the criteria")
+			return diag.Errorf("No Compute Manager matches the criteria")
 		}
 	}
 
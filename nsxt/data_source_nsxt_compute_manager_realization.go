@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +20,7 @@ import (
 
 func dataSourceNsxtComputeManagerRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtComputeManagerRealizationRead,
+		ReadContext: dataSourceNsxtComputeManagerRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -60,7 +62,7 @@ func dataSourceNsxtComputeManagerRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtComputeManagerRealizationRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtComputeManagerRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	checkRegistration := d.Get("check_registration").(bool)
@@ -68,10 +70,10 @@ func dataSourceNsxtComputeManagerRealizationRead(d *schema.ResourceData, m inter
 	err := dataSourceNsxtComputeManagerRealizationWait(d, connector)
 
 	if !checkRegistration {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return dataSourceNsxtComputeManagerRegistrationWait(d, connector)
+	return diag.FromErr(dataSourceNsxtComputeManagerRegistrationWait(d, connector))
 }
 
 func dataSourceNsxtComputeManagerRealizationWait(d *schema.ResourceData, connector client.Connector) error {
@@ -4,18 +4,19 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtEdgeCluster() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtEdgeClusterRead,
+		ReadContext:        dataSourceNsxtEdgeClusterRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -52,11 +53,11 @@ func dataSourceNsxtEdgeCluster() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtEdgeClusterRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtEdgeClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read an edge cluster by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return diag.FromErr(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -67,21 +68,21 @@ func dataSourceNsxtEdgeClusterRead(d *schema.ResourceData, m interface{}) error
 		objGet, resp, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Edge cluster %s was not found", objID)
+			return diag.Errorf("Edge cluster %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading edge cluster %s: %v", objID, err)
+			return diag.Errorf("Error while reading edge cluster %s: %v", objID, err)
 		}
 		obj = objGet
 
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining edge cluster ID or name during read")
+		return diag.Errorf("Error obtaining edge cluster ID or name during read")
 	} else {
 		// Get by full name/prefix
 		// TODO use 2nd parameter localVarOptionals for paging
 		objList, _, err := nsxClient.NetworkTransportApi.ListEdgeClusters(nsxClient.Context, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading edge clusters: %v", err)
+			return diag.Errorf("Error while reading edge clusters: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []manager.EdgeCluster
@@ -96,16 +97,16 @@ func dataSourceNsxtEdgeClusterRead(d *schema.ResourceData, m interface{}) error
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple edge clusters with name '%s'", objName)
+				return diag.Errorf("Found multiple edge clusters with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple edge clusters with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple edge clusters with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Edge cluster with name '%s' was not found", objName)
+			return diag.Errorf("Edge cluster with name '%s' was not found", objName)
 		}
 	}
 
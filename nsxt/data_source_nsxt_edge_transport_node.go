@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceNsxtEdgeTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtEdgeTransportNodeRead,
+		ReadContext: dataSourceNsxtEdgeTransportNodeRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -25,7 +26,7 @@ func dataSourceNsxtEdgeTransportNode() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtEdgeTransportNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nsx.NewTransportNodesClient(connector)
 
@@ -38,18 +39,18 @@ func dataSourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{})
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return fmt.Errorf("failed to read TransportNode %s: %v", objID, err)
+			return diag.Errorf("failed to read TransportNode %s: %v", objID, err)
 		}
 
 		// Make sure that found obj is an EdgeNode
 		converter := bindings.NewTypeConverter()
 		base, errs := converter.ConvertToGolang(obj.NodeDeploymentInfo, model.NodeBindingType())
 		if errs != nil {
-			return fmt.Errorf("failed to convert NodeDeploymentInfo for node %s %v", objID, errs[0])
+			return diag.Errorf("failed to convert NodeDeploymentInfo for node %s %v", objID, errs[0])
 		}
 		node := base.(model.Node)
 		if node.ResourceType != model.EdgeNode__TYPE_IDENTIFIER {
-			return fmt.Errorf("no Transport Node matches the criteria")
+			return diag.Errorf("no Transport Node matches the criteria")
 		}
 		obj = objGet
 	} else {
@@ -57,7 +58,7 @@ func dataSourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{})
 		objType := model.EdgeNode__TYPE_IDENTIFIER
 		objList, err := client.List(nil, nil, nil, nil, nil, &objType, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("failed to read Transport Nodes: %v", err)
+			return diag.Errorf("failed to read Transport Nodes: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.TransportNode
@@ -77,16 +78,16 @@ func dataSourceNsxtEdgeTransportNodeRead(d *schema.ResourceData, m interface{})
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("found multiple Transport Nodes matching the criteria")
+				return diag.Errorf("found multiple Transport Nodes matching the criteria")
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("found multiple Transport Nodes matching the criteria")
+				return diag.Errorf("found multiple Transport Nodes matching the criteria")
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("no Transport Node matches the criteria")
+			return diag.Errorf("no Transport Node matches the criteria")
 		}
 	}
 	d.SetId(*obj.Id)
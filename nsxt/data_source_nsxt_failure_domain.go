@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceNsxtFailureDomain() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtFailureDomainRead,
+		ReadContext: dataSourceNsxtFailureDomainRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -24,7 +25,7 @@ func dataSourceNsxtFailureDomain() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtFailureDomainRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtFailureDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nsx.NewFailureDomainsClient(connector)
 
@@ -35,20 +36,20 @@ func dataSourceNsxtFailureDomainRead(d *schema.ResourceData, m interface{}) erro
 		// Get by id
 		objGet, err := client.Get(objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("FailureDomain with ID %s was not found", objID)
+			return diag.Errorf("FailureDomain with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("error while reading FailureDomain %s: %v", objID, err)
+			return diag.Errorf("error while reading FailureDomain %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("error obtaining FailureDomain ID or name during read")
+		return diag.Errorf("error obtaining FailureDomain ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := client.List()
 		if err != nil {
-			return fmt.Errorf("error while reading FailureDomains: %v", err)
+			return diag.Errorf("error while reading FailureDomains: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.FailureDomain
@@ -63,16 +64,16 @@ func dataSourceNsxtFailureDomainRead(d *schema.ResourceData, m interface{}) erro
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("found multiple FailureDomains with name '%s'", objName)
+				return diag.Errorf("found multiple FailureDomains with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("found multiple FailureDomains with name starting with '%s'", objName)
+				return diag.Errorf("found multiple FailureDomains with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("FailureDomain with name '%s' was not found", objName)
+			return diag.Errorf("FailureDomain with name '%s' was not found", objName)
 		}
 	}
 
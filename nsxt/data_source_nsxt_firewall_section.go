@@ -4,17 +4,19 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtFirewallSection() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtFirewallSectionRead,
+		ReadContext:        dataSourceNsxtFirewallSectionRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -39,10 +41,10 @@ func dataSourceNsxtFirewallSection() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtFirewallSectionRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtFirewallSectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return diag.FromErr(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -53,10 +55,10 @@ func dataSourceNsxtFirewallSectionRead(d *schema.ResourceData, m interface{}) er
 		objGet, resp, err := nsxClient.ServicesApi.GetSection(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Firewall section %s was not found", objID)
+			return diag.Errorf("Firewall section %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading Firewall section %s: %v", objID, err)
+			return diag.Errorf("Error while reading Firewall section %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName != "" {
@@ -86,13 +88,13 @@ func dataSourceNsxtFirewallSectionRead(d *schema.ResourceData, m interface{}) er
 		}
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !found {
-			return fmt.Errorf("Firewall section with  name '%s' was not found among %d sections", objName, total)
+			return diag.Errorf("Firewall section with  name '%s' was not found among %d sections", objName, total)
 		}
 	} else {
-		return fmt.Errorf("Error obtaining Firewall section ID or name during read")
+		return diag.Errorf("Error obtaining Firewall section ID or name during read")
 	}
 
 	d.SetId(obj.Id)
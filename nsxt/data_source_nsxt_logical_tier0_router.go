@@ -4,18 +4,20 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtLogicalTier0Router() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtLogicalTier0RouterRead,
+		ReadContext:        dataSourceNsxtLogicalTier0RouterRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -52,11 +54,11 @@ func dataSourceNsxtLogicalTier0Router() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtLogicalTier0RouterRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtLogicalTier0RouterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read a logical tier0 router by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return diag.FromErr(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -67,17 +69,17 @@ func dataSourceNsxtLogicalTier0RouterRead(d *schema.ResourceData, m interface{})
 		objGet, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouter(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Logical tier0 router %s was not found", objID)
+			return diag.Errorf("Logical tier0 router %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading logical tier0 router %s: %v", objID, err)
+			return diag.Errorf("Error while reading logical tier0 router %s: %v", objID, err)
 		}
 		if objGet.RouterType != "TIER0" {
-			return fmt.Errorf("Logical router %s is not a tier0 router", objID)
+			return diag.Errorf("Logical router %s is not a tier0 router", objID)
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining logical tier0 router ID or name during read")
+		return diag.Errorf("Error obtaining logical tier0 router ID or name during read")
 	} else {
 		// Get by full name/prefix
 		var perfectMatch []manager.LogicalRouter
@@ -107,21 +109,21 @@ func dataSourceNsxtLogicalTier0RouterRead(d *schema.ResourceData, m interface{})
 
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple logical tier0 routers with name '%s'", objName)
+				return diag.Errorf("Found multiple logical tier0 routers with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple logical tier0 routers with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple logical tier0 routers with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Logical tier0 router with name '%s' was not found among %d objects", objName, total)
+			return diag.Errorf("Logical tier0 router with name '%s' was not found among %d objects", objName, total)
 		}
 	}
 
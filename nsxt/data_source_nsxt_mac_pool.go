@@ -4,17 +4,19 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtMacPool() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtMacPoolRead,
+		ReadContext:        dataSourceNsxtMacPoolRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -39,11 +41,11 @@ func dataSourceNsxtMacPool() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtMacPoolRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtMacPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read Mac Pool by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return diag.FromErr(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -54,10 +56,10 @@ func dataSourceNsxtMacPoolRead(d *schema.ResourceData, m interface{}) error {
 		objGet, resp, err := nsxClient.PoolManagementApi.ReadMacPool(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Mac pool %s was not found", objID)
+			return diag.Errorf("Mac pool %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading Mac pool %s: %v", objID, err)
+			return diag.Errorf("Error while reading Mac pool %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName != "" {
@@ -88,13 +90,13 @@ func dataSourceNsxtMacPoolRead(d *schema.ResourceData, m interface{}) error {
 
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if !found {
-			return fmt.Errorf("Mac pool with name '%s' was not found among %d pools", objName, total)
+			return diag.Errorf("Mac pool with name '%s' was not found among %d pools", objName, total)
 		}
 	} else {
-		return fmt.Errorf("Error obtaining Mac pool ID or name during read")
+		return diag.Errorf("Error obtaining Mac pool ID or name during read")
 	}
 
 	d.SetId(obj.Id)
@@ -4,15 +4,16 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtManagementCluster() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtManagementClusterRead,
+		ReadContext:        dataSourceNsxtManagementClusterRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -29,41 +30,41 @@ func dataSourceNsxtManagementCluster() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtManagementClusterRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtManagementClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return diag.FromErr(dataSourceNotSupportedError())
 	}
 
 	clusterObj, resp, err := nsxClient.NsxComponentAdministrationApi.ReadClusterConfig(nsxClient.Context)
 	if err != nil {
-		return fmt.Errorf("Error while reading cluster configuration: %v", err)
+		return diag.Errorf("Error while reading cluster configuration: %v", err)
 	}
 	if resp != nil && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected Response while reading cluster configuration. Status Code: %d", resp.StatusCode)
+		return diag.Errorf("Unexpected Response while reading cluster configuration. Status Code: %d", resp.StatusCode)
 	}
 
 	nodeList, resp, err := nsxClient.NsxComponentAdministrationApi.ListClusterNodeConfigs(nsxClient.Context, nil)
 	if err != nil {
-		return fmt.Errorf("Error while reading cluster node configuration: %v", err)
+		return diag.Errorf("Error while reading cluster node configuration: %v", err)
 	}
 	if resp != nil && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected Response while reading cluster node configuration. Status Code: %d", resp.StatusCode)
+		return diag.Errorf("Unexpected Response while reading cluster node configuration. Status Code: %d", resp.StatusCode)
 	}
 	for _, nodeConfig := range nodeList.Results {
 		if nodeConfig.ManagerRole != nil && nodeConfig.ManagerRole.ApiListenAddr != nil && nodeConfig.ManagerRole.ApiListenAddr.IpAddress == m.(nsxtClients).Host[len("https://"):] {
 			if nodeConfig.ManagerRole.ApiListenAddr.CertificateSha256Thumbprint == "" {
-				return fmt.Errorf("Manager node thumbprint not found while reading cluster node configuration")
+				return diag.Errorf("Manager node thumbprint not found while reading cluster node configuration")
 			}
 			d.Set("node_sha256_thumbprint", nodeConfig.ManagerRole.ApiListenAddr.CertificateSha256Thumbprint)
 		}
 	}
 
 	if clusterObj.ClusterId == "" {
-		return fmt.Errorf("Cluster id not found")
+		return diag.Errorf("Cluster id not found")
 	}
 	if d.Get("node_sha256_thumbprint").(string) == "" {
-		return fmt.Errorf("Cluster node sha256 thumbprint not found")
+		return diag.Errorf("Cluster node sha256 thumbprint not found")
 	}
 
 	d.SetId(clusterObj.ClusterId)
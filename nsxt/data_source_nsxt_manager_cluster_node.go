@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceNsxtManagerClusterNode() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtManagerClusterNodeRead,
+		ReadContext:        dataSourceNsxtManagerClusterNodeRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -45,7 +46,7 @@ func dataSourceNsxtManagerClusterNode() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtManagerClusterNodeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtManagerClusterNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := cluster.NewNodesClient(connector)
 
@@ -56,16 +57,16 @@ func dataSourceNsxtManagerClusterNodeRead(d *schema.ResourceData, m interface{})
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return handleDataSourceReadError(d, "ClusterNode", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "ClusterNode", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("error obtaining ClusterNode ID or name during read")
+		return diag.Errorf("error obtaining ClusterNode ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := client.List(nil, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("ClusterNode", err)
+			return diag.FromErr(handleListError("ClusterNode", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.ClusterNodeConfig
@@ -80,16 +81,16 @@ func dataSourceNsxtManagerClusterNodeRead(d *schema.ResourceData, m interface{})
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("found multiple ClusterNode with name '%s'", objName)
+				return diag.Errorf("found multiple ClusterNode with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("found multiple ClusterNodes with name starting with '%s'", objName)
+				return diag.Errorf("found multiple ClusterNodes with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("ClusterNode with name '%s' was not found", objName)
+			return diag.Errorf("ClusterNode with name '%s' was not found", objName)
 		}
 	}
 
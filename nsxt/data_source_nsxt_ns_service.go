@@ -4,16 +4,18 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtNsService() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtNsServiceRead,
+		ReadContext:        dataSourceNsxtNsServiceRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -38,11 +40,11 @@ func dataSourceNsxtNsService() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read NS Service by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return diag.FromErr(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -53,10 +55,10 @@ func dataSourceNsxtNsServiceRead(d *schema.ResourceData, m interface{}) error {
 		objGet, resp, err := nsxClient.GroupingObjectsApi.ReadNSService(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("NS service %s was not found", objID)
+			return diag.Errorf("NS service %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading NS service %s: %v", objID, err)
+			return diag.Errorf("Error while reading NS service %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName != "" {
@@ -86,14 +88,14 @@ func dataSourceNsxtNsServiceRead(d *schema.ResourceData, m interface{}) error {
 
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if !found {
-			return fmt.Errorf("NS service with name '%s' was not found among %d services", objName, total)
+			return diag.Errorf("NS service with name '%s' was not found among %d services", objName, total)
 		}
 	} else {
-		return fmt.Errorf("Error obtaining NS service ID or name during read")
+		return diag.Errorf("Error obtaining NS service ID or name during read")
 	}
 
 	d.SetId(obj.Id)
@@ -4,14 +4,16 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtNsServices() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtNsServicesRead,
+		ReadContext:        dataSourceNsxtNsServicesRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"items": {
@@ -26,10 +28,10 @@ func dataSourceNsxtNsServices() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtNsServicesRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtNsServicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return diag.FromErr(dataSourceNotSupportedError())
 	}
 
 	// Get by full name
@@ -52,7 +54,7 @@ func dataSourceNsxtNsServicesRead(d *schema.ResourceData, m interface{}) error {
 
 	_, err := handlePagination(lister)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(newUUID())
@@ -4,28 +4,32 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyBridgeProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyBridgeProfileRead,
+		ReadContext: dataSourceNsxtPolicyBridgeProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtPolicyBridgeProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyBridgeProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	_, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "L2BridgeEndpointProfile", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
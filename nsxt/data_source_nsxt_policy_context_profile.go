@@ -4,16 +4,20 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyContextProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyContextProfileRead,
+		ReadContext: dataSourceNsxtPolicyContextProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(),
@@ -21,10 +25,10 @@ func dataSourceNsxtPolicyContextProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyContextProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyContextProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "PolicyContextProfile", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
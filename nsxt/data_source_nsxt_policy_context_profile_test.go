@@ -42,7 +42,7 @@ func TestAccDataSourceNsxtPolicyContextProfile_prefix(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccNsxtPolicyContextProfileReadTemplate(namePrefix),
+				Config: testAccNsxtPolicyContextProfileReadPrefixTemplate(namePrefix),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
 					resource.TestCheckResourceAttrSet(testResourceName, "description"),
@@ -83,6 +83,14 @@ data "nsxt_policy_context_profile" "test" {
 }`, name)
 }
 
+func testAccNsxtPolicyContextProfileReadPrefixTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_context_profile" "test" {
+  display_name = "%s"
+  match_mode   = "prefix"
+}`, name)
+}
+
 func testAccNsxtPolicyContextProfileMultitenancyTemplate(name string) string {
 	context := testAccNsxtPolicyMultitenancyContext()
 	return fmt.Sprintf(`
@@ -4,16 +4,20 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyEdgeCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyEdgeClusterRead,
+		ReadContext: dataSourceNsxtPolicyEdgeClusterRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"site_path": {
@@ -26,16 +30,16 @@ func dataSourceNsxtPolicyEdgeCluster() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyEdgeClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read an edge cluster by name or id
 	objSitePath := d.Get("site_path").(string)
 
 	if !isPolicyGlobalManager(m) && objSitePath != "" {
-		return globalManagerOnlyError()
+		return diag.FromErr(globalManagerOnlyError())
 	}
 	if isPolicyGlobalManager(m) {
 		if objSitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_edge_cluster")
+			return diag.FromErr(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_edge_cluster"))
 		}
 
 		query := make(map[string]string)
@@ -43,7 +47,7 @@ func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{})
 		query["parent_path"] = globalPolicyEnforcementPointPath
 		_, err := policyDataSourceResourceReadWithValidation(d, getPolicyConnector(m), getSessionContext(d, m), "PolicyEdgeCluster", query, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		return nil
 	}
@@ -52,7 +56,7 @@ func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{})
 	connector := getPolicyConnector(m)
 	_, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "PolicyEdgeCluster", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points/edge_clusters"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceNsxtPolicyEdgeNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyEdgeNodeRead,
+		ReadContext: dataSourceNsxtPolicyEdgeNodeRead,
 
 		Schema: map[string]*schema.Schema{
 			"edge_cluster_path": getPolicyPathSchema(true, false, "Edge cluster Path"),
@@ -28,20 +29,22 @@ func dataSourceNsxtPolicyEdgeNode() *schema.Resource {
 			},
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyEdgeNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read an edge node by name or id
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	// Note - according to the documentation GetOkExists should be used
 	// for bool types, but in this case it works and GetOk doesn't
 	memberIndex, memberIndexSet := d.GetOkExists("member_index")
 
-	if isPolicyGlobalManager(m) || nsxVersionHigherOrEqual("3.2.0") {
+	if isPolicyGlobalManager(m) || nsxVersionHigherOrEqual(m, "3.2.0") {
 		query := make(map[string]string)
 		query["parent_path"] = edgeClusterPath
 		if memberIndexSet {
@@ -49,7 +52,7 @@ func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) err
 		}
 		_, err := policyDataSourceResourceReadWithValidation(d, getPolicyConnector(m), getSessionContext(d, m), "PolicyEdgeNode", query, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		return nil
 	}
@@ -66,50 +69,43 @@ func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) err
 		objGet, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), edgeClusterID, objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "Edge Node", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "Edge Node", objID, err))
 		}
 		obj = objGet
 	} else {
-		// Get by full name/prefix
-		name, nameSet := d.GetOk("display_name")
-		objName := name.(string)
+		// Get by name
+		objName := d.Get("display_name").(string)
 		objMemberIndex := int64(memberIndex.(int))
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(defaultSite, getPolicyEnforcementPoint(m), edgeClusterID, nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("Edge Node", err)
+			return diag.FromErr(handleListError("Edge Node", err))
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.PolicyEdgeNode
-		var prefixMatch []model.PolicyEdgeNode
 		for _, objInList := range objList.Results {
 			indexMatch := true
 			if memberIndexSet && objMemberIndex != *objInList.MemberIndex {
 				indexMatch = false
 			}
-			if nameSet && strings.HasPrefix(*objInList.DisplayName, objName) && indexMatch {
-				prefixMatch = append(prefixMatch, objInList)
-			}
-			if nameSet && *objInList.DisplayName == objName && indexMatch {
-				perfectMatch = append(perfectMatch, objInList)
+			if !indexMatch {
+				continue
 			}
-			if !nameSet && indexMatch {
+			if matcher.match(objInList.DisplayName, getCommonTagsFromPolicyTags(objInList.Tags)) {
 				perfectMatch = append(perfectMatch, objInList)
 			}
 		}
 
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple edge nodes with name '%s' and index %d", objName, memberIndex)
+				return diag.FromErr(policyEdgeNodeMultipleMatchError(fmt.Sprintf("%s and index %d", matcher.String(), memberIndex), perfectMatch))
 			}
 			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple edge nodes with name starting with '%s' and index %d", objName, memberIndex)
-			}
-			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("edge node '%s' was not found and %d", objName, memberIndex)
+			return diag.Errorf("edge node '%s' was not found and %d", objName, memberIndex)
 		}
 	}
 
@@ -119,3 +115,12 @@ func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) err
 	d.Set("path", obj.Path)
 	return nil
 }
+
+func policyEdgeNodeMultipleMatchError(criteria string, objs []model.PolicyEdgeNode) error {
+	var names, paths []string
+	for _, obj := range objs {
+		names = append(names, *obj.DisplayName)
+		paths = append(paths, *obj.Path)
+	}
+	return policyDataSourceMultipleMatchError("edge nodes", criteria, names, paths)
+}
@@ -4,8 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -13,12 +15,14 @@ import (
 
 func dataSourceNsxtPolicyGatewayLocaleService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayLocaleServiceRead,
+		ReadContext: dataSourceNsxtPolicyGatewayLocaleServiceRead,
 
 		Schema: map[string]*schema.Schema{
 			"gateway_path": getPolicyPathSchema(true, true, "Gateway path"),
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"bgp_path":     getComputedPolicyPathSchema("Path for BGP config"),
@@ -33,7 +37,7 @@ func dataSourceNsxtPolicyGatewayLocaleService() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyGatewayLocaleServiceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyGatewayLocaleServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPath := d.Get("gateway_path").(string)
@@ -42,13 +46,13 @@ func dataSourceNsxtPolicyGatewayLocaleServiceRead(d *schema.ResourceData, m inte
 	obj, err := policyDataSourceResourceReadWithValidation(d, connector, getSessionContext(d, m), "LocaleServices", query, false)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	converter := bindings.NewTypeConverter()
 	dataValue, errors := converter.ConvertToGolang(obj, model.LocaleServicesBindingType())
 	if len(errors) > 0 {
-		return errors[0]
+		return diag.FromErr(errors[0])
 	}
 	localeService := dataValue.(model.LocaleServices)
 
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -22,11 +23,13 @@ var gatewayPolicyCategoryValues = []string{"Emergency", "SystemRules", "SharedPr
 
 func dataSourceNsxtPolicyGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayPolicyRead,
+		ReadContext: dataSourceNsxtPolicyGatewayPolicyRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"domain":       getDataSourceDomainNameSchema(),
@@ -69,7 +72,7 @@ func listGatewayPolicies(context utl.SessionContext, domain string, connector cl
 	}
 }
 
-func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyGatewayPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	category := d.Get("category").(string)
@@ -83,13 +86,13 @@ func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}
 		}
 		obj, err := policyDataSourceResourceReadWithValidation(d, connector, context, "GatewayPolicy", query, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		converter := bindings.NewTypeConverter()
 		dataValue, errors := converter.ConvertToGolang(obj, gm_model.GatewayPolicyBindingType())
 		if len(errors) > 0 {
-			return errors[0]
+			return diag.FromErr(errors[0])
 		}
 
 		policy := dataValue.(gm_model.GatewayPolicy)
@@ -106,51 +109,42 @@ func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}
 		client := domains.NewGatewayPoliciesClient(context, connector)
 		objGet, err := client.Get(domain, objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("Gateway Policy with ID %s was not found", objID)
+			return diag.Errorf("Gateway Policy with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Gateway Policy %s: %v", objID, err)
+			return diag.Errorf("Error while reading Gateway Policy %s: %v", objID, err)
 		}
 		obj = objGet
-	} else if objName == "" && category == "" {
-		return fmt.Errorf("Gateway Policy id, display name or category must be specified")
+	} else if objName == "" && category == "" && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Gateway Policy id, display name, tag or category must be specified")
 	} else {
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		objList, err := listGatewayPolicies(context, domain, connector)
 		if err != nil {
-			return fmt.Errorf("Error while reading Gateway Policies: %v", err)
+			return diag.Errorf("Error while reading Gateway Policies: %v", err)
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.GatewayPolicy
-		var prefixMatch []model.GatewayPolicy
+		var policies []model.GatewayPolicy
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList {
 			if category != "" && objInList.Category != nil && category != *objInList.Category {
 				continue
 			}
-			if objName != "" && objInList.DisplayName != nil {
-				if strings.HasPrefix(*objInList.DisplayName, objName) {
-					prefixMatch = append(prefixMatch, objInList)
-				}
-				if *objInList.DisplayName == objName {
-					perfectMatch = append(perfectMatch, objInList)
-				}
-			} else {
-				prefixMatch = append(prefixMatch, objInList)
-			}
+			policies = append(policies, objInList)
+			candidates = append(candidates, policyDataSourceCandidate{displayName: objInList.DisplayName, path: objInList.Path, tags: getCommonTagsFromPolicyTags(objInList.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Gateway Policies with name '%s'", objName)
-			}
-			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Gateway Policies with name starting with '%s' and category '%s'", objName, category)
-			}
-			obj = prefixMatch[0]
-		} else {
-			return fmt.Errorf("Gateway Policy with name '%s' and category '%s' was not found", objName, category)
+		criteria := ""
+		if category != "" {
+			criteria = fmt.Sprintf("category '%s'", category)
+		}
+		i, err := matcher.selectMatch("Gateway Policy", criteria, candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		obj = policies[i]
 	}
 
 	d.SetId(*obj.Id)
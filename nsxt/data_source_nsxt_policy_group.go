@@ -4,16 +4,20 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGroupRead,
+		ReadContext: dataSourceNsxtPolicyGroupRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"domain":       getDomainNameSchema(),
@@ -22,13 +26,13 @@ func dataSourceNsxtPolicyGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	domain := d.Get("domain").(string)
 	query := make(map[string]string)
 	query["parent_path"] = "*/" + domain
 	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "Group", query)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -0,0 +1,272 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/domains/groups/members"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func getPolicyGroupMemberDetailsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id":           getComputedStringSchema("ID of the member"),
+				"path":         getComputedStringSchema("Policy path of the member"),
+				"display_name": getComputedDisplayNameSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyGroupMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the group",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the enforcement point to retrieve effective members from",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"vms": {
+				Type:        schema.TypeList,
+				Description: "Effective virtual machine members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":           getComputedStringSchema("External ID of the VM"),
+						"display_name": getComputedDisplayNameSchema(),
+						"bios_id":      getComputedStringSchema("BIOS UUID of the VM"),
+						"instance_id":  getComputedStringSchema("Instance UUID of the VM"),
+					},
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "Effective IP address members of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"segments":      getPolicyGroupMemberDetailsSchema("Effective segment members of the group"),
+			"segment_ports": getPolicyGroupMemberDetailsSchema("Effective segment port members of the group"),
+			"vifs": {
+				Type:        schema.TypeList,
+				Description: "Effective virtual network interface members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                  getComputedStringSchema("External ID of the interface"),
+						"display_name":        getComputedDisplayNameSchema(),
+						"owner_vm_id":         getComputedStringSchema("External ID of the VM owning the interface"),
+						"lport_attachment_id": getComputedStringSchema("Attachment ID of the port the interface is connected to"),
+						"mac_address":         getComputedStringSchema("MAC address of the interface"),
+					},
+				},
+			},
+			"context": getContextSchema(),
+		},
+	}
+}
+
+// Calls list function for each page of group members, until all pages are retrieved.
+// List function is expected to return cursor for the next page and number of
+// members retrieved.
+func listAllPolicyGroupMemberPages(listPage func(cursor *string) (*string, int, error)) error {
+	var cursor *string
+	for {
+		nextCursor, count, err := listPage(cursor)
+		if err != nil {
+			return err
+		}
+		if nextCursor == nil || *nextCursor == "" || count == 0 {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+func getPolicyGroupMemberDetailsList(details []model.PolicyGroupMemberDetails) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, member := range details {
+		elem := make(map[string]interface{})
+		elem["id"] = member.Id
+		elem["path"] = member.Path
+		elem["display_name"] = member.DisplayName
+		result = append(result, elem)
+	}
+	return result
+}
+
+func listPolicyGroupVMMembers(sessionContext utl.SessionContext, connector client.Connector, domain string, groupID string, enforcementPointPath *string) ([]map[string]interface{}, error) {
+	vmClient := members.NewVirtualMachinesClient(sessionContext, connector)
+	if vmClient == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+	var result []map[string]interface{}
+	err := listAllPolicyGroupMemberPages(func(cursor *string) (*string, int, error) {
+		vms, err := vmClient.List(domain, groupID, cursor, enforcementPointPath, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, vm := range vms.Results {
+			computeIDMap := collectSeparatedStringListToMap(vm.ComputeIds, ":")
+			elem := make(map[string]interface{})
+			elem["id"] = vm.Id
+			elem["display_name"] = vm.DisplayName
+			elem["bios_id"] = computeIDMap[nsxtPolicyBiosUUIDKey]
+			elem["instance_id"] = computeIDMap[nsxtPolicyInstanceUUIDKey]
+			result = append(result, elem)
+		}
+		return vms.Cursor, len(vms.Results), nil
+	})
+	return result, err
+}
+
+func listPolicyGroupIPMembers(sessionContext utl.SessionContext, connector client.Connector, domain string, groupID string, enforcementPointPath *string) ([]string, error) {
+	ipClient := members.NewIpAddressesClient(sessionContext, connector)
+	if ipClient == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+	var result []string
+	err := listAllPolicyGroupMemberPages(func(cursor *string) (*string, int, error) {
+		ips, err := ipClient.List(domain, groupID, cursor, enforcementPointPath, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, ips.Results...)
+		return ips.Cursor, len(ips.Results), nil
+	})
+	return result, err
+}
+
+func listPolicyGroupSegmentMembers(sessionContext utl.SessionContext, connector client.Connector, domain string, groupID string, enforcementPointPath *string) ([]map[string]interface{}, error) {
+	segmentClient := members.NewSegmentsClient(sessionContext, connector)
+	if segmentClient == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+	var result []map[string]interface{}
+	err := listAllPolicyGroupMemberPages(func(cursor *string) (*string, int, error) {
+		segments, err := segmentClient.List(domain, groupID, cursor, enforcementPointPath, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, getPolicyGroupMemberDetailsList(segments.Results)...)
+		return segments.Cursor, len(segments.Results), nil
+	})
+	return result, err
+}
+
+func listPolicyGroupSegmentPortMembers(sessionContext utl.SessionContext, connector client.Connector, domain string, groupID string, enforcementPointPath *string) ([]map[string]interface{}, error) {
+	portClient := members.NewSegmentPortsClient(sessionContext, connector)
+	if portClient == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+	var result []map[string]interface{}
+	err := listAllPolicyGroupMemberPages(func(cursor *string) (*string, int, error) {
+		ports, err := portClient.List(domain, groupID, cursor, enforcementPointPath, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, getPolicyGroupMemberDetailsList(ports.Results)...)
+		return ports.Cursor, len(ports.Results), nil
+	})
+	return result, err
+}
+
+func listPolicyGroupVifMembers(sessionContext utl.SessionContext, connector client.Connector, domain string, groupID string, enforcementPointPath *string) ([]map[string]interface{}, error) {
+	vifClient := members.NewVifsClient(sessionContext, connector)
+	if vifClient == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+	var result []map[string]interface{}
+	err := listAllPolicyGroupMemberPages(func(cursor *string) (*string, int, error) {
+		vifs, err := vifClient.List(domain, groupID, cursor, enforcementPointPath, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, vif := range vifs.Results {
+			elem := make(map[string]interface{})
+			elem["id"] = vif.ExternalId
+			elem["display_name"] = vif.DisplayName
+			elem["owner_vm_id"] = vif.OwnerVmId
+			elem["lport_attachment_id"] = vif.LportAttachmentId
+			elem["mac_address"] = vif.MacAddress
+			result = append(result, elem)
+		}
+		return vifs.Cursor, len(vifs.Results), nil
+	})
+	return result, err
+}
+
+func dataSourceNsxtPolicyGroupMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	sessionContext := getSessionContext(d, m)
+
+	groupPath := d.Get("group_path").(string)
+	domain := getDomainFromResourcePath(groupPath)
+	groupID := getPolicyIDFromPath(groupPath)
+	if domain == "" || groupID == "" {
+		return diag.Errorf("Invalid group path %s", groupPath)
+	}
+	var enforcementPointPath *string
+	if path := d.Get("enforcement_point_path").(string); path != "" {
+		enforcementPointPath = &path
+	}
+
+	vms, err := listPolicyGroupVMMembers(sessionContext, connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return diag.FromErr(handleDataSourceReadError(d, "Group VM members", groupPath, err))
+	}
+	ips, err := listPolicyGroupIPMembers(sessionContext, connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return diag.FromErr(handleDataSourceReadError(d, "Group IP members", groupPath, err))
+	}
+	segments, err := listPolicyGroupSegmentMembers(sessionContext, connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return diag.FromErr(handleDataSourceReadError(d, "Group Segment members", groupPath, err))
+	}
+	ports, err := listPolicyGroupSegmentPortMembers(sessionContext, connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return diag.FromErr(handleDataSourceReadError(d, "Group Segment Port members", groupPath, err))
+	}
+	vifs, err := listPolicyGroupVifMembers(sessionContext, connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return diag.FromErr(handleDataSourceReadError(d, "Group VIF members", groupPath, err))
+	}
+
+	d.SetId(newUUID())
+	d.Set("ip_addresses", ips)
+	for attr, value := range map[string][]map[string]interface{}{
+		"vms":           vms,
+		"segments":      segments,
+		"segment_ports": ports,
+		"vifs":          vifs,
+	} {
+		if err := d.Set(attr, value); err != nil {
+			return diag.Errorf("Error setting %s: %v", attr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyGroupMembers_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupMembersBasic(t, false, func() {
+		testAccPreCheck(t)
+		testAccOnlyLocalManager(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyGroupMembers_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupMembersBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyGroupMembersBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_group_members.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupMembersReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(testResourceName, "ip_addresses.*", "10.1.1.1"),
+					resource.TestCheckTypeSetElemAttr(testResourceName, "ip_addresses.*", "10.1.2.0/24"),
+					resource.TestCheckResourceAttr(testResourceName, "vms.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "segments.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "segment_ports.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "vifs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupMembersReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+%s
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["10.1.1.1", "10.1.2.0/24"]
+    }
+  }
+}
+
+data "nsxt_policy_group_members" "test" {
+%s
+  group_path = nsxt_policy_group.test.path
+}`, context, name, context)
+}
+
+func TestMockNsxPolicyGroupMembersDataSource(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	groupPath := "/infra/domains/default/groups/web"
+	var ips []interface{}
+	for i := 0; i < mockNsxDefaultPageSize+10; i++ {
+		ips = append(ips, fmt.Sprintf("10.%d.%d.1", i/250, i%250))
+	}
+	server.setGroupMembers(groupPath, "ip-addresses", ips)
+	server.setGroupMembers(groupPath, "virtual-machines", []interface{}{
+		map[string]interface{}{
+			"id":           "vm-1",
+			"display_name": "web-1",
+			"compute_ids":  []interface{}{"biosUuid:bios-1", "instanceUuid:instance-1"},
+		},
+	})
+	server.setGroupMembers(groupPath, "segments", []interface{}{
+		map[string]interface{}{"id": "web", "display_name": "web", "path": "/infra/segments/web"},
+	})
+	server.setGroupMembers(groupPath, "segment-ports", []interface{}{})
+	server.setGroupMembers(groupPath, "vifs", []interface{}{
+		map[string]interface{}{"external_id": "vif-1", "owner_vm_id": "vm-1", "lport_attachment_id": "attachment-1"},
+	})
+
+	dataSource := dataSourceNsxtPolicyGroupMembers()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"group_path": groupPath,
+	})
+	if diags := dataSource.ReadContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Read failed: %v", diags)
+	}
+	if count := d.Get("ip_addresses.#").(int); count != len(ips) {
+		t.Fatalf("Expected %d IP addresses across pages, got %d", len(ips), count)
+	}
+	if d.Get("vms.0.id").(string) != "vm-1" || d.Get("vms.0.bios_id").(string) != "bios-1" {
+		t.Fatalf("Unexpected VM members %v", d.Get("vms"))
+	}
+	if d.Get("segments.0.path").(string) != "/infra/segments/web" || d.Get("segment_ports.#").(int) != 0 {
+		t.Fatalf("Unexpected segment members %v, ports %v", d.Get("segments"), d.Get("segment_ports"))
+	}
+	if d.Get("vifs.0.owner_vm_id").(string) != "vm-1" {
+		t.Fatalf("Unexpected VIF members %v", d.Get("vifs"))
+	}
+}
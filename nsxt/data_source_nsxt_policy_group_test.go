@@ -91,6 +91,28 @@ func TestAccDataSourceNsxtPolicyGroup_withSite(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNsxtPolicyGroup_matchMode(t *testing.T) {
+	name := getAccTestDataSourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupMatchModeTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nsxt_policy_group.exact", "display_name", name),
+					resource.TestCheckResourceAttr("data.nsxt_policy_group.regex", "display_name", name+"-legacy"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_group.tag", "display_name", name+"-legacy"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceNsxtPolicyGroupCreate(domain string, name string) error {
 	connector, err := testAccGetPolicyConnector()
 	if err != nil {
@@ -152,3 +174,42 @@ data "nsxt_policy_group" "test" {
   domain       = "%s"
 }`, context, name, domain)
 }
+
+func testAccNsxtPolicyGroupMatchModeTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_group" "legacy" {
+  display_name = "%s-legacy"
+
+  tag {
+    scope = "lifecycle"
+    tag   = "legacy"
+  }
+}
+
+data "nsxt_policy_group" "exact" {
+  display_name = "%s"
+  match_mode   = "exact"
+  depends_on   = [nsxt_policy_group.test, nsxt_policy_group.legacy]
+}
+
+data "nsxt_policy_group" "regex" {
+  display_name = "^%s-leg.*$"
+  match_mode   = "regex"
+  depends_on   = [nsxt_policy_group.test, nsxt_policy_group.legacy]
+}
+
+data "nsxt_policy_group" "tag" {
+  display_name = "%s"
+  match_mode   = "prefix"
+
+  tag {
+    scope = "lifecycle"
+    tag   = "legacy"
+  }
+  depends_on = [nsxt_policy_group.test, nsxt_policy_group.legacy]
+}`, name, name, name, name, name)
+}
@@ -0,0 +1,73 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGroups_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_groups.test"
+	tagResourceName := "data.nsxt_policy_groups.tagged"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.display_name", fmt.Sprintf("%s-1", name)),
+					resource.TestCheckResourceAttr(testResourceName, "results.1.display_name", fmt.Sprintf("%s-2", name)),
+					resource.TestCheckResourceAttrSet(testResourceName, "results.0.id"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.parent_path", "/infra/domains/default"),
+					resource.TestCheckResourceAttr(testResourceName, "items.%", "2"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s-1", name), "nsxt_policy_group.test1", "path"),
+					resource.TestCheckResourceAttr(tagResourceName, "results.#", "1"),
+					resource.TestCheckResourceAttrPair(tagResourceName, "results.0.path", "nsxt_policy_group.test2", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test1" {
+  display_name = "%s-1"
+}
+
+resource "nsxt_policy_group" "test2" {
+  display_name = "%s-2"
+
+  tag {
+    scope = "list-test"
+    tag   = "%s"
+  }
+}
+
+data "nsxt_policy_groups" "test" {
+  display_name_regex = "^%s-[0-9]$"
+  parent_path        = "/infra/domains/default"
+
+  depends_on = [nsxt_policy_group.test1, nsxt_policy_group.test2]
+}
+
+data "nsxt_policy_groups" "tagged" {
+  tag {
+    scope = "list-test"
+    tag   = "%s"
+  }
+
+  depends_on = [nsxt_policy_group.test1, nsxt_policy_group.test2]
+}`, name, name, name, name, name)
+}
@@ -4,6 +4,8 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -11,11 +13,13 @@ import (
 
 func dataSourceNsxtPolicyHostTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyHostTransportNodeRead,
+		ReadContext: dataSourceNsxtPolicyHostTransportNodeRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"unique_id": {
@@ -27,15 +31,15 @@ func dataSourceNsxtPolicyHostTransportNode() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyHostTransportNodeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyHostTransportNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	obj, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "HostTransportNode", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	converter := bindings.NewTypeConverter()
 	dataValue, errors := converter.ConvertToGolang(obj, model.HostTransportNodeBindingType())
 	if len(errors) > 0 {
-		return errors[0]
+		return diag.FromErr(errors[0])
 	}
 	htn := dataValue.(model.HostTransportNode)
 	d.Set("unique_id", htn.UniqueId)
@@ -4,32 +4,36 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyIntrusionServiceProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIntrusionServiceProfileRead,
+		ReadContext: dataSourceNsxtPolicyIntrusionServiceProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtPolicyIntrusionServiceProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIntrusionServiceProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	if isPolicyGlobalManager(m) {
-		return localManagerOnlyError()
+		return diag.FromErr(localManagerOnlyError())
 	}
 
 	_, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "IdsProfile", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
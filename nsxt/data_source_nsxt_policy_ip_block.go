@@ -4,9 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
-	"strings"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
@@ -15,11 +15,13 @@ import (
 
 func dataSourceNsxtPolicyIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPBlockRead,
+		ReadContext: dataSourceNsxtPolicyIPBlockRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(),
@@ -27,7 +29,7 @@ func dataSourceNsxtPolicyIPBlock() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIPBlockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(getSessionContext(d, m), connector)
 
@@ -38,41 +40,29 @@ func dataSourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) erro
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return handleDataSourceReadError(d, "IpAddressBlock", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "IpAddressBlock", objID, err))
 		}
 		obj = objGet
-	} else if objName == "" {
-		return fmt.Errorf("Error obtaining IpAddressBlock ID or name during read")
+	} else if objName == "" && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Error obtaining IpAddressBlock ID, name or tag during read")
 	} else {
-		// Get by full name/prefix
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		objList, err := client.List(nil, nil, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("IpAddressBlock", err)
+			return diag.FromErr(handleListError("IpAddressBlock", err))
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.IpAddressBlock
-		var prefixMatch []model.IpAddressBlock
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList.Results {
-			if strings.HasPrefix(*objInList.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, objInList)
-			}
-			if *objInList.DisplayName == objName {
-				perfectMatch = append(perfectMatch, objInList)
-			}
+			candidates = append(candidates, policyDataSourceCandidate{displayName: objInList.DisplayName, path: objInList.Path, tags: getCommonTagsFromPolicyTags(objInList.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple IpAddressBlocks with name '%s'", objName)
-			}
-			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple IpAddressBlocks with name starting with '%s'", objName)
-			}
-			obj = prefixMatch[0]
-		} else {
-			return fmt.Errorf("IpAddressBlock with name '%s' was not found", objName)
+		i, err := matcher.selectMatch("IpAddressBlock", "", candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		obj = objList.Results[i]
 	}
 
 	d.SetId(*obj.Id)
@@ -4,6 +4,8 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -12,11 +14,13 @@ import (
 
 func dataSourceNsxtPolicyIPPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPPoolRead,
+		ReadContext: dataSourceNsxtPolicyIPPoolRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(),
@@ -29,16 +33,16 @@ func dataSourceNsxtPolicyIPPool() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	obj, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "IpAddressPool", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	converter := bindings.NewTypeConverter()
 	dataValue, errors := converter.ConvertToGolang(obj, model.IpAddressPoolBindingType())
 	if len(errors) > 0 {
-		return errors[0]
+		return diag.FromErr(errors[0])
 	}
 	pool := dataValue.(model.IpAddressPool)
 	d.Set("realized_id", pool.RealizationId)
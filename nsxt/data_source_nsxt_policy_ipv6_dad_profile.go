@@ -4,16 +4,20 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyIpv6DadProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIpv6DadProfileRead,
+		ReadContext: dataSourceNsxtPolicyIpv6DadProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(),
@@ -21,10 +25,10 @@ func dataSourceNsxtPolicyIpv6DadProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIpv6DadProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIpv6DadProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "Ipv6DadProfile", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	return nil
 }
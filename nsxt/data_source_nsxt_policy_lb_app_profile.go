@@ -4,9 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -24,7 +25,7 @@ var lbAppProfileTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLBAppProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBAppProfileRead,
+		ReadContext: dataSourceNsxtPolicyLBAppProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
@@ -36,6 +37,8 @@ func dataSourceNsxtPolicyLBAppProfile() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(lbAppProfileTypeValues, false),
 			},
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
@@ -61,7 +64,7 @@ func policyLbAppProfileConvert(obj *data.StructValue, requestedType string) (*mo
 	return &profile, nil
 }
 
-func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLBAppProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbAppProfilesClient(connector)
 
@@ -75,59 +78,49 @@ func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{})
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LBAppProfile", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "LBAppProfile", objID, err))
 		}
 		result, err = policyLbAppProfileConvert(objGet, objType)
 		if err != nil {
-			return fmt.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
+			return diag.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
 		}
 		if result == nil {
-			return fmt.Errorf("LBAppProfile with ID '%s' and type %s was not found", objID, objType)
+			return diag.Errorf("LBAppProfile with ID '%s' and type %s was not found", objID, objType)
 		}
-	} else if objName == "" && !typeSet {
-		return fmt.Errorf("Error obtaining LBAppProfile ID or name or type during read")
+	} else if objName == "" && !typeSet && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Error obtaining LBAppProfile ID or name or type or tag during read")
 	} else {
-		// Get by full name/prefix
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LBAppProfile", err)
+			return diag.FromErr(handleListError("LBAppProfile", err))
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.LBAppProfile
-		var prefixMatch []model.LBAppProfile
+		var objs []model.LBAppProfile
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList.Results {
 			obj, err := policyLbAppProfileConvert(objInList, objType)
 			if err != nil {
-				return fmt.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
+				return diag.Errorf("Error while converting LBAppProfile: %v", err)
 			}
 			if obj == nil {
 				continue
 			}
-			if objName != "" && obj.DisplayName != nil && strings.HasPrefix(*obj.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, *obj)
-			}
-			if obj.DisplayName != nil && *obj.DisplayName == objName {
-				perfectMatch = append(perfectMatch, *obj)
-			}
-			if objName == "" && typeSet {
-				// match only by type
-				perfectMatch = append(perfectMatch, *obj)
-			}
+			objs = append(objs, *obj)
+			candidates = append(candidates, policyDataSourceCandidate{displayName: obj.DisplayName, path: obj.Path, tags: getCommonTagsFromPolicyTags(obj.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LBAppProfiles with name '%s'", objName)
-			}
-			result = &perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LBAppProfiles with name starting with '%s'", objName)
-			}
-			result = &prefixMatch[0]
-		} else {
-			return fmt.Errorf("LBAppProfile with name '%s' and type %s was not found", objName, objType)
+		criteria := ""
+		if objType != "ANY" {
+			criteria = fmt.Sprintf("type %s", objType)
+		}
+		i, err := matcher.selectMatch("LBAppProfile", criteria, candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		result = &objs[i]
 	}
 
 	d.SetId(*result.Id)
@@ -4,9 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
-	"strings"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,18 +14,20 @@ import (
 
 func dataSourceNsxtPolicyLBClientSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBClientSslProfileRead,
+		ReadContext: dataSourceNsxtPolicyLBClientSslProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLBClientSslProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbClientSslProfilesClient(connector)
 
@@ -37,42 +39,30 @@ func dataSourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interf
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LBClientSslProfile", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "LBClientSslProfile", objID, err))
 		}
 		obj = objGet
-	} else if objName == "" {
-		return fmt.Errorf("Error obtaining LBClientSslProfile ID or name during read")
+	} else if objName == "" && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Error obtaining LBClientSslProfile ID, name or tag during read")
 	} else {
-		// Get by full name/prefix
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LBClientSslProfile", err)
+			return diag.FromErr(handleListError("LBClientSslProfile", err))
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.LBClientSslProfile
-		var prefixMatch []model.LBClientSslProfile
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList.Results {
-			if strings.HasPrefix(*objInList.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, objInList)
-			}
-			if *objInList.DisplayName == objName {
-				perfectMatch = append(perfectMatch, objInList)
-			}
+			candidates = append(candidates, policyDataSourceCandidate{displayName: objInList.DisplayName, path: objInList.Path, tags: getCommonTagsFromPolicyTags(objInList.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LBClientSslProfiles with name '%s'", objName)
-			}
-			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LBClientSslProfiles with name starting with '%s'", objName)
-			}
-			obj = prefixMatch[0]
-		} else {
-			return fmt.Errorf("LBClientSslProfile with name '%s' was not found", objName)
+		i, err := matcher.selectMatch("LBClientSslProfile", "", candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		obj = objList.Results[i]
 	}
 
 	d.SetId(*obj.Id)
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -27,7 +28,7 @@ var lbMonitorTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLBMonitor() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBMonitorRead,
+		ReadContext: dataSourceNsxtPolicyLBMonitorRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
@@ -39,6 +40,8 @@ func dataSourceNsxtPolicyLBMonitor() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(lbMonitorTypeValues, false),
 			},
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
@@ -64,7 +67,7 @@ func policyLbMonitorConvert(obj *data.StructValue, requestedType string) (*model
 	return &profile, nil
 }
 
-func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLBMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbMonitorProfilesClient(connector)
 
@@ -78,59 +81,49 @@ func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) er
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LBMonitor", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "LBMonitor", objID, err))
 		}
 		result, err = policyLbMonitorConvert(objGet, objType)
 		if err != nil {
-			return fmt.Errorf("Error while converting LBMonitor %s: %v", objID, err)
+			return diag.Errorf("Error while converting LBMonitor %s: %v", objID, err)
 		}
 		if result == nil {
-			return fmt.Errorf("LBMonitor with ID '%s' and type %s was not found", objID, objType)
+			return diag.Errorf("LBMonitor with ID '%s' and type %s was not found", objID, objType)
 		}
-	} else if objName == "" && !typeSet {
-		return fmt.Errorf("Error obtaining LBMonitor ID or name or type during read")
+	} else if objName == "" && !typeSet && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Error obtaining LBMonitor ID or name or type or tag during read")
 	} else {
-		// Get by full name/prefix
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LBMonitor", err)
+			return diag.FromErr(handleListError("LBMonitor", err))
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.LBMonitorProfile
-		var prefixMatch []model.LBMonitorProfile
+		var objs []model.LBMonitorProfile
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList.Results {
 			obj, err := policyLbMonitorConvert(objInList, objType)
 			if err != nil {
-				return fmt.Errorf("Error while converting LBMonitor %s: %v", objID, err)
+				return diag.Errorf("Error while converting LBMonitor: %v", err)
 			}
 			if obj == nil {
 				continue
 			}
-			if objName != "" && obj.DisplayName != nil && strings.HasPrefix(*obj.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, *obj)
-			}
-			if obj.DisplayName != nil && *obj.DisplayName == objName {
-				perfectMatch = append(perfectMatch, *obj)
-			}
-			if objName == "" && typeSet {
-				// match only by type
-				perfectMatch = append(perfectMatch, *obj)
-			}
+			objs = append(objs, *obj)
+			candidates = append(candidates, policyDataSourceCandidate{displayName: obj.DisplayName, path: obj.Path, tags: getCommonTagsFromPolicyTags(obj.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LBMonitors with name '%s'", objName)
-			}
-			result = &perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LBMonitors with name starting with '%s'", objName)
-			}
-			result = &prefixMatch[0]
-		} else {
-			return fmt.Errorf("LBMonitor with name '%s' and type %s was not found", objName, objType)
+		criteria := ""
+		if objType != "ANY" {
+			criteria = fmt.Sprintf("type %s", objType)
+		}
+		i, err := matcher.selectMatch("LBMonitor", criteria, candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		result = &objs[i]
 	}
 
 	d.SetId(*result.Id)
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -23,7 +24,7 @@ var lbPersistenceTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLbPersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLbPersistenceProfileRead,
+		ReadContext: dataSourceNsxtPolicyLbPersistenceProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
@@ -52,7 +53,7 @@ func dataSourceNsxtPolicyLbPersistenceProfileTypeMatches(profile model.LBPersist
 	return false
 }
 
-func dataSourceNsxtPolicyLbPersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLbPersistenceProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPersistenceProfilesClient(connector)
 	converter := bindings.NewTypeConverter()
@@ -68,28 +69,28 @@ func dataSourceNsxtPolicyLbPersistenceProfileRead(d *schema.ResourceData, m inte
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LbPersistenceProfile", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "LbPersistenceProfile", objID, err))
 		}
 		profile, errs := converter.ConvertToGolang(objGet, model.LBPersistenceProfileBindingType())
 		if errs != nil {
-			return errs[0]
+			return diag.FromErr(errs[0])
 		}
 		obj = profile.(model.LBPersistenceProfile)
 	} else if objName == "" && !typeSet {
-		return fmt.Errorf("Error obtaining LbPersistenceProfile name or type during read")
+		return diag.Errorf("Error obtaining LbPersistenceProfile name or type during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LbPersistenceProfile", err)
+			return diag.FromErr(handleListError("LbPersistenceProfile", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch, prefixMatch []model.LBPersistenceProfile
 		for _, objInList := range objList.Results {
 			profile, errs := converter.ConvertToGolang(objInList, model.LBPersistenceProfileBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 			lbProfile := profile.(model.LBPersistenceProfile)
 
@@ -107,16 +108,16 @@ func dataSourceNsxtPolicyLbPersistenceProfileRead(d *schema.ResourceData, m inte
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LbPersistenceProfiles with name '%s' and type '%s'", objName, objType)
+				return diag.Errorf("Found multiple LbPersistenceProfiles with name '%s' and type '%s'", objName, objType)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LbPersistenceProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple LbPersistenceProfiles with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("LbPersistenceProfile with name '%s' and type '%s' was not found", objName, objType)
+			return diag.Errorf("LbPersistenceProfile with name '%s' and type '%s' was not found", objName, objType)
 		}
 	}
 
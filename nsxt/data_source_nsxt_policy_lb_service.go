@@ -4,28 +4,32 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyLbService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLbServiceRead,
+		ReadContext: dataSourceNsxtPolicyLbServiceRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtPolicyLbServiceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLbServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	_, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "LBService", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
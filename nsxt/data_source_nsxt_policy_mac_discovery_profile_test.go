@@ -41,7 +41,7 @@ func TestAccDataSourceNsxtPolicyMacDiscoveryProfile_prefix(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccNsxtPolicyMacDiscoveryProfileReadTemplate(namePrefix),
+				Config: testAccNsxtPolicyMacDiscoveryProfileReadPrefixTemplate(namePrefix),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
 					resource.TestCheckResourceAttr(testResourceName, "description", "Default MacDiscovery Profile for ENS"),
@@ -81,6 +81,14 @@ data "nsxt_policy_mac_discovery_profile" "test" {
 }`, name)
 }
 
+func testAccNsxtPolicyMacDiscoveryProfileReadPrefixTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_mac_discovery_profile" "test" {
+  display_name = "%s"
+  match_mode   = "prefix"
+}`, name)
+}
+
 func testAccNsxtPolicyMacDiscoveryProfileMultitenancyTemplate(name string) string {
 	context := testAccNsxtPolicyMultitenancyContext()
 	return fmt.Sprintf(`
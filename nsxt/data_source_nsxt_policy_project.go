@@ -4,9 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
-	"strings"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs"
@@ -16,13 +16,20 @@ const defaultOrgID = "default"
 
 func dataSourceNsxtPolicyProject() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyProjectRead,
+		ReadContext: dataSourceNsxtPolicyProjectRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
+			"org_id": {
+				Type:        schema.TypeString,
+				Description: "Id of the organization which the project belongs to. Default organization is used if not specified.",
+				Optional:    true,
+			},
 			"short_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -57,7 +64,7 @@ func dataSourceNsxtPolicyProject() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyProjectRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewProjectsClient(connector)
 
@@ -65,48 +72,37 @@ func dataSourceNsxtPolicyProjectRead(d *schema.ResourceData, m interface{}) erro
 	// this data source fetches extra attributes, e.g site_info and tier0_gateway_paths, it's simpler to implement using .List()
 	// instead of using search API.
 
+	orgID := getPolicyProjectOrgID(d)
 	objID := d.Get("id").(string)
 	objName := d.Get("display_name").(string)
 	var obj model.Project
 	if objID != "" {
 		// Get by id
-		objGet, err := client.Get(defaultOrgID, objID)
+		objGet, err := client.Get(orgID, objID)
 		if err != nil {
-			return handleDataSourceReadError(d, "Project", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "Project", objID, err))
 		}
 		obj = objGet
-	} else if objName == "" {
-		return fmt.Errorf("Error obtaining Project ID or name during read")
+	} else if objName == "" && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Error obtaining Project ID, name or tag during read")
 	} else {
-		// Get by full name/prefix
-		objList, err := client.List(defaultOrgID, nil, nil, nil, nil, nil, nil, nil)
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		objList, err := client.List(orgID, nil, nil, nil, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("Project", err)
+			return diag.FromErr(handleListError("Project", err))
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.Project
-		var prefixMatch []model.Project
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList.Results {
-			if strings.HasPrefix(*objInList.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, objInList)
-			}
-			if *objInList.DisplayName == objName {
-				perfectMatch = append(perfectMatch, objInList)
-			}
+			candidates = append(candidates, policyDataSourceCandidate{displayName: objInList.DisplayName, path: objInList.Path, tags: getCommonTagsFromPolicyTags(objInList.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Project with name '%s'", objName)
-			}
-			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Projects with name starting with '%s'", objName)
-			}
-			obj = prefixMatch[0]
-		} else {
-			return fmt.Errorf("Project with name '%s' was not found", objName)
+		i, err := matcher.selectMatch("Project", "", candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		obj = objList.Results[i]
 	}
 
 	d.SetId(*obj.Id)
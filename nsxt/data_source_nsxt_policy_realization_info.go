@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceNsxtPolicyRealizationInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyRealizationInfoRead,
+		ReadContext: dataSourceNsxtPolicyRealizationInfoRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
@@ -68,7 +69,7 @@ func dataSourceNsxtPolicyRealizationInfo() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyRealizationInfoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read the realization info by the path, and wait till it is valid
 	connector := getPolicyConnector(m)
 
@@ -81,11 +82,11 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 
 	// Site is mandatory got GM and irrelevant else
 	if !isPolicyGlobalManager(m) && objSitePath != "" {
-		return globalManagerOnlyError()
+		return diag.FromErr(globalManagerOnlyError())
 	}
 	if isPolicyGlobalManager(m) {
 		if objSitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_realization_info")
+			return diag.FromErr(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_realization_info"))
 		}
 	}
 
@@ -148,7 +149,7 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 	}
 	_, err := stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
+		return diag.Errorf("Failed to get realization information for %s: %v", path, err)
 	}
 	return nil
 }
@@ -0,0 +1,194 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+// Fields that are always retrieved with field projection, since they are
+// exported for every result
+var policySearchResultFields = []string{"id", "path", "display_name", "description", "resource_type", "parent_path", "tags"}
+
+func dataSourceNsxtPolicySearch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicySearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Resource type of objects to search for, for example Segment or Group",
+				Optional:    true,
+			},
+			"query": {
+				Type:        schema.TypeString,
+				Description: "Search query in Lucene syntax, for example display_name:web*",
+				Optional:    true,
+			},
+			"tag": getDataSourceTagFilterSchema(),
+			"included_fields": {
+				Type:        schema.TypeList,
+				Description: "Object fields to retrieve and export in fields attribute of the results",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Description: "Objects matching the search criteria",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":            getComputedStringSchema("ID of the object"),
+						"path":          getComputedStringSchema("Policy path of the object"),
+						"display_name":  getComputedDisplayNameSchema(),
+						"description":   getComputedStringSchema("Description of the object"),
+						"resource_type": getComputedStringSchema("Resource type of the object"),
+						"parent_path":   getComputedStringSchema("Policy path of the parent object"),
+						"tag": {
+							Type:        schema.TypeList,
+							Description: "Tags of the object",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scope": getComputedStringSchema("Tag scope"),
+									"tag":   getComputedStringSchema("Tag value"),
+								},
+							},
+						},
+						"fields": {
+							Type:        schema.TypeMap,
+							Description: "Values of included fields, with non-string values encoded in JSON",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"context": getContextSchema(),
+		},
+	}
+}
+
+func buildPolicySearchQuery(resourceType string, query string, tags []common.Tag) string {
+	terms := []string{"marked_for_delete:false"}
+	if resourceType != "" {
+		terms = append(terms, fmt.Sprintf("resource_type:%s", resourceType))
+	}
+	if query != "" {
+		terms = append(terms, fmt.Sprintf("(%s)", query))
+	}
+	if tagsQuery := buildPolicyTagsQuery(tags); tagsQuery != "" {
+		terms = append(terms, tagsQuery)
+	}
+	return strings.Join(terms, " AND ")
+}
+
+func getPolicySearchIncludedFields(fields []interface{}) *string {
+	if len(fields) == 0 {
+		return nil
+	}
+	includedFields := append([]string{}, policySearchResultFields...)
+	for _, field := range fields {
+		includedFields = append(includedFields, field.(string))
+	}
+	result := strings.Join(includedFields, ",")
+	return &result
+}
+
+// Returns values of included fields of search result. Nested fields are
+// exported by their top level field.
+func getPolicySearchResultFields(result *data.StructValue, fields []interface{}) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	encoder := cleanjson.NewDataValueToJsonEncoder()
+	for _, field := range fields {
+		name := strings.Split(field.(string), ".")[0]
+		if _, ok := values[name]; ok || !result.HasField(name) {
+			continue
+		}
+		value, err := result.Field(name)
+		if err != nil {
+			return nil, err
+		}
+		if stringValue, ok := value.(*data.StringValue); ok {
+			values[name] = stringValue.Value()
+			continue
+		}
+		encoded, err := encoder.Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		values[name] = encoded
+	}
+	return values, nil
+}
+
+func dataSourceNsxtPolicySearchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	resourceType := d.Get("resource_type").(string)
+	query := d.Get("query").(string)
+	tags := getDataSourceTagFilterFromSchema(d)
+	fields := d.Get("included_fields").([]interface{})
+	if resourceType == "" && query == "" && len(tags) == 0 {
+		return diag.Errorf("At least one of resource_type, query or tag needs to be specified")
+	}
+
+	searchQuery := buildPolicySearchQuery(resourceType, query, tags)
+	resultValues, err := searchPolicyResources(connector, getSessionContext(d, m), searchQuery, getPolicySearchIncludedFields(fields))
+	if err != nil {
+		return diag.Errorf("Failed to search policy objects with query %s: %v", searchQuery, err)
+	}
+
+	var results []map[string]interface{}
+	for _, result := range resultValues {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return diag.FromErr(errs[0])
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		if !policyTagsMatch(tags, getCommonTagsFromSearchResult(policyResource.Tags)) {
+			continue
+		}
+
+		elem := make(map[string]interface{})
+		elem["id"] = policyResource.Id
+		elem["path"] = policyResource.Path
+		elem["display_name"] = policyResource.DisplayName
+		elem["description"] = policyResource.Description
+		elem["resource_type"] = policyResource.ResourceType
+		elem["parent_path"] = policyResource.ParentPath
+		var tagList []map[string]interface{}
+		for _, tag := range policyResource.Tags {
+			tagList = append(tagList, map[string]interface{}{"scope": tag.Scope, "tag": tag.Tag})
+		}
+		elem["tag"] = tagList
+		elem["fields"], err = getPolicySearchResultFields(result, fields)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		results = append(results, elem)
+	}
+
+	d.SetId(newUUID())
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicySearch_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_search.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySearchReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "results.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.resource_type", "Group"),
+					resource.TestCheckResourceAttrSet(testResourceName, "results.0.id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "results.0.path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "results.0.parent_path"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.fields.%", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "results.0.fields._revision"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySearchReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test1" {
+  display_name = "%s-1"
+
+  tag {
+    scope = "search-test"
+    tag   = "%s"
+  }
+}
+
+resource "nsxt_policy_group" "test2" {
+  display_name = "%s-2"
+
+  tag {
+    scope = "search-test"
+    tag   = "%s"
+  }
+}
+
+resource "nsxt_policy_group" "test3" {
+  display_name = "%s-3"
+}
+
+data "nsxt_policy_search" "test" {
+  resource_type   = "Group"
+  query           = "display_name:%s*"
+  included_fields = ["_revision"]
+
+  tag {
+    scope = "search-test"
+    tag   = "%s"
+  }
+
+  depends_on = [nsxt_policy_group.test1, nsxt_policy_group.test2, nsxt_policy_group.test3]
+}`, name, name, name, name, name, name, name)
+}
+
+func TestMockNsxPolicySearchDataSource(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	group := resourceNsxtPolicyGroup()
+	for i, tag := range []string{"blue", "blue", "red"} {
+		d := schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{
+			"display_name": fmt.Sprintf("mock-search-%d", i),
+			"tag":          []interface{}{map[string]interface{}{"scope": "color", "tag": tag}},
+		})
+		if diags := group.CreateContext(context.Background(), d, m); diags.HasError() {
+			t.Fatalf("Create failed: %v", diags)
+		}
+	}
+
+	dataSource := dataSourceNsxtPolicySearch()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"resource_type":   "Group",
+		"query":           "display_name:mock-search*",
+		"included_fields": []interface{}{"_revision"},
+		"tag":             []interface{}{map[string]interface{}{"scope": "color", "tag": "blue"}},
+	})
+	if diags := dataSource.ReadContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Read failed: %v", diags)
+	}
+	if count := d.Get("results.#").(int); count != 2 {
+		t.Fatalf("Expected 2 results, got %d", count)
+	}
+	if d.Get("results.0.fields._revision").(string) != "0" {
+		t.Fatalf("Expected included field _revision in results, got %v", d.Get("results.0.fields"))
+	}
+	if d.Get("results.1.tag.0.tag").(string) != "blue" {
+		t.Fatalf("Expected results to be filtered by tag, got %v", d.Get("results.1.tag"))
+	}
+}
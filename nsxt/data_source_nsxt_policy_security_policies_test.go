@@ -0,0 +1,49 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySecurityPolicies_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_security_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPoliciesReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "results.0.path", "nsxt_policy_security_policy.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s", name), "nsxt_policy_security_policy.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySecurityPoliciesReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+}
+
+data "nsxt_policy_security_policies" "test" {
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_security_policy.test]
+}`, name, name)
+}
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,11 +19,13 @@ import (
 
 func dataSourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySecurityPolicyRead,
+		ReadContext: dataSourceNsxtPolicySecurityPolicyRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(),
@@ -71,7 +74,7 @@ func listSecurityPolicies(context utl.SessionContext, domain string, connector c
 	}
 }
 
-func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	category := d.Get("category").(string)
@@ -88,23 +91,26 @@ func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{
 		client := domains.NewSecurityPoliciesClient(context, connector)
 		objGet, err := client.Get(domain, objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("Security Policy with ID %s was not found", objID)
+			return diag.Errorf("Security Policy with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Security Policy %s: %v", objID, err)
+			return diag.Errorf("Error while reading Security Policy %s: %v", objID, err)
 		}
 		obj = objGet
-	} else if objName == "" && category == "" {
-		return fmt.Errorf("Security Policy id, display name or category must be specified")
+	} else if objName == "" && category == "" && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Security Policy id, display name, tag or category must be specified")
 	} else {
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		objList, err := listSecurityPolicies(context, domain, connector)
 		if err != nil {
-			return fmt.Errorf("Error while reading Security Policies: %v", err)
+			return diag.Errorf("Error while reading Security Policies: %v", err)
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.SecurityPolicy
-		var prefixMatch []model.SecurityPolicy
+		var policies []model.SecurityPolicy
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList {
 			if category != "" && objInList.Category != nil && category != *objInList.Category {
 				continue
@@ -112,30 +118,18 @@ func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{
 			if objInList.IsDefault != nil && *objInList.IsDefault != isDefault {
 				continue
 			}
-			if objName != "" && objInList.DisplayName != nil {
-				if strings.HasPrefix(*objInList.DisplayName, objName) {
-					prefixMatch = append(prefixMatch, objInList)
-				}
-				if *objInList.DisplayName == objName {
-					perfectMatch = append(perfectMatch, objInList)
-				}
-			} else {
-				prefixMatch = append(prefixMatch, objInList)
-			}
+			policies = append(policies, objInList)
+			candidates = append(candidates, policyDataSourceCandidate{displayName: objInList.DisplayName, path: objInList.Path, tags: getCommonTagsFromPolicyTags(objInList.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Security Policies with name '%s'", objName)
-			}
-			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Security Policies with name starting with '%s' and category '%s'", objName, category)
-			}
-			obj = prefixMatch[0]
-		} else {
-			return fmt.Errorf("Security Policy with name '%s' and category '%s' was not found", objName, category)
+		criteria := ""
+		if category != "" {
+			criteria = fmt.Sprintf("category '%s'", category)
+		}
+		i, err := matcher.selectMatch("Security Policy", criteria, candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		obj = policies[i]
 	}
 
 	d.SetId(*obj.Id)
@@ -4,16 +4,20 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicySegment() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySegmentRead,
+		ReadContext: dataSourceNsxtPolicySegmentRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(),
@@ -21,12 +25,12 @@ func dataSourceNsxtPolicySegment() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicySegmentRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	_, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "Segment", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
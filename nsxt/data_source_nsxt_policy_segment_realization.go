@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -18,7 +19,7 @@ import (
 
 func dataSourceNsxtPolicySegmentRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySegmentRealizationRead,
+		ReadContext: dataSourceNsxtPolicySegmentRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":      getDataSourceIDSchema(),
@@ -43,7 +44,7 @@ func dataSourceNsxtPolicySegmentRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySegmentRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read the realization info by the path, and wait till it is valid
 	connector := getPolicyConnector(m)
 	context := getSessionContext(d, m)
@@ -93,7 +94,7 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 	}
 	_, err := stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
+		return diag.Errorf("Failed to get realization information for %s: %v", path, err)
 	}
 
 	// In some cases success state is returned a moment before VC actually sees the network
@@ -106,7 +107,7 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 	segClient := infra.NewSegmentsClient(context, connector)
 	obj, err := segClient.Get(segmentID)
 	if err != nil {
-		return handleReadError(d, "Segment", segmentID, err)
+		return diag.FromErr(handleReadError(d, "Segment", segmentID, err))
 	}
 
 	d.Set("network_name", obj.DisplayName)
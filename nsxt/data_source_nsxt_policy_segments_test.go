@@ -0,0 +1,49 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegments_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_segments.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "results.0.path", "nsxt_policy_segment.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s", name), "nsxt_policy_segment.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentsReadTemplate(name string) string {
+	return testAccNSXPolicyTransportZoneReadTemplate(getOverlayTransportZoneName(), false, false) + fmt.Sprintf(`
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+}
+
+data "nsxt_policy_segments" "test" {
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_segment.test]
+}`, name, name)
+}
@@ -60,7 +60,7 @@ func TestAccDataSourceNsxtPolicyService_byPrefix(t *testing.T) {
 		Providers: testAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccNsxtPolicyServiceReadTemplate(servicePrefix),
+				Config: testAccNsxtPolicyServiceReadPrefixTemplate(servicePrefix),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(testResourceName, "display_name", serviceName),
 					resource.TestCheckResourceAttr(testResourceName, "description", serviceName),
@@ -150,6 +150,14 @@ data "nsxt_policy_service" "test" {
 }`, name)
 }
 
+func testAccNsxtPolicyServiceReadPrefixTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_service" "test" {
+  display_name = "%s"
+  match_mode   = "prefix"
+}`, name)
+}
+
 func testAccNsxtPolicyServiceReadIDTemplate(id string) string {
 	return fmt.Sprintf(`
 data "nsxt_policy_service" "test" {
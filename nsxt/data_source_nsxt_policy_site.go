@@ -4,30 +4,34 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicySite() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySiteRead,
+		ReadContext: dataSourceNsxtPolicySiteRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtPolicySiteRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if !isPolicyGlobalManager(m) {
-		return globalManagerOnlyError()
+		return diag.FromErr(globalManagerOnlyError())
 	}
 
 	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "Site", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -17,11 +19,13 @@ import (
 
 func dataSourceNsxtPolicyTier0Gateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTier0GatewayRead,
+		ReadContext: dataSourceNsxtPolicyTier0GatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"edge_cluster_path": {
@@ -34,11 +38,11 @@ func dataSourceNsxtPolicyTier0Gateway() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyTier0GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	obj, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "Tier0", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Single edge cluster is not informative for global manager
@@ -48,12 +52,12 @@ func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{})
 		converter := bindings.NewTypeConverter()
 		dataValue, errors := converter.ConvertToGolang(obj, model.Tier0BindingType())
 		if len(errors) > 0 {
-			return errors[0]
+			return diag.FromErr(errors[0])
 		}
 		gw := dataValue.(model.Tier0)
 		err := resourceNsxtPolicyTier0GatewayReadEdgeCluster(getSessionContext(d, m), d, connector)
 		if err != nil {
-			return fmt.Errorf("failed to get Tier0 %s locale-services: %v", *gw.Id, err)
+			return diag.Errorf("failed to get Tier0 %s locale-services: %v", *gw.Id, err)
 		}
 	}
 	return nil
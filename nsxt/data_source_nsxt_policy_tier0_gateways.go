@@ -0,0 +1,12 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyTier0Gateways() *schema.Resource {
+	return getPolicyListDataSource("Tier0", "Tier-0 gateways")
+}
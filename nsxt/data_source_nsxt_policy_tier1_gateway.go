@@ -4,8 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -13,11 +14,13 @@ import (
 
 func dataSourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTier1GatewayRead,
+		ReadContext: dataSourceNsxtPolicyTier1GatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"edge_cluster_path": {
@@ -31,11 +34,11 @@ func dataSourceNsxtPolicyTier1Gateway() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyTier1GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	obj, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "Tier1", nil)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Single edge cluster is not informative for global manager
@@ -45,12 +48,12 @@ func dataSourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{})
 		converter := bindings.NewTypeConverter()
 		dataValue, errors := converter.ConvertToGolang(obj, model.Tier1BindingType())
 		if len(errors) > 0 {
-			return errors[0]
+			return diag.FromErr(errors[0])
 		}
 		tier1 := dataValue.(model.Tier1)
 		err := resourceNsxtPolicyTier1GatewayReadEdgeCluster(getSessionContext(d, m), d, connector)
 		if err != nil {
-			return fmt.Errorf("failed to get Tier1 %s locale-services: %v", *tier1.Id, err)
+			return diag.Errorf("failed to get Tier1 %s locale-services: %v", *tier1.Id, err)
 		}
 	}
 	return nil
@@ -0,0 +1,48 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTier1Gateways_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_tier1_gateways.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1GatewaysReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "results.0.path", "nsxt_policy_tier1_gateway.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, fmt.Sprintf("items.%s", name), "nsxt_policy_tier1_gateway.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier1GatewaysReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_tier1_gateways" "test" {
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_tier1_gateway.test]
+}`, name, name)
+}
@@ -4,9 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
-	"strings"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -25,11 +25,13 @@ var policyTransportZoneTransportTypes = [](string){
 
 func dataSourceNsxtPolicyTransportZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTransportZoneRead,
+		ReadContext: dataSourceNsxtPolicyTransportZoneRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"is_default": {
@@ -55,17 +57,17 @@ func dataSourceNsxtPolicyTransportZone() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyTransportZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	objSitePath := d.Get("site_path").(string)
 	transportType := d.Get("transport_type").(string)
 	defaultVal, isDefaultSet := d.GetOkExists("is_default")
 	isDefault := isDefaultSet && defaultVal.(bool)
 	if !isPolicyGlobalManager(m) && objSitePath != "" {
-		return globalManagerOnlyError()
+		return diag.FromErr(globalManagerOnlyError())
 	}
 	if isPolicyGlobalManager(m) {
 		if objSitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_transport_zone")
+			return diag.FromErr(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_transport_zone"))
 		}
 		query := make(map[string]string)
 		globalPolicyEnforcementPointPath := getGlobalPolicyEnforcementPointPath(m, &objSitePath)
@@ -78,12 +80,12 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 		}
 		obj, err := policyDataSourceResourceReadWithValidation(d, getPolicyConnector(m), getSessionContext(d, m), "PolicyTransportZone", query, false)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		converter := bindings.NewTypeConverter()
 		dataValue, errors := converter.ConvertToGolang(obj, gm_model.PolicyTransportZoneBindingType())
 		if len(errors) > 0 {
-			return errors[0]
+			return diag.FromErr(errors[0])
 		}
 		transportZoneResource := dataValue.(gm_model.PolicyTransportZone)
 
@@ -104,21 +106,23 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 		objGet, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "TransportZone", objID, err)
+			return diag.FromErr(handleDataSourceReadError(d, "TransportZone", objID, err))
 		}
 		obj = objGet
-	} else if objName == "" && !(isDefault && transportType != "") {
-		return fmt.Errorf("Please specify id, display_name or is_default and transport_type in order to identify Transport Zone")
+	} else if objName == "" && len(getDataSourceTagFilterFromSchema(d)) == 0 && !(isDefault && transportType != "") {
+		return diag.Errorf("Please specify id, display_name, tag or is_default and transport_type in order to identify Transport Zone")
 	} else {
-		// Get by full name/prefix
+		// Get by name
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(defaultSite, getPolicyEnforcementPoint(m), nil, &includeMarkForDeleteObjectsParam, nil, nil, &includeMarkForDeleteObjectsParam, nil)
 		if err != nil {
-			return handleListError("TransportZone", err)
+			return diag.FromErr(handleListError("TransportZone", err))
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []lm_model.PolicyTransportZone
-		var prefixMatch []lm_model.PolicyTransportZone
 		for _, objInList := range objList.Results {
 			if transportType != "" && transportType != *objInList.TzType {
 				// no match for transport type
@@ -131,25 +135,17 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 				break
 			}
 
-			if strings.HasPrefix(*objInList.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, objInList)
-			}
-			if *objInList.DisplayName == objName {
+			if matcher.match(objInList.DisplayName, getCommonTagsFromPolicyTags(objInList.Tags)) {
 				perfectMatch = append(perfectMatch, objInList)
 			}
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple TransportZones with name '%s'", objName)
+				return diag.FromErr(policyTransportZoneMultipleMatchError(matcher.String(), perfectMatch))
 			}
 			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple TransportZones with name starting with '%s'", objName)
-			}
-			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("TransportZone '%s' was not found", objName)
+			return diag.Errorf("TransportZone with %s was not found", matcher.String())
 		}
 	}
 
@@ -161,3 +157,12 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 	d.Set("transport_type", obj.TzType)
 	return nil
 }
+
+func policyTransportZoneMultipleMatchError(criteria string, objs []lm_model.PolicyTransportZone) error {
+	var names, paths []string
+	for _, obj := range objs {
+		names = append(names, *obj.DisplayName)
+		paths = append(paths, *obj.Path)
+	}
+	return policyDataSourceMultipleMatchError("TransportZones", criteria, names, paths)
+}
@@ -0,0 +1,43 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTransportZones_basic(t *testing.T) {
+	transportZoneName := getOverlayTransportZoneName()
+	testResourceName := "data.nsxt_policy_transport_zones.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTransportZonesReadTemplate(transportZoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "results.0.display_name", transportZoneName),
+					resource.TestCheckResourceAttrPair(testResourceName, "results.0.path", "data.nsxt_policy_transport_zone.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTransportZonesReadTemplate(transportZoneName string) string {
+	return testAccNSXPolicyTransportZoneReadTemplate(transportZoneName, false, false) + fmt.Sprintf(`
+
+data "nsxt_policy_transport_zones" "test" {
+  display_name_regex = "^%s$"
+}`, regexp.QuoteMeta(transportZoneName))
+}
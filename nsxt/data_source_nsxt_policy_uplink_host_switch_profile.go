@@ -4,26 +4,29 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 )
 
 func dataSourceNsxtUplinkHostSwitchProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtUplinkHostSwitchProfileRead,
+		ReadContext: dataSourceNsxtUplinkHostSwitchProfileRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"path":         getPathSchema(),
 			"display_name": getDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDescriptionSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtUplinkHostSwitchProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtUplinkHostSwitchProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	_, err := policyDataSourceResourceReadWithValidation(d, connector, getSessionContext(d, m), infra.HostSwitchProfiles_LIST_HOSTSWITCH_PROFILE_TYPE_POLICYUPLINKHOSTSWITCHPROFILE, nil, false)
@@ -31,5 +34,5 @@ func dataSourceNsxtUplinkHostSwitchProfileRead(d *schema.ResourceData, m interfa
 		return nil
 	}
 
-	return fmt.Errorf("PolicyUplinkHostSwitchProfile with name '%s' was not found", d.Get("display_name").(string))
+	return diag.Errorf("PolicyUplinkHostSwitchProfile with name '%s' was not found", d.Get("display_name").(string))
 }
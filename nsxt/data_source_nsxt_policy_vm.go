@@ -4,8 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
@@ -18,7 +19,7 @@ var nsxtPolicyVMIDSchemaKeys = []string{
 
 func dataSourceNsxtPolicyVM() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVMIDRead,
+		ReadContext: dataSourceNsxtPolicyVMIDRead,
 
 		Schema: map[string]*schema.Schema{
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -41,7 +42,7 @@ func getNsxtPolicyVMIDFromSchema(d *schema.ResourceData) string {
 	return ""
 }
 
-func dataSourceNsxtPolicyVMIDRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyVMIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var vmModel model.VirtualMachine
 	connector := getPolicyConnector(m)
 
@@ -51,7 +52,7 @@ func dataSourceNsxtPolicyVMIDRead(d *schema.ResourceData, m interface{}) error {
 	if objID != "" {
 		vmObj, err := findNsxtPolicyVMByID(context, connector, objID, m)
 		if err != nil {
-			return fmt.Errorf("Error while reading Virtual Machine %s: %v", objID, err)
+			return diag.Errorf("Error while reading Virtual Machine %s: %v", objID, err)
 		}
 		vmModel = vmObj
 	} else {
@@ -59,15 +60,15 @@ func dataSourceNsxtPolicyVMIDRead(d *schema.ResourceData, m interface{}) error {
 
 		perfectMatch, prefixMatch, err := findNsxtPolicyVMByNamePrefix(context, connector, displayName, m)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		foundLen := len(perfectMatch) + len(prefixMatch)
 		if foundLen == 0 {
-			return fmt.Errorf("Unable to find Virtual Machine with name prefix: %s", displayName)
+			return diag.Errorf("Unable to find Virtual Machine with name prefix: %s", displayName)
 		}
 		if foundLen > 1 {
-			return fmt.Errorf("Found %v Virtual Machines with name prefix: %s", foundLen, displayName)
+			return diag.Errorf("Found %v Virtual Machines with name prefix: %s", foundLen, displayName)
 		}
 		if len(perfectMatch) > 0 {
 			vmModel = perfectMatch[0]
@@ -78,7 +79,7 @@ func dataSourceNsxtPolicyVMIDRead(d *schema.ResourceData, m interface{}) error {
 
 	computeIDMap := collectSeparatedStringListToMap(vmModel.ComputeIds, ":")
 	if vmModel.ExternalId == nil {
-		return fmt.Errorf("Unable to read external ID for Virtual Machine with name %s", *vmModel.DisplayName)
+		return diag.Errorf("Unable to read external ID for Virtual Machine with name %s", *vmModel.DisplayName)
 	}
 	d.SetId(*vmModel.ExternalId)
 	d.Set("display_name", vmModel.DisplayName)
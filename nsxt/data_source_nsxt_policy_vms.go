@@ -4,12 +4,19 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
+	"log"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 )
 
 var valueTypeValues = []string{"bios_id", "external_id", "instance_id"}
@@ -28,10 +35,9 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVMsRead,
+		ReadContext: dataSourceNsxtPolicyVMsRead,
 
 		Schema: map[string]*schema.Schema{
-			// TODO: add option to filter by display name regex
 			"value_type": {
 				Type:         schema.TypeString,
 				Description:  "Type of data populated in map value",
@@ -50,6 +56,19 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 				Description: "Operating system",
 				Optional:    true,
 			},
+			"display_name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Regular expression to filter VMs by display name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tag": getDataSourceTagFilterSchema(),
+			"include_segment_paths": {
+				Type:        schema.TypeBool,
+				Description: "Populate segment_paths of matching VMs, which requires listing all VM interfaces and segment ports",
+				Optional:    true,
+				Default:     false,
+			},
 			"items": {
 				Type:        schema.TypeMap,
 				Description: "Mapping of VM instance ID by display name",
@@ -58,22 +77,125 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"vms": {
+				Type:        schema.TypeList,
+				Description: "Virtual machines matching the filters, sorted by display name",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": getComputedDisplayNameSchema(),
+						"bios_id":      getComputedStringSchema("BIOS UUID of the VM"),
+						"instance_id":  getComputedStringSchema("Instance UUID of the VM"),
+						"external_id":  getComputedStringSchema("External ID of the VM"),
+						"power_state":  getComputedStringSchema("Power state of the VM"),
+						"guest_os":     getComputedStringSchema("Operating system of the VM"),
+						"tag": {
+							Type:        schema.TypeList,
+							Description: "Tags of the VM",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scope": getComputedStringSchema("Tag scope"),
+									"tag":   getComputedStringSchema("Tag value"),
+								},
+							},
+						},
+						"segment_paths": {
+							Type:        schema.TypeList,
+							Description: "Policy paths of segments the VM is attached to, populated if include_segment_paths is set",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 			"context": getContextSchema(),
 		},
 	}
 }
 
-func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
+func getPolicyVMPowerState(vm model.VirtualMachine) string {
+	if vm.PowerState == nil {
+		return ""
+	}
+	for state, value := range stateMap {
+		if value == *vm.PowerState {
+			return state
+		}
+	}
+	return strings.ToLower(*vm.PowerState)
+}
+
+// Returns paths of segments VMs are attached to, by VM external ID
+func getPolicyVMSegmentPaths(sessionContext utl.SessionContext, m interface{}) (map[string][]string, error) {
+	vmSegmentPaths := make(map[string][]string)
+	if sessionContext.ClientType != utl.Local {
+		// VM interfaces are only exposed by local manager infra API
+		log.Printf("[WARN] Segment paths of VMs are not available in this context")
+		return vmSegmentPaths, nil
+	}
+	vifs, err := listAllPolicyVifs(m)
+	if err != nil {
+		return nil, err
+	}
+	vmByAttachment := make(map[string]string)
+	for _, vif := range vifs {
+		if vif.LportAttachmentId != nil && vif.OwnerVmId != nil {
+			vmByAttachment[*vif.LportAttachmentId] = *vif.OwnerVmId
+		}
+	}
+	if len(vmByAttachment) == 0 {
+		return vmSegmentPaths, nil
+	}
+
+	includedFields := "id,parent_path,attachment"
+	ports, err := searchPolicyResources(getPolicyConnector(m), sessionContext, "resource_type:SegmentPort AND marked_for_delete:false", &includedFields)
+	if err != nil {
+		return nil, err
+	}
+	converter := bindings.NewTypeConverter()
+	for _, result := range ports {
+		dataValue, errs := converter.ConvertToGolang(result, model.SegmentPortBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		port := dataValue.(model.SegmentPort)
+		if port.Attachment == nil || port.Attachment.Id == nil || port.ParentPath == nil {
+			continue
+		}
+		if vmID, ok := vmByAttachment[*port.Attachment.Id]; ok {
+			vmSegmentPaths[vmID] = append(vmSegmentPaths[vmID], *port.ParentPath)
+		}
+	}
+	for vmID := range vmSegmentPaths {
+		sort.Strings(vmSegmentPaths[vmID])
+	}
+	return vmSegmentPaths, nil
+}
+
+func dataSourceNsxtPolicyVMsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	valueType := d.Get("value_type").(string)
 	state := d.Get("state").(string)
 	osPrefix := d.Get("guest_os").(string)
+	var nameRegexp *regexp.Regexp
+	if expression := d.Get("display_name_regex").(string); expression != "" {
+		var err error
+		nameRegexp, err = regexp.Compile(expression)
+		if err != nil {
+			return diag.Errorf("Invalid display_name_regex '%s': %v", expression, err)
+		}
+	}
+	tags := getDataSourceTagFilterFromSchema(d)
 	vmMap := make(map[string]interface{})
+	var vms []model.VirtualMachine
 
 	allVMs, err := listAllPolicyVirtualMachines(getSessionContext(d, m), connector, m)
 	if err != nil {
-		return fmt.Errorf("Error reading Virtual Machines: %v", err)
+		return diag.Errorf("Error reading Virtual Machines: %v", err)
 	}
 
 	for _, vm := range allVMs {
@@ -94,6 +216,13 @@ func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
 		if vm.DisplayName == nil {
 			continue
 		}
+		if nameRegexp != nil && !nameRegexp.MatchString(*vm.DisplayName) {
+			continue
+		}
+		if !policyTagsMatch(tags, getCommonTagsFromPolicyTags(vm.Tags)) {
+			continue
+		}
+		vms = append(vms, vm)
 		computeIDMap := collectSeparatedStringListToMap(vm.ComputeIds, ":")
 		if valueType == "instance_id" {
 			vmMap[*vm.DisplayName] = computeIDMap[nsxtPolicyInstanceUUIDKey]
@@ -108,8 +237,46 @@ func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	var vmSegmentPaths map[string][]string
+	if len(vms) > 0 && d.Get("include_segment_paths").(bool) {
+		// Segment paths are informational, hence failure to retrieve
+		// them does not fail the data source
+		vmSegmentPaths, err = getPolicyVMSegmentPaths(getSessionContext(d, m), m)
+		if err != nil {
+			log.Printf("[WARN] Failed to read Virtual Machine attachments: %v", err)
+		}
+	}
+	sort.SliceStable(vms, func(i, j int) bool {
+		return *vms[i].DisplayName < *vms[j].DisplayName
+	})
+	var vmList []map[string]interface{}
+	for _, vm := range vms {
+		computeIDMap := collectSeparatedStringListToMap(vm.ComputeIds, ":")
+		elem := make(map[string]interface{})
+		elem["display_name"] = vm.DisplayName
+		elem["bios_id"] = computeIDMap[nsxtPolicyBiosUUIDKey]
+		elem["instance_id"] = computeIDMap[nsxtPolicyInstanceUUIDKey]
+		elem["external_id"] = vm.ExternalId
+		elem["power_state"] = getPolicyVMPowerState(vm)
+		if vm.GuestInfo != nil {
+			elem["guest_os"] = vm.GuestInfo.OsName
+		}
+		var tagList []map[string]interface{}
+		for _, tag := range getCommonTagsFromPolicyTags(vm.Tags) {
+			tagList = append(tagList, map[string]interface{}{"scope": tag.Scope, "tag": tag.Tag})
+		}
+		elem["tag"] = tagList
+		if vm.ExternalId != nil {
+			elem["segment_paths"] = vmSegmentPaths[*vm.ExternalId]
+		}
+		vmList = append(vmList, elem)
+	}
+
 	d.SetId(newUUID())
 	d.Set("items", vmMap)
+	if err := d.Set("vms", vmList); err != nil {
+		return diag.Errorf("Error setting vms: %v", err)
+	}
 
 	return nil
 }
@@ -4,10 +4,14 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccDataSourceNsxtPolicyVMs_basic(t *testing.T) {
@@ -82,6 +86,33 @@ func TestAccDataSourceNsxtPolicyVMs_filter(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNsxtPolicyVMs_regex(t *testing.T) {
+	testResourceName := "data.nsxt_policy_vms.test"
+	checkDataSourceName := "data.nsxt_policy_vm.check"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyVMsTemplateRegex(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "vms.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "vms.0.display_name", getTestVMName()),
+					resource.TestCheckResourceAttrPair(testResourceName, "vms.0.bios_id", checkDataSourceName, "bios_id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "vms.0.instance_id", checkDataSourceName, "instance_id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "vms.0.external_id", checkDataSourceName, "external_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "vms.0.power_state"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNsxtPolicyVMsTemplate(valueType string, withContext bool) string {
 	context := ""
 	if withContext {
@@ -115,3 +146,92 @@ resource "nsxt_policy_group" "check" {
   display_name = length(data.nsxt_policy_vms.test.items)
 }`
 }
+
+func testAccNsxtPolicyVMsTemplateRegex() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_vms" "test" {
+  display_name_regex = "^%s$"
+}
+
+data "nsxt_policy_vm" "check" {
+  display_name = "%s"
+}`, regexp.QuoteMeta(getTestVMName()), getTestVMName())
+}
+
+func TestMockNsxPolicyVMsDataSource(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	inventory := "/infra/realized-state/enforcement-points/default"
+	server.lock.Lock()
+	for i, name := range []string{"web-2", "web-1", "db-1"} {
+		externalID := fmt.Sprintf("vm-%d", i)
+		server.storeObject(fmt.Sprintf("%s/virtual-machines/%s", inventory, externalID), map[string]interface{}{
+			"resource_type": "VirtualMachine",
+			"display_name":  name,
+			"external_id":   externalID,
+			"power_state":   "VM_RUNNING",
+			"compute_ids":   []interface{}{fmt.Sprintf("biosUuid:bios-%d", i), fmt.Sprintf("instanceUuid:instance-%d", i)},
+			"guest_info":    map[string]interface{}{"os_name": "Ubuntu Linux (64-bit)"},
+			"tags":          []interface{}{map[string]interface{}{"scope": "tier", "tag": strings.Split(name, "-")[0]}},
+		})
+		server.storeObject(fmt.Sprintf("%s/vifs/vif-%d", inventory, i), map[string]interface{}{
+			"resource_type":       "VirtualNetworkInterface",
+			"owner_vm_id":         externalID,
+			"lport_attachment_id": fmt.Sprintf("attachment-%d", i),
+		})
+	}
+	server.storeObject("/infra/segments/web/ports/port-1", map[string]interface{}{
+		"resource_type": "SegmentPort",
+		"attachment":    map[string]interface{}{"id": "attachment-1"},
+	})
+	server.lock.Unlock()
+
+	dataSource := dataSourceNsxtPolicyVMs()
+	read := func(config map[string]interface{}) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, dataSource.Schema, config)
+		if diags := dataSource.ReadContext(context.Background(), d, m); diags.HasError() {
+			t.Fatalf("Read failed: %v", diags)
+		}
+		return d
+	}
+
+	d := read(map[string]interface{}{"display_name_regex": "^web-", "value_type": "instance_id", "include_segment_paths": true})
+	if count := d.Get("vms.#").(int); count != 2 {
+		t.Fatalf("Expected 2 VMs, got %d", count)
+	}
+	if d.Get("items.web-2").(string) != "instance-0" {
+		t.Fatalf("Expected items to map web-2 to its instance ID, got %v", d.Get("items"))
+	}
+	if d.Get("vms.0.display_name").(string) != "web-1" || d.Get("vms.0.bios_id").(string) != "bios-1" || d.Get("vms.0.external_id").(string) != "vm-1" {
+		t.Fatalf("Unexpected first VM %v", d.Get("vms.0"))
+	}
+	if d.Get("vms.0.power_state").(string) != "running" || d.Get("vms.0.tag.0.tag").(string) != "web" {
+		t.Fatalf("Expected power state and tags of VM, got %v", d.Get("vms.0"))
+	}
+	if d.Get("vms.0.segment_paths.#").(int) != 1 || d.Get("vms.0.segment_paths.0").(string) != "/infra/segments/web" {
+		t.Fatalf("Expected segment path of web-1, got %v", d.Get("vms.0.segment_paths"))
+	}
+	if d.Get("vms.1.segment_paths.#").(int) != 0 {
+		t.Fatalf("Expected no segment paths for web-2, got %v", d.Get("vms.1.segment_paths"))
+	}
+
+	// Segment paths are only retrieved on demand, and on best effort basis
+	d = read(map[string]interface{}{"display_name_regex": "^web-1$"})
+	if d.Get("vms.0.segment_paths.#").(int) != 0 {
+		t.Fatalf("Expected segment paths not to be populated by default, got %v", d.Get("vms.0.segment_paths"))
+	}
+	server.denyAccess(mockPolicyPrefix + "/infra/realized-state/enforcement-points/default/vifs")
+	d = read(map[string]interface{}{"display_name_regex": "^web-1$", "include_segment_paths": true})
+	if d.Get("vms.#").(int) != 1 || d.Get("vms.0.segment_paths.#").(int) != 0 {
+		t.Fatalf("Expected VMs without segment paths when interfaces can not be read, got %v", d.Get("vms"))
+	}
+
+	d = read(map[string]interface{}{
+		"tag": []interface{}{map[string]interface{}{"scope": "tier", "tag": "db"}},
+	})
+	if count := d.Get("vms.#").(int); count != 1 || d.Get("vms.0.display_name").(string) != "db-1" {
+		t.Fatalf("Expected VMs to be filtered by tag, got %v", d.Get("vms"))
+	}
+}
@@ -4,9 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
-	"strings"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,11 +14,13 @@ import (
 
 func dataSourceNsxtPolicyVniPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVniPoolRead,
+		ReadContext: dataSourceNsxtPolicyVniPoolRead,
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getDataSourceTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"end": {
@@ -35,7 +37,7 @@ func dataSourceNsxtPolicyVniPool() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyVniPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewVniPoolsClient(connector)
 
@@ -46,46 +48,34 @@ func dataSourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) erro
 		// Get by id
 		objGet, err := client.Get(objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("VniPoolConfig with ID %s was not found", objID)
+			return diag.Errorf("VniPoolConfig with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading VniPoolConfig %s: %v", objID, err)
+			return diag.Errorf("Error while reading VniPoolConfig %s: %v", objID, err)
 		}
 		obj = objGet
-	} else if objName == "" {
-		return fmt.Errorf("Error obtaining VniPoolConfig ID or name during read")
+	} else if objName == "" && len(getDataSourceTagFilterFromSchema(d)) == 0 {
+		return diag.Errorf("Error obtaining VniPoolConfig ID, name or tag during read")
 	} else {
-		// Get by full name/prefix
+		matcher, err := newPolicyDataSourceMatcher(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading VniPoolConfigs: %v", err)
+			return diag.Errorf("Error while reading VniPoolConfigs: %v", err)
 		}
-		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
-		var perfectMatch []model.VniPoolConfig
-		var prefixMatch []model.VniPoolConfig
+		var candidates []policyDataSourceCandidate
 		for _, objInList := range objList.Results {
-			if strings.HasPrefix(*objInList.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, objInList)
-			}
-			if *objInList.DisplayName == objName {
-				perfectMatch = append(perfectMatch, objInList)
-			}
+			candidates = append(candidates, policyDataSourceCandidate{displayName: objInList.DisplayName, path: objInList.Path, tags: getCommonTagsFromPolicyTags(objInList.Tags)})
 		}
-		if len(perfectMatch) > 0 {
-			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple VniPoolConfigs with name '%s'", objName)
-			}
-			obj = perfectMatch[0]
-		} else if len(prefixMatch) > 0 {
-			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple VniPoolConfigs with name starting with '%s'", objName)
-			}
-			obj = prefixMatch[0]
-		} else {
-			return fmt.Errorf("VniPoolConfig with name '%s' was not found", objName)
+		i, err := matcher.selectMatch("VniPoolConfig", "", candidates)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		obj = objList.Results[i]
 	}
 
 	d.SetId(*obj.Id)
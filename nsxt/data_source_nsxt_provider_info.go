@@ -4,8 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,7 +15,7 @@ var GitCommit string
 
 func dataSourceNsxtProviderInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtProviderInfoRead,
+		ReadContext: dataSourceNsxtProviderInfoRead,
 
 		Schema: map[string]*schema.Schema{
 			"commit": {
@@ -30,7 +32,7 @@ func dataSourceNsxtProviderInfo() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtProviderInfoRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtProviderInfoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	d.SetId("nsxt")
 	d.Set("commit", GitCommit)
 	d.Set("date", time.Now().Format(time.Stamp))
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceNsxtTransportNodeRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtTransportNodeRealizationRead,
+		ReadContext: dataSourceNsxtTransportNodeRealizationRead,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -48,7 +49,7 @@ func dataSourceNsxtTransportNodeRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtTransportNodeRealizationRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtTransportNodeRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := transport_nodes.NewStateClient(connector)
 
@@ -89,7 +90,7 @@ func dataSourceNsxtTransportNodeRealizationRead(d *schema.ResourceData, m interf
 	}
 	_, err := stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("failed to get realization information for %s: %v", id, err)
+		return diag.Errorf("failed to get realization information for %s: %v", id, err)
 	}
 
 	return nil
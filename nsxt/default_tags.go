@@ -0,0 +1,192 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/common"
+)
+
+func getDefaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Tags to be applied to every resource managed by this provider",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func getTagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Set of all tags applied to this resource, including provider default tags",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func getTagsFromSetList(tags []interface{}) []common.Tag {
+	var tagList []common.Tag
+	for _, tag := range tags {
+		data := tag.(map[string]interface{})
+		elem := common.Tag{
+			Scope: data["scope"].(string),
+			Tag:   data["tag"].(string)}
+
+		tagList = append(tagList, elem)
+	}
+	return tagList
+}
+
+func getProviderDefaultTags(m interface{}) []common.Tag {
+	if m == nil {
+		return nil
+	}
+	return m.(nsxtClients).CommonConfig.DefaultTags
+}
+
+func containsTag(tags []common.Tag, tag common.Tag) bool {
+	for _, t := range tags {
+		if t.Scope == tag.Scope && t.Tag == tag.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource tags take precedence over provider default tags with same scope
+func mergeDefaultTags(defaultTags []common.Tag, tags []common.Tag) []common.Tag {
+	result := make([]common.Tag, 0, len(tags)+len(defaultTags))
+	result = append(result, tags...)
+	for _, defaultTag := range defaultTags {
+		overridden := false
+		for _, tag := range tags {
+			if tag.Scope == defaultTag.Scope && (tag.Scope != "" || tag.Tag == defaultTag.Tag) {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			result = append(result, defaultTag)
+		}
+	}
+	return result
+}
+
+// Strip tags that originate from provider defaults, unless specified in the
+// resource explicitly, so that default tags do not show up in resource diff
+func filterDefaultTags(defaultTags []common.Tag, tags []common.Tag, resourceTags []common.Tag) []common.Tag {
+	var result []common.Tag
+	for _, tag := range tags {
+		if containsTag(defaultTags, tag) && !containsTag(resourceTags, tag) {
+			continue
+		}
+		result = append(result, tag)
+	}
+	return result
+}
+
+// Returns tags configured for the resource merged with provider default tags
+func getAllTagsFromSchema(d *schema.ResourceData, m interface{}) []common.Tag {
+	tags := getCustomizedTagsFromSchema(d, "tag")
+	return mergeDefaultTags(getProviderDefaultTags(m), tags)
+}
+
+// Sets tags retrieved from NSX in schema, separating provider default tags
+// from tags configured for the resource
+func setAllTagsInSchema(d *schema.ResourceData, tags []common.Tag, m interface{}) {
+	resourceTags := getCustomizedTagsFromSchema(d, "tag")
+	setCustomizedTagsInSchema(d, filterDefaultTags(getProviderDefaultTags(m), tags, resourceTags), "tag")
+	setCustomizedTagsInSchema(d, tags, "tags_all")
+}
+
+// Plan tags_all attribute, so that change in provider default tags triggers
+// update of the resource
+func resourceTagsAllCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("tag") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tags := getTagsFromSetList(diff.Get("tag").(*schema.Set).List())
+	allTags := mergeDefaultTags(getProviderDefaultTags(m), tags)
+	currentTags := getTagsFromSetList(diff.Get("tags_all").(*schema.Set).List())
+	if len(allTags) == len(currentTags) {
+		changed := false
+		for _, tag := range allTags {
+			if !containsTag(currentTags, tag) {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+
+	var tagList []map[string]interface{}
+	for _, tag := range allTags {
+		tagList = append(tagList, map[string]interface{}{"scope": tag.Scope, "tag": tag.Tag})
+	}
+	return diff.SetNew("tags_all", tagList)
+}
+
+// Resources that tag objects they do not own, hence provider default tags
+// should not be applied by them
+var defaultTagsExcludedResources = map[string]bool{
+	"nsxt_policy_object_tags": true,
+}
+
+// Adds tags_all attribute to resources that support tagging
+func addDefaultTagsSupport(name string, resource *schema.Resource) {
+	if defaultTagsExcludedResources[name] {
+		return
+	}
+	tagSchema, ok := resource.Schema["tag"]
+	if !ok || tagSchema.Type != schema.TypeSet || resource.CreateContext == nil {
+		return
+	}
+	if _, ok := resource.Schema["tags_all"]; ok {
+		return
+	}
+
+	resource.Schema["tags_all"] = getTagsAllSchema()
+	if resource.UpdateContext == nil {
+		// Tags can not be updated for this resource, provider default
+		// tags are only applied on creation
+		return
+	}
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, m); err != nil {
+				return err
+			}
+		}
+		return resourceTagsAllCustomizeDiff(ctx, diff, m)
+	}
+}
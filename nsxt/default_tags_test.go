@@ -0,0 +1,48 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMockNsxDefaultTags(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMetaWithConfig(t, server.Host(), map[string]interface{}{
+		"default_tags": []interface{}{
+			map[string]interface{}{"scope": "owner", "tag": "netops"},
+			map[string]interface{}{"scope": "cost-center", "tag": "default"},
+		},
+	})
+
+	resource := Provider().ResourcesMap["nsxt_policy_group"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-tagged-group",
+		"tag": []interface{}{
+			map[string]interface{}{"scope": "cost-center", "tag": "1234"},
+		},
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	obj := server.objects[d.Get("path").(string)]
+	if obj == nil {
+		t.Fatalf("Group %s not found on server", d.Get("path").(string))
+	}
+	tags := obj.data["tags"].([]interface{})
+	if len(tags) != 2 {
+		t.Fatalf("Expected resource tag merged with single default tag, got %v", tags)
+	}
+	if d.Get("tag").(*schema.Set).Len() != 1 {
+		t.Fatalf("Expected default tags to be excluded from tag attribute, got %v", d.Get("tag"))
+	}
+	if d.Get("tags_all").(*schema.Set).Len() != 2 {
+		t.Fatalf("Expected default tags in tags_all attribute, got %v", d.Get("tags_all"))
+	}
+}
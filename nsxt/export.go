@@ -0,0 +1,445 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// Policy resource types supported by export, in order of dependency
+type exportResourceType struct {
+	resourceType string
+	nsxType      string
+	// Optional filter for resource types that share NSX type
+	match func(path string, result *data.StructValue) bool
+}
+
+var exportResourceTypes = []exportResourceType{
+	{resourceType: "nsxt_policy_tier0_gateway", nsxType: "Tier0"},
+	{resourceType: "nsxt_policy_tier1_gateway", nsxType: "Tier1"},
+	{resourceType: "nsxt_policy_segment", nsxType: "Segment", match: func(path string, result *data.StructValue) bool {
+		return !strings.Contains(path, "/tier-1s/") && !exportHasListField(result, "vlan_ids")
+	}},
+	{resourceType: "nsxt_policy_vlan_segment", nsxType: "Segment", match: func(path string, result *data.StructValue) bool {
+		return !strings.Contains(path, "/tier-1s/") && exportHasListField(result, "vlan_ids")
+	}},
+	{resourceType: "nsxt_policy_fixed_segment", nsxType: "Segment", match: func(path string, result *data.StructValue) bool {
+		return strings.Contains(path, "/tier-1s/")
+	}},
+	{resourceType: "nsxt_policy_ip_block", nsxType: "IpAddressBlock"},
+	{resourceType: "nsxt_policy_ip_pool", nsxType: "IpAddressPool"},
+	{resourceType: "nsxt_policy_service", nsxType: "Service"},
+	{resourceType: "nsxt_policy_context_profile", nsxType: "PolicyContextProfile"},
+	{resourceType: "nsxt_policy_group", nsxType: "Group"},
+	{resourceType: "nsxt_policy_security_policy", nsxType: "SecurityPolicy"},
+	{resourceType: "nsxt_policy_gateway_policy", nsxType: "GatewayPolicy"},
+	{resourceType: "nsxt_policy_static_route", nsxType: "StaticRoutes"},
+	{resourceType: "nsxt_policy_nat_rule", nsxType: "PolicyNatRule"},
+	{resourceType: "nsxt_policy_dhcp_server", nsxType: "DhcpServerConfig"},
+	{resourceType: "nsxt_policy_lb_pool", nsxType: "LBPool"},
+}
+
+// Attributes that are not exported, since those are either managed by
+// provider or do not represent NSX configuration
+var exportSkippedAttributes = map[string]bool{
+	"id":                   true,
+	"tags_all":             true,
+	"wait_for_realization": true,
+	"deletion_protection":  true,
+	"retain_on_destroy":    true,
+}
+
+type exportOptions struct {
+	domain  string
+	project string
+	tags    []common.Tag
+	types   []string
+}
+
+type exportObject struct {
+	resourceType string
+	name         string
+	path         string
+	importID     string
+	data         *schema.ResourceData
+}
+
+func exportHasListField(result *data.StructValue, name string) bool {
+	if !result.HasField(name) {
+		return false
+	}
+	value, err := result.Field(name)
+	if err != nil {
+		return false
+	}
+	list, ok := value.(*data.ListValue)
+	return ok && len(list.List()) > 0
+}
+
+// Export runs export command, that generates terraform configuration with
+// import blocks for policy objects that exist on NSX. Connection settings
+// are taken from provider environment variables, such as NSXT_MANAGER_HOST.
+func Export(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	domain := flags.String("domain", "", "Only export objects in this policy domain")
+	project := flags.String("project", "", "Export objects of this multitenancy project")
+	tag := flags.String("tag", "", "Only export objects with this tag, in format <scope>:<tag>")
+	types := flags.String("types", "", "Comma-separated list of resource types to export, all supported types by default")
+	output := flags.String("output", "", "File to write the configuration to, standard output by default")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	options := exportOptions{
+		domain:  *domain,
+		project: *project,
+	}
+	if *tag != "" {
+		segs := strings.SplitN(*tag, ":", 2)
+		if len(segs) != 2 {
+			return fmt.Errorf("tag filter is expected in format <scope>:<tag>, got %s", *tag)
+		}
+		options.tags = []common.Tag{{Scope: segs[0], Tag: segs[1]}}
+	}
+	if *types != "" {
+		options.types = strings.Split(*types, ",")
+	}
+
+	provider := Provider()
+	config := make(map[string]interface{})
+	if options.project != "" {
+		config["context"] = []interface{}{map[string]interface{}{"project_id": options.project}}
+	}
+	if diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		return fmt.Errorf("failed to configure NSX connection: %v", diags)
+	}
+
+	out := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	return exportPolicyResources(context.Background(), provider, provider.Meta(), options, out)
+}
+
+func getExportSessionContext(m interface{}, options exportOptions) utl.SessionContext {
+	if options.project != "" {
+		return utl.SessionContext{ProjectID: options.project, ClientType: utl.Multitenancy}
+	}
+	if isPolicyGlobalManager(m) {
+		return utl.SessionContext{ClientType: utl.Global}
+	}
+	return utl.SessionContext{ClientType: utl.Local}
+}
+
+func isExportTypeSelected(resourceType string, options exportOptions) bool {
+	if len(options.types) == 0 {
+		return true
+	}
+	for _, selected := range options.types {
+		if strings.TrimSpace(selected) == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+func exportPolicyResources(ctx context.Context, provider *schema.Provider, m interface{}, options exportOptions, out io.Writer) error {
+	connector := getPolicyConnector(m)
+	sessionContext := getExportSessionContext(m, options)
+	converter := bindings.NewTypeConverter()
+	names := make(map[string]bool)
+	var objects []*exportObject
+
+	for _, exportType := range exportResourceTypes {
+		if !isExportTypeSelected(exportType.resourceType, options) {
+			continue
+		}
+		resource := provider.ResourcesMap[exportType.resourceType]
+		query := buildPolicySearchQuery(exportType.nsxType, "", options.tags)
+		results, err := searchPolicyResources(connector, sessionContext, query, nil)
+		if err != nil {
+			return fmt.Errorf("failed to search %s objects: %v", exportType.nsxType, err)
+		}
+
+		for _, result := range results {
+			dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			obj := dataValue.(model.PolicyResource)
+			if obj.Path == nil || obj.Id == nil {
+				continue
+			}
+			path := *obj.Path
+			if obj.SystemOwned != nil && *obj.SystemOwned {
+				continue
+			}
+			if exportType.match != nil && !exportType.match(path, result) {
+				continue
+			}
+			if options.domain != "" && !strings.Contains(path, "/domains/"+options.domain+"/") {
+				continue
+			}
+			if !policyTagsMatch(options.tags, getCommonTagsFromPolicyTags(obj.Tags)) {
+				continue
+			}
+
+			exported, err := exportPolicyObject(ctx, resource, m, path, *obj.Id)
+			if err != nil {
+				return fmt.Errorf("failed to export %s %s: %v", exportType.resourceType, path, err)
+			}
+			if exported == nil {
+				continue
+			}
+			displayName := *obj.Id
+			if obj.DisplayName != nil {
+				displayName = *obj.DisplayName
+			}
+			exported.resourceType = exportType.resourceType
+			exported.name = getExportResourceName(exportType.resourceType, displayName, names)
+			objects = append(objects, exported)
+		}
+	}
+
+	references := make(map[string]string)
+	for _, obj := range objects {
+		references[obj.path] = fmt.Sprintf("%s.%s.path", obj.resourceType, obj.name)
+	}
+	for _, obj := range objects {
+		if _, err := io.WriteString(out, renderExportObject(provider.ResourcesMap[obj.resourceType], obj, references)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Imports the object by its path, or by ID for resources that do not support
+// import by path, and reads its configuration
+func exportPolicyObject(ctx context.Context, resource *schema.Resource, m interface{}, path string, id string) (*exportObject, error) {
+	d := resource.Data(nil)
+	d.SetId(path)
+	importID := path
+	results := []*schema.ResourceData{d}
+	if resource.Importer != nil {
+		var err error
+		if resource.Importer.StateContext != nil {
+			results, err = resource.Importer.StateContext(ctx, d, m)
+		} else if resource.Importer.State != nil {
+			results, err = resource.Importer.State(d, m)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 {
+			return nil, nil
+		}
+	}
+	d = results[0]
+	if d.Id() == path {
+		// Import by path is not supported by this resource
+		d.SetId(id)
+		importID = id
+	}
+
+	if diags := resource.ReadContext(ctx, d, m); diags.HasError() {
+		return nil, fmt.Errorf("%v", diags)
+	}
+	if d.Id() == "" {
+		log.Printf("[DEBUG] Object %s not found", path)
+		return nil, nil
+	}
+	return &exportObject{path: path, importID: importID, data: d}, nil
+}
+
+var exportNameRegexp = regexp.MustCompile("[^a-z0-9_]+")
+
+func getExportResourceName(resourceType string, displayName string, names map[string]bool) string {
+	name := strings.Trim(exportNameRegexp.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+	unique := name
+	for i := 2; names[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[resourceType+"."+unique] = true
+	return unique
+}
+
+func renderExportObject(resource *schema.Resource, obj *exportObject, references map[string]string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "import {\n  to = %s.%s\n  id = %s\n}\n\n", obj.resourceType, obj.name, quoteExportString(obj.importID))
+	fmt.Fprintf(&sb, "resource \"%s\" \"%s\" {\n", obj.resourceType, obj.name)
+	values := make(map[string]interface{})
+	for key := range resource.Schema {
+		values[key] = obj.data.Get(key)
+	}
+	renderExportBody(&sb, resource.Schema, values, references, "  ", true)
+	sb.WriteString("}\n\n")
+	return sb.String()
+}
+
+// Attributes are rendered first, aligned same way as terraform fmt does,
+// followed by nested blocks
+func renderExportBody(sb *strings.Builder, schemaMap map[string]*schema.Schema, values map[string]interface{}, references map[string]string, indent string, topLevel bool) {
+	var keys []string
+	for key, attrSchema := range schemaMap {
+		if topLevel && exportSkippedAttributes[key] {
+			continue
+		}
+		if attrSchema.Computed && !attrSchema.Optional && !attrSchema.Required {
+			continue
+		}
+		if attrSchema.Sensitive || attrSchema.Deprecated != "" {
+			continue
+		}
+		if !isExportValueSet(attrSchema, values[key]) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return getExportAttributeOrder(keys[i]) < getExportAttributeOrder(keys[j]) ||
+			getExportAttributeOrder(keys[i]) == getExportAttributeOrder(keys[j]) && keys[i] < keys[j]
+	})
+
+	var attributes, blocks []string
+	width := 0
+	for _, key := range keys {
+		if _, isBlock := schemaMap[key].Elem.(*schema.Resource); isBlock {
+			blocks = append(blocks, key)
+			continue
+		}
+		attributes = append(attributes, key)
+		if len(key) > width {
+			width = len(key)
+		}
+	}
+
+	for _, key := range attributes {
+		fmt.Fprintf(sb, "%s%-*s = %s\n", indent, width, key, renderExportValue(values[key], references))
+	}
+	for _, key := range blocks {
+		elemSchema := schemaMap[key].Elem.(*schema.Resource).Schema
+		for _, elem := range getExportList(values[key]) {
+			elemValues, ok := elem.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(sb, "\n%s%s {\n", indent, key)
+			renderExportBody(sb, elemSchema, elemValues, references, indent+"  ", false)
+			fmt.Fprintf(sb, "%s}\n", indent)
+		}
+	}
+}
+
+func getExportAttributeOrder(key string) int {
+	switch key {
+	case "display_name":
+		return 0
+	case "description":
+		return 1
+	case "nsx_id":
+		return 2
+	}
+	return 3
+}
+
+func getExportList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+// Returns true if value differs from the value terraform would assume if
+// the attribute was omitted from configuration
+func isExportValueSet(attrSchema *schema.Schema, value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	if attrSchema.Default != nil {
+		return fmt.Sprintf("%v", value) != fmt.Sprintf("%v", attrSchema.Default)
+	}
+	switch v := value.(type) {
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case bool:
+		return v
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return len(getExportList(value)) > 0
+}
+
+func renderExportValue(value interface{}, references map[string]string) string {
+	switch v := value.(type) {
+	case string:
+		if reference, ok := references[v]; ok {
+			return reference
+		}
+		return quoteExportString(v)
+	case *schema.Set, []interface{}:
+		var elems []string
+		for _, elem := range getExportList(v) {
+			elems = append(elems, renderExportValue(elem, references))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var elems []string
+		for _, key := range keys {
+			elems = append(elems, fmt.Sprintf("%s = %s", quoteExportString(key), renderExportValue(v[key], references)))
+		}
+		return "{ " + strings.Join(elems, ", ") + " }"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// Quotes string for HCL, escaping template sequences
+func quoteExportString(value string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		"\"", "\\\"",
+		"\n", "\\n",
+		"\r", "\\r",
+		"\t", "\\t",
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return "\"" + replacer.Replace(value) + "\""
+}
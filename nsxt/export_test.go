@@ -0,0 +1,76 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/common"
+)
+
+func TestMockNsxExport(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+	provider := Provider()
+
+	tier1 := provider.ResourcesMap["nsxt_policy_tier1_gateway"]
+	d := schema.TestResourceDataRaw(t, tier1.Schema, map[string]interface{}{
+		"display_name": "mock export gw",
+		"nsx_id":       "export-gw",
+	})
+	if diags := tier1.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	segment := provider.ResourcesMap["nsxt_policy_segment"]
+	d = schema.TestResourceDataRaw(t, segment.Schema, map[string]interface{}{
+		"display_name":        "mock-export-segment",
+		"description":         "Segment with \"quoted\" ${text}",
+		"connectivity_path":   "/infra/tier-1s/export-gw",
+		"transport_zone_path": "/infra/sites/default/enforcement-points/default/transport-zones/overlay-tz",
+		"subnet": []interface{}{
+			map[string]interface{}{"cidr": "12.12.3.1/24"},
+		},
+	})
+	if diags := segment.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	var out bytes.Buffer
+	options := exportOptions{types: []string{"nsxt_policy_tier1_gateway", "nsxt_policy_segment"}}
+	if err := exportPolicyResources(context.Background(), provider, m, options, &out); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	hcl := out.String()
+	for _, expected := range []string{
+		"resource \"nsxt_policy_tier1_gateway\" \"mock_export_gw\" {",
+		"import {\n  to = nsxt_policy_tier1_gateway.mock_export_gw\n  id = \"/infra/tier-1s/export-gw\"\n}",
+		"resource \"nsxt_policy_segment\" \"mock_export_segment\" {",
+		"  connectivity_path   = nsxt_policy_tier1_gateway.mock_export_gw.path\n",
+		"  description         = \"Segment with \\\"quoted\\\" $${text}\"\n",
+		"\n  subnet {\n    cidr = \"12.12.3.1/24\"\n  }\n",
+	} {
+		if !strings.Contains(hcl, expected) {
+			t.Fatalf("Expected export to contain %q, got:\n%s", expected, hcl)
+		}
+	}
+	if strings.Contains(hcl, "tags_all") || strings.Contains(hcl, "revision") {
+		t.Fatalf("Expected computed attributes to be omitted from export, got:\n%s", hcl)
+	}
+
+	// Tag filter excludes untagged objects
+	options.tags = []common.Tag{{Scope: "owner", Tag: "none"}}
+	out.Reset()
+	if err := exportPolicyResources(context.Background(), provider, m, options, &out); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Fatalf("Expected empty export, got:\n%s", out.String())
+	}
+}
@@ -280,7 +280,7 @@ func initChildLocaleService(serviceStruct *model.LocaleServices, markForDelete b
 	return dataValue.(*data.StructValue), nil
 }
 
-func initGatewayLocaleServices(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, listLocaleServicesFunc func(utl.SessionContext, client.Connector, string) ([]model.LocaleServices, error)) ([]*data.StructValue, error) {
+func initGatewayLocaleServices(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, listLocaleServicesFunc func(utl.SessionContext, client.Connector, string) ([]model.LocaleServices, error), m interface{}) ([]*data.StructValue, error) {
 	var localeServices []*data.StructValue
 
 	services := d.Get("locale_service").(*schema.Set).List()
@@ -332,7 +332,7 @@ func initGatewayLocaleServices(context utl.SessionContext, d *schema.ResourceDat
 		if redistribution != nil {
 			redistributionConfigs := redistribution.([]interface{})
 			if len(redistributionConfigs) > 0 {
-				setLocaleServiceRedistributionConfig(redistributionConfigs, &serviceStruct)
+				setLocaleServiceRedistributionConfig(redistributionConfigs, &serviceStruct, m)
 				d.Set("redistribution_set", true)
 			} else {
 				d.Set("redistribution_set", false)
@@ -507,7 +507,7 @@ func getRedistributionConfigSchema() *schema.Schema {
 	}
 }
 
-func setLocaleServiceRedistributionRulesConfig(rulesConfig []interface{}, config *model.Tier0RouteRedistributionConfig) {
+func setLocaleServiceRedistributionRulesConfig(rulesConfig []interface{}, config *model.Tier0RouteRedistributionConfig, m interface{}) {
 	var rules []model.Tier0RouteRedistributionRule
 	for _, ruleConfig := range rulesConfig {
 		data := ruleConfig.(map[string]interface{})
@@ -529,7 +529,7 @@ func setLocaleServiceRedistributionRulesConfig(rulesConfig []interface{}, config
 			rule.RouteMapPath = &routeMapPath
 		}
 
-		if nsxVersionHigherOrEqual("3.1.0") {
+		if nsxVersionHigherOrEqual(m, "3.1.0") {
 			if bgp {
 				rule.Destinations = append(rule.Destinations, model.Tier0RouteRedistributionRule_DESTINATIONS_BGP)
 			}
@@ -546,7 +546,7 @@ func setLocaleServiceRedistributionRulesConfig(rulesConfig []interface{}, config
 	}
 }
 
-func setLocaleServiceRedistributionConfig(redistributionConfigs []interface{}, serviceStruct *model.LocaleServices) {
+func setLocaleServiceRedistributionConfig(redistributionConfigs []interface{}, serviceStruct *model.LocaleServices, m interface{}) {
 	if len(redistributionConfigs) == 0 {
 		return
 	}
@@ -560,22 +560,22 @@ func setLocaleServiceRedistributionConfig(redistributionConfigs []interface{}, s
 		BgpEnabled: &bgpEnabled,
 	}
 
-	if nsxVersionHigherOrEqual("3.1.0") {
+	if nsxVersionHigherOrEqual(m, "3.1.0") {
 		redistributionStruct.OspfEnabled = &ospfEnabled
 	}
 
-	setLocaleServiceRedistributionRulesConfig(rulesConfig, &redistributionStruct)
+	setLocaleServiceRedistributionRulesConfig(rulesConfig, &redistributionStruct, m)
 	serviceStruct.RouteRedistributionConfig = &redistributionStruct
 }
 
-func getLocaleServiceRedistributionRuleConfig(config *model.Tier0RouteRedistributionConfig) []map[string]interface{} {
+func getLocaleServiceRedistributionRuleConfig(config *model.Tier0RouteRedistributionConfig, m interface{}) []map[string]interface{} {
 	var rules []map[string]interface{}
 	for _, ruleConfig := range config.RedistributionRules {
 		rule := make(map[string]interface{})
 		rule["name"] = ruleConfig.Name
 		rule["route_map_path"] = ruleConfig.RouteMapPath
 		rule["types"] = ruleConfig.RouteRedistributionTypes
-		if nsxVersionHigherOrEqual("3.1.0") {
+		if nsxVersionHigherOrEqual(m, "3.1.0") {
 			bgp := false
 			ospf := false
 			for _, destination := range ruleConfig.Destinations {
@@ -596,7 +596,7 @@ func getLocaleServiceRedistributionRuleConfig(config *model.Tier0RouteRedistribu
 	return rules
 }
 
-func getLocaleServiceRedistributionConfig(serviceStruct *model.LocaleServices) []map[string]interface{} {
+func getLocaleServiceRedistributionConfig(serviceStruct *model.LocaleServices, m interface{}) []map[string]interface{} {
 	var redistributionConfigs []map[string]interface{}
 	config := serviceStruct.RouteRedistributionConfig
 	if config == nil {
@@ -606,7 +606,7 @@ func getLocaleServiceRedistributionConfig(serviceStruct *model.LocaleServices) [
 	elem := make(map[string]interface{})
 	elem["enabled"] = config.BgpEnabled
 	elem["ospf_enabled"] = config.OspfEnabled
-	elem["rule"] = getLocaleServiceRedistributionRuleConfig(config)
+	elem["rule"] = getLocaleServiceRedistributionRuleConfig(config, m)
 	redistributionConfigs = append(redistributionConfigs, elem)
 	return redistributionConfigs
 }
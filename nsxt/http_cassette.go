@@ -0,0 +1,301 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Cassette mode and file are selected via environment, so that same cassette
+// is shared by all provider instances within the process, including those
+// configured by acceptance test framework
+const (
+	cassetteModeEnv  = "NSXT_CASSETTE_MODE"
+	cassetteFileEnv  = "NSXT_CASSETTE_FILE"
+	cassetteModeRec  = "record"
+	cassetteModePlay = "replay"
+)
+
+// Single line in cassette file, which holds either HTTP interaction, ID
+// generated by the provider, or random seed used by tests, in order of occurrence
+type cassetteEntry struct {
+	Interaction *cassetteInteraction `json:"interaction,omitempty"`
+	GeneratedID string               `json:"generated_id,omitempty"`
+	RandomSeed  int64                `json:"random_seed,omitempty"`
+}
+
+type cassetteInteraction struct {
+	Method          string      `json:"method"`
+	URL             string      `json:"url"`
+	RequestBody     string      `json:"request_body,omitempty"`
+	StatusCode      int         `json:"status_code"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    string      `json:"response_body,omitempty"`
+}
+
+// httpCassette records HTTP interactions with NSX into cassette file, or
+// serves recorded interactions without reaching NSX.
+// Interactions are replayed in recorded order for each method and URL, which
+// keeps replay deterministic as long as the provider issues same requests in
+// same order, i.e. when tests are run sequentially.
+type httpCassette struct {
+	lock         sync.Mutex
+	mode         string
+	file         *os.File
+	interactions map[string][]*cassetteInteraction
+	generatedIDs []string
+	randomSeed   int64
+}
+
+var httpCassetteOnce sync.Once
+var httpCassetteInstance *httpCassette
+var httpCassetteErr error
+
+// Returns cassette configured via environment, or nil if cassette mode is off
+func getHTTPCassette() (*httpCassette, error) {
+	httpCassetteOnce.Do(func() {
+		httpCassetteInstance, httpCassetteErr = newHTTPCassette(os.Getenv(cassetteModeEnv), os.Getenv(cassetteFileEnv))
+	})
+	return httpCassetteInstance, httpCassetteErr
+}
+
+func newHTTPCassette(mode string, fileName string) (*httpCassette, error) {
+	if mode == "" {
+		return nil, nil
+	}
+	if fileName == "" {
+		return nil, fmt.Errorf("%s must be set for cassette mode %s", cassetteFileEnv, mode)
+	}
+
+	cassette := &httpCassette{
+		mode:         mode,
+		interactions: make(map[string][]*cassetteInteraction),
+	}
+	switch mode {
+	case cassetteModeRec:
+		file, err := os.OpenFile(fileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cassette %s: %v", fileName, err)
+		}
+		cassette.file = file
+		log.Printf("[INFO] Recording NSX API interactions into cassette %s", fileName)
+	case cassetteModePlay:
+		if err := cassette.load(fileName); err != nil {
+			return nil, err
+		}
+		log.Printf("[INFO] Replaying NSX API interactions from cassette %s", fileName)
+	default:
+		return nil, fmt.Errorf("unsupported cassette mode %s, expected %s or %s", mode, cassetteModeRec, cassetteModePlay)
+	}
+	return cassette, nil
+}
+
+func (c *httpCassette) load(fileName string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("failed to open cassette %s: %v", fileName, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var entry cassetteEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("failed to parse cassette %s line %d: %v", fileName, line, err)
+		}
+		if entry.Interaction != nil {
+			key := getCassetteKey(entry.Interaction.Method, entry.Interaction.URL)
+			c.interactions[key] = append(c.interactions[key], entry.Interaction)
+		}
+		if entry.GeneratedID != "" {
+			c.generatedIDs = append(c.generatedIDs, entry.GeneratedID)
+		}
+		if entry.RandomSeed != 0 {
+			c.randomSeed = entry.RandomSeed
+		}
+	}
+	return scanner.Err()
+}
+
+// Should be called under lock
+func (c *httpCassette) write(entry cassetteEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARNING] Failed to encode cassette entry: %v", err)
+		return
+	}
+	if _, err := c.file.Write(append(data, '\n')); err != nil {
+		log.Printf("[WARNING] Failed to write cassette entry: %v", err)
+	}
+}
+
+// Host is not part of the key, so that interactions recorded against one
+// manager can be replayed regardless of manager address
+func getCassetteKey(method string, url string) string {
+	return method + " " + url
+}
+
+// Query parameters are sorted, since SDK does not guarantee their order
+func getCassetteURL(req *http.Request) string {
+	if req.URL.RawQuery == "" {
+		return req.URL.Path
+	}
+	return req.URL.Path + "?" + req.URL.Query().Encode()
+}
+
+// Records ID generated by the provider, or returns recorded ID when replaying
+func (c *httpCassette) generateID(generate func() string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.mode == cassetteModePlay {
+		if len(c.generatedIDs) == 0 {
+			log.Printf("[WARNING] No recorded IDs left in cassette, generating new ID")
+			return generate()
+		}
+		id := c.generatedIDs[0]
+		c.generatedIDs = c.generatedIDs[1:]
+		return id
+	}
+	id := generate()
+	c.write(cassetteEntry{GeneratedID: id})
+	return id
+}
+
+// Records seed for random values that end up in requests, such as names of
+// test objects, or returns recorded seed when replaying
+func (c *httpCassette) getRandomSeed(seed int64) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.mode == cassetteModePlay {
+		if c.randomSeed == 0 {
+			log.Printf("[WARNING] No random seed recorded in cassette, using new seed")
+			return seed
+		}
+		return c.randomSeed
+	}
+	c.write(cassetteEntry{RandomSeed: seed})
+	return seed
+}
+
+// Response headers are stored with credentials and session tokens redacted
+func getCassetteResponseHeaders(header http.Header) http.Header {
+	result := header.Clone()
+	for name := range result {
+		for _, sensitiveHeader := range sensitiveHTTPHeaders {
+			if strings.EqualFold(name, sensitiveHeader) {
+				result.Set(name, redactedValue)
+			}
+		}
+	}
+	return result
+}
+
+func (c *httpCassette) record(req *http.Request, requestBody []byte, resp *http.Response, responseBody []byte) {
+	interaction := &cassetteInteraction{
+		Method:          req.Method,
+		URL:             getCassetteURL(req),
+		RequestBody:     redactHTTPBody(string(requestBody), req.Header.Get("Content-Type")),
+		StatusCode:      resp.StatusCode,
+		ResponseHeaders: getCassetteResponseHeaders(resp.Header),
+		ResponseBody:    redactHTTPBody(string(responseBody), resp.Header.Get("Content-Type")),
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.write(cassetteEntry{Interaction: interaction})
+}
+
+func (c *httpCassette) replay(req *http.Request) (*http.Response, error) {
+	url := getCassetteURL(req)
+	key := getCassetteKey(req.Method, url)
+	c.lock.Lock()
+	interactions := c.interactions[key]
+	if len(interactions) == 0 {
+		c.lock.Unlock()
+		return nil, fmt.Errorf("no recorded interaction left in cassette for %s %s", req.Method, url)
+	}
+	interaction := interactions[0]
+	c.interactions[key] = interactions[1:]
+	c.lock.Unlock()
+
+	if req.Body != nil {
+		io.Copy(io.Discard, req.Body)
+		req.Body.Close()
+	}
+
+	header := interaction.ResponseHeaders.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.ResponseBody)),
+		ContentLength: int64(len(interaction.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+// cassetteTransport is the innermost transport of NSX clients when cassette
+// mode is on, so that interactions are recorded as seen on the wire
+type cassetteTransport struct {
+	transport http.RoundTripper
+	cassette  *httpCassette
+}
+
+// Wraps transport with cassette, if cassette mode is configured
+func newCassetteTransport(transport http.RoundTripper) (http.RoundTripper, error) {
+	cassette, err := getHTTPCassette()
+	if err != nil || cassette == nil {
+		return transport, err
+	}
+	return &cassetteTransport{
+		transport: transport,
+		cassette:  cassette,
+	}, nil
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cassette.mode == cassetteModePlay {
+		return t.cassette.replay(req)
+	}
+
+	var requestBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		// Transport errors are not recorded
+		return resp, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	t.cassette.record(req, requestBody, resp, responseBody)
+	return resp, nil
+}
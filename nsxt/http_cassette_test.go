@@ -0,0 +1,99 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testMockUseCassette(t *testing.T, cassette *httpCassette) {
+	httpCassetteOnce.Do(func() {})
+	previous := httpCassetteInstance
+	httpCassetteInstance = cassette
+	t.Cleanup(func() {
+		httpCassetteInstance = previous
+	})
+}
+
+func testMockCassetteLifecycle(t *testing.T, host string) string {
+	m := testMockGetProviderMetaWithHost(t, host)
+	resource := resourceNsxtPolicyGroup()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-cassette-group",
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	d.Set("description", "updated")
+	if diags := resource.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	if d.Get("description").(string) != "updated" {
+		t.Fatalf("Expected description to be updated, got %s", d.Get("description").(string))
+	}
+	id := d.Id()
+	if diags := resource.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+	return id
+}
+
+func TestMockNsxCassette(t *testing.T) {
+	fileName := t.TempDir() + "/cassette.jsonl"
+	server := newMockNsxServer()
+	host := server.Host()
+
+	recorder, err := newHTTPCassette(cassetteModeRec, fileName)
+	if err != nil {
+		t.Fatalf("Failed to create cassette: %v", err)
+	}
+	testMockUseCassette(t, recorder)
+	recordedID := testMockCassetteLifecycle(t, host)
+	recorder.file.Close()
+	server.Close()
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatalf("Failed to read cassette: %v", err)
+	}
+	if strings.Contains(string(data), mockNsxPassword) {
+		t.Fatalf("Password found in recorded cassette")
+	}
+
+	// Replay against closed server
+	player, err := newHTTPCassette(cassetteModePlay, fileName)
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %v", err)
+	}
+	testMockUseCassette(t, player)
+	if replayedID := testMockCassetteLifecycle(t, host); replayedID != recordedID {
+		t.Fatalf("Expected replayed ID %s to match recorded ID %s", replayedID, recordedID)
+	}
+}
+
+func TestMockNsxCassetteRandomSeed(t *testing.T) {
+	fileName := t.TempDir() + "/cassette.jsonl"
+
+	recorder, err := newHTTPCassette(cassetteModeRec, fileName)
+	if err != nil {
+		t.Fatalf("Failed to create cassette: %v", err)
+	}
+	if seed := recorder.getRandomSeed(42); seed != 42 {
+		t.Fatalf("Expected seed to be kept when recording, got %d", seed)
+	}
+	recorder.file.Close()
+
+	player, err := newHTTPCassette(cassetteModePlay, fileName)
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %v", err)
+	}
+	if seed := player.getRandomSeed(7); seed != 42 {
+		t.Fatalf("Expected recorded seed to be replayed, got %d", seed)
+	}
+}
@@ -0,0 +1,267 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const redactedValue = "<redacted>"
+
+// HTTP headers that carry credentials or session identifiers
+var sensitiveHTTPHeaders = []string{
+	"Authorization",
+	"Csp-Auth-Token",
+	"Cookie",
+	"Set-Cookie",
+	"X-Xsrf-Token",
+}
+
+// NSX API JSON keys and form fields that carry secrets, in addition to
+// attributes marked as sensitive in provider schema
+var sensitiveAPIKeys = []string{
+	"password",
+	"old_password",
+	"root_password",
+	"cli_password",
+	"audit_password",
+	"bind_password",
+	"j_password",
+	"private_key",
+	"passphrase",
+	"psk",
+	"secret",
+	"secret_key",
+	"client_secret",
+	"session_id",
+	"access_token",
+	"refresh_token",
+	"token",
+	"credential_key",
+	"asymmetric_credential",
+	"credential_verifier",
+	"authentication_key",
+}
+
+var sensitiveLogKeysOnce sync.Once
+var sensitiveLogKeys []string
+
+func collectSensitiveSchemaKeys(s map[string]*schema.Schema, keys map[string]bool) {
+	for name, attr := range s {
+		if attr.Sensitive {
+			keys[name] = true
+		}
+		if elem, ok := attr.Elem.(*schema.Resource); ok {
+			collectSensitiveSchemaKeys(elem.Schema, keys)
+		}
+	}
+}
+
+// Returns names of keys to be redacted in HTTP logs, which are NSX API keys
+// known to be sensitive and all sensitive attributes in provider schema
+func getSensitiveLogKeys() []string {
+	sensitiveLogKeysOnce.Do(func() {
+		keys := make(map[string]bool)
+		for _, key := range sensitiveAPIKeys {
+			keys[key] = true
+		}
+		provider := Provider()
+		collectSensitiveSchemaKeys(provider.Schema, keys)
+		for _, resource := range provider.ResourcesMap {
+			collectSensitiveSchemaKeys(resource.Schema, keys)
+		}
+		for _, dataSource := range provider.DataSourcesMap {
+			collectSensitiveSchemaKeys(dataSource.Schema, keys)
+		}
+		for key := range keys {
+			sensitiveLogKeys = append(sensitiveLogKeys, key)
+		}
+		sort.Strings(sensitiveLogKeys)
+	})
+	return sensitiveLogKeys
+}
+
+var sensitiveJSONKeyRegexpOnce sync.Once
+var sensitiveJSONKeyRegexp *regexp.Regexp
+
+// Matches sensitive JSON key regardless of case, up to its value
+func getSensitiveJSONKeyRegexp() *regexp.Regexp {
+	sensitiveJSONKeyRegexpOnce.Do(func() {
+		var quoted []string
+		for _, key := range getSensitiveLogKeys() {
+			quoted = append(quoted, regexp.QuoteMeta(key))
+		}
+		sensitiveJSONKeyRegexp = regexp.MustCompile(`(?i)"(?:` + strings.Join(quoted, "|") + `)"\s*:\s*`)
+	})
+	return sensitiveJSONKeyRegexp
+}
+
+func isSensitiveLogKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitiveKey := range getSensitiveLogKeys() {
+		if key == sensitiveKey {
+			return true
+		}
+	}
+	return false
+}
+
+// Replaces values of sensitive keys in decoded JSON, whatever their type, and
+// returns whether anything was redacted
+func redactJSONValue(value interface{}) bool {
+	redacted := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if isSensitiveLogKey(key) {
+				v[key] = redactedValue
+				redacted = true
+			} else if redactJSONValue(elem) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if redactJSONValue(elem) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+// Returns length of JSON value at the beginning of text, which is a string,
+// an object or an array with nested brackets balanced, or a scalar. Value
+// that is not terminated, such as in truncated text, spans till the end.
+func scanJSONValue(text string) int {
+	depth := 0
+	inString := false
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case inString && c == '\\':
+			i++
+		case inString && c == '"':
+			inString = false
+			if depth == 0 {
+				return i + 1
+			}
+		case inString:
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			if depth == 0 {
+				return i
+			}
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case depth == 0 && (c == ',' || c == ' ' || c == '\t' || c == '\r' || c == '\n'):
+			return i
+		}
+	}
+	return len(text)
+}
+
+// Masks values of sensitive keys in text that is not valid JSON, such as
+// truncated body
+func redactJSONText(text string) string {
+	var sb strings.Builder
+	for {
+		loc := getSensitiveJSONKeyRegexp().FindStringIndex(text)
+		if loc == nil {
+			sb.WriteString(text)
+			return sb.String()
+		}
+		sb.WriteString(text[:loc[1]])
+		sb.WriteString(`"` + redactedValue + `"`)
+		text = text[loc[1]+scanJSONValue(text[loc[1]:]):]
+	}
+}
+
+// Masks values of sensitive keys in JSON body. Body is only re-encoded when
+// something is redacted, in order to keep it intact otherwise.
+func redactJSONBody(body string) string {
+	if !getSensitiveJSONKeyRegexp().MatchString(body) {
+		return body
+	}
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return redactJSONText(body)
+	}
+	if !redactJSONValue(value) {
+		return body
+	}
+	var redacted strings.Builder
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return redactJSONText(body)
+	}
+	return strings.TrimSuffix(redacted.String(), "\n")
+}
+
+// Masks values of sensitive headers in HTTP dump header section
+func redactHTTPHeaders(header string) string {
+	lines := strings.Split(header, "\r\n")
+	for i, line := range lines {
+		name, _, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		for _, sensitiveHeader := range sensitiveHTTPHeaders {
+			if strings.EqualFold(strings.TrimSpace(name), sensitiveHeader) {
+				lines[i] = http.CanonicalHeaderKey(strings.TrimSpace(name)) + ": " + redactedValue
+				break
+			}
+		}
+	}
+	return strings.Join(lines, "\r\n")
+}
+
+// Masks values of sensitive keys in HTTP body, which is expected to be either
+// JSON or URL encoded form
+func redactHTTPBody(body string, contentType string) string {
+	if strings.HasPrefix(strings.ToLower(contentType), "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(body)
+		if err == nil {
+			for key := range form {
+				if isSensitiveLogKey(key) {
+					form.Set(key, redactedValue)
+				}
+			}
+			return form.Encode()
+		}
+	}
+	return redactJSONBody(body)
+}
+
+// Redacts credentials, session identifiers and secrets from HTTP request or
+// response dump
+func redactHTTPDump(dump []byte, header http.Header) string {
+	head, body, found := strings.Cut(string(dump), "\r\n\r\n")
+	head = redactHTTPHeaders(head)
+	if !found {
+		return head
+	}
+	contentType := ""
+	if header != nil {
+		contentType = header.Get("Content-Type")
+	}
+	return head + "\r\n\r\n" + redactHTTPBody(body, contentType)
+}
@@ -0,0 +1,81 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/bgp"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestMockNsxHTTPLogRedaction(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	t.Setenv("TF_LOG_PROVIDER_NSX_HTTP", "1")
+	m := testMockGetProviderMeta(t, server)
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	secret := "mock-bgp-secret"
+	address := "10.0.0.1"
+	remoteAs := "65000"
+	obj := model.BgpNeighborConfig{
+		NeighborAddress: &address,
+		RemoteAsNum:     &remoteAs,
+		Password:        &secret,
+	}
+	client := bgp.NewNeighborsClient(getPolicyConnector(m))
+	if err := client.Patch("mock-t0", "default", "mock-neighbor", obj, nil); err != nil {
+		t.Fatalf("Failed to create BGP neighbor: %v", err)
+	}
+	if _, err := client.Get("mock-t0", "default", "mock-neighbor"); err != nil {
+		t.Fatalf("Failed to read BGP neighbor: %v", err)
+	}
+	log.SetOutput(os.Stderr)
+
+	output := buf.String()
+	if !strings.Contains(output, "Issuing request towards NSX") || !strings.Contains(output, "Received NSX response") {
+		t.Fatalf("Expected HTTP requests and responses to be logged")
+	}
+	if strings.Contains(output, secret) {
+		t.Fatalf("Secret found in HTTP log:\n%s", output)
+	}
+	if !strings.Contains(output, redactedValue) {
+		t.Fatalf("Expected redacted values in HTTP log:\n%s", output)
+	}
+}
+
+func TestRedactHTTPBody(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+	}{
+		{`{"display_name": "t0"}`, `{"display_name": "t0"}`},
+		{`{"Password": "secret", "display_name": "t0"}`, "{\n  \"Password\": \"<redacted>\",\n  \"display_name\": \"t0\"\n}"},
+		{`{"results": [{"PRIVATE_KEY": {"pem": "secret"}, "psk": ["secret"], "id": 7}]}`, "{\n  \"results\": [\n    {\n      \"PRIVATE_KEY\": \"<redacted>\",\n      \"id\": 7,\n      \"psk\": \"<redacted>\"\n    }\n  ]\n}"},
+		{`{"Secret": {"value": "secret", "nested": [1, {"a": "}"}]}, "token": 12, "id": "t`, `{"Secret": "<redacted>", "token": "<redacted>", "id": "t`},
+		{`{"password": ["secret", "trunc`, `{"password": "<redacted>"`},
+	}
+	for _, test := range tests {
+		redacted := redactHTTPBody(test.body, "application/json")
+		if redacted != test.expected {
+			t.Errorf("Unexpected redaction of %s:\n%s\nexpected:\n%s", test.body, redacted, test.expected)
+		}
+		if strings.Contains(redacted, "secret") {
+			t.Errorf("Secret found in redacted body %s", redacted)
+		}
+	}
+
+	redacted := redactHTTPBody("j_username=admin&J_PASSWORD=secret", "application/x-www-form-urlencoded")
+	if strings.Contains(redacted, "secret") {
+		t.Errorf("Secret found in redacted form %s", redacted)
+	}
+}
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -271,20 +272,20 @@ func fillLbHTTPRuleHeaderConditionInSchema(data map[string]interface{}, conditio
 	data["case_sensitive"] = *condition.CaseSensitive
 }
 
-func resourceNsxtLbHTTPRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerRule(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LoadBalancerRule delete: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -0,0 +1,171 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const managerHealthProbePath = "/api/v1/reverse-proxy/node/health"
+const managerHealthProbeTimeout = 10 * time.Second
+
+// Returns manager addresses configured in provider, without schema
+func getProviderHosts(d *schema.ResourceData) []string {
+	var hosts []string
+	for _, host := range strings.Split(d.Get("host").(string), ",") {
+		host = strings.TrimPrefix(strings.TrimSpace(host), "https://")
+		if host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// nsxtManagerPool tracks the active manager out of ordered list of NSX managers,
+// and moves to the next healthy manager when the active one is not reachable
+type nsxtManagerPool struct {
+	lock      sync.Mutex
+	hosts     []string
+	active    int
+	transport http.RoundTripper
+}
+
+func newNsxtManagerPool(hosts []string, transport http.RoundTripper) *nsxtManagerPool {
+	return &nsxtManagerPool{
+		hosts:     hosts,
+		transport: transport,
+	}
+}
+
+func (pool *nsxtManagerPool) activeHost() string {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+	return pool.hosts[pool.active]
+}
+
+// Manager is considered healthy if it responds to health probe without server error
+func (pool *nsxtManagerPool) probe(host string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), managerHealthProbeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s%s", host, managerHealthProbePath), nil)
+	if err != nil {
+		return false
+	}
+	resp, err := pool.transport.RoundTrip(req)
+	if err != nil {
+		log.Printf("[DEBUG] NSX manager %s health probe failed: %v", host, err)
+		return false
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		log.Printf("[DEBUG] NSX manager %s health probe responded with status %d", host, resp.StatusCode)
+		return false
+	}
+	return true
+}
+
+// Select first healthy manager in the configured order
+func (pool *nsxtManagerPool) selectHealthyHost() error {
+	// Probes are done without holding the lock, in order not to block
+	// concurrent requests, while list of hosts is never modified
+	for i, host := range pool.hosts {
+		if pool.probe(host) {
+			pool.lock.Lock()
+			pool.active = i
+			pool.lock.Unlock()
+			log.Printf("[INFO] Using NSX manager %s", host)
+			return nil
+		}
+	}
+	return fmt.Errorf("None of NSX managers %s is reachable", strings.Join(pool.hosts, ", "))
+}
+
+// Move on to the next healthy manager after the failed one, unless this was
+// already done by concurrent request
+func (pool *nsxtManagerPool) failover(failedHost string) (string, error) {
+	pool.lock.Lock()
+	failedIndex := pool.active
+	pool.lock.Unlock()
+	if pool.hosts[failedIndex] != failedHost {
+		return pool.hosts[failedIndex], nil
+	}
+
+	for i := 1; i < len(pool.hosts); i++ {
+		index := (failedIndex + i) % len(pool.hosts)
+		if !pool.probe(pool.hosts[index]) {
+			continue
+		}
+
+		pool.lock.Lock()
+		defer pool.lock.Unlock()
+		if pool.active != failedIndex {
+			// Concurrent request has failed over while probing
+			return pool.hosts[pool.active], nil
+		}
+		log.Printf("[WARNING] NSX manager %s is not reachable, failing over to %s", failedHost, pool.hosts[index])
+		pool.active = index
+		return pool.hosts[index], nil
+	}
+	return "", fmt.Errorf("None of NSX managers %s is reachable", strings.Join(pool.hosts, ", "))
+}
+
+// managerFailoverTransport sends requests to the active manager, and re-issues
+// the request against another healthy manager upon connection error
+type managerFailoverTransport struct {
+	transport http.RoundTripper
+	pool      *nsxtManagerPool
+}
+
+func newManagerFailoverTransport(transport http.RoundTripper, pool *nsxtManagerPool) *managerFailoverTransport {
+	return &managerFailoverTransport{
+		transport: transport,
+		pool:      pool,
+	}
+}
+
+func (t *managerFailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Keep the body in order to be able to re-issue the request
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	host := t.pool.activeHost()
+	for attempt := 0; ; attempt++ {
+		hostReq := req.Clone(req.Context())
+		hostReq.URL.Host = host
+		hostReq.Host = host
+		if body != nil {
+			hostReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		resp, err := t.transport.RoundTrip(hostReq)
+		if err == nil || req.Context().Err() != nil || attempt >= len(t.pool.hosts)-1 {
+			return resp, err
+		}
+
+		log.Printf("[DEBUG] Request to NSX manager %s failed: %v", host, err)
+		var failoverErr error
+		host, failoverErr = t.pool.failover(host)
+		if failoverErr != nil {
+			log.Printf("[ERROR] %v", failoverErr)
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,77 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+// Transport that blocks health probes until released
+type mockBlockingTransport struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (t *mockBlockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.started <- struct{}{}
+	<-t.release
+	return nil, fmt.Errorf("manager %s is not reachable", req.URL.Host)
+}
+
+func TestMockNsxManagerFailoverProbeNotBlocking(t *testing.T) {
+	transport := &mockBlockingTransport{
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	pool := newNsxtManagerPool([]string{"manager1", "manager2"}, transport)
+	go pool.failover("manager1")
+	<-transport.started
+
+	done := make(chan string)
+	go func() { done <- pool.activeHost() }()
+	select {
+	case host := <-done:
+		if host != "manager1" {
+			t.Fatalf("Expected active manager not to change while probing, got %s", host)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Active manager lookup is blocked by health probe")
+	}
+	close(transport.release)
+}
+
+func TestMockNsxManagerFailover(t *testing.T) {
+	unreachable := newMockNsxServer()
+	unreachableHost := unreachable.Host()
+	unreachable.Close()
+	server1 := newMockNsxServer()
+	defer server1.Close()
+	server2 := newMockNsxServer()
+	defer server2.Close()
+
+	// First manager is down during provider configuration
+	m := testMockGetProviderMetaWithHost(t, fmt.Sprintf("%s, %s,%s", unreachableHost, server1.Host(), server2.Host()))
+	if active := m.(nsxtClients).ManagerPool.activeHost(); active != server1.Host() {
+		t.Fatalf("Expected active manager %s, got %s", server1.Host(), active)
+	}
+
+	// Active manager goes down in the middle of the run
+	server1.Close()
+	if _, err := infra.NewDomainsClient(getPolicyConnector(m)).Get("default"); err != nil {
+		t.Fatalf("Policy request failed after manager failure: %v", err)
+	}
+	mpClient := m.(nsxtClients).NsxtClient
+	if _, _, err := mpClient.LogicalSwitchingApi.CreateLogicalSwitch(mpClient.Context, manager.LogicalSwitch{DisplayName: "mock-switch"}); err != nil {
+		t.Fatalf("MP request failed after manager failure: %v", err)
+	}
+	if active := m.(nsxtClients).ManagerPool.activeHost(); active != server2.Host() {
+		t.Fatalf("Expected active manager %s, got %s", server2.Host(), active)
+	}
+}
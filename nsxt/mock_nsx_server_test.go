@@ -0,0 +1,1344 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mock NSX server is an in-process stand-in for NSX Manager, that allows running
+// acceptance tests without NSX endpoint. In order to run tests against mock server,
+// set NSXT_TEST_MOCK_SERVER environment variable.
+// The server keeps a generic object tree for policy (/policy/api/v1) and MP (/api/v1)
+// APIs, and implements PATCH/PUT/GET/DELETE semantics, H-API for /infra,
+// revision checks, realization and search APIs. It has no knowledge of specific
+// object schemas, thus only basic semantics can be validated against it.
+
+const mockNsxDefaultVersion = "4.1.0.0.0"
+const mockNsxUsername = "admin"
+const mockNsxPassword = "MockPassword123!"
+const mockNsxDefaultPageSize = 1000
+
+const (
+	mockPolicyPrefix = "/policy/api/v1"
+	mockGlobalPrefix = "/global-manager/api/v1"
+	mockMPPrefix     = "/api/v1"
+)
+
+// Mapping between policy resource types and collection segments in the path
+var mockResourceTypeCollections = map[string]string{
+	"Domain":                                "domains",
+	"Group":                                 "groups",
+	"SecurityPolicy":                        "security-policies",
+	"GatewayPolicy":                         "gateway-policies",
+	"IdsSecurityPolicy":                     "intrusion-service-policies",
+	"Rule":                                  "rules",
+	"IdsRule":                               "rules",
+	"Segment":                               "segments",
+	"SegmentPort":                           "ports",
+	"Tier0":                                 "tier-0s",
+	"Tier1":                                 "tier-1s",
+	"LocaleServices":                        "locale-services",
+	"Tier0Interface":                        "interfaces",
+	"Tier1Interface":                        "interfaces",
+	"StaticRoutes":                          "static-routes",
+	"PolicyNatRule":                         "nat-rules",
+	"PolicyNat":                             "nat",
+	"Service":                               "services",
+	"ServiceEntry":                          "service-entries",
+	"L4PortSetServiceEntry":                 "service-entries",
+	"ICMPTypeServiceEntry":                  "service-entries",
+	"IGMPTypeServiceEntry":                  "service-entries",
+	"IPProtocolServiceEntry":                "service-entries",
+	"EtherTypeServiceEntry":                 "service-entries",
+	"ALGTypeServiceEntry":                   "service-entries",
+	"NestedServiceServiceEntry":             "service-entries",
+	"SegmentDiscoveryProfileBindingMap":     "segment-discovery-profile-binding-maps",
+	"SegmentSecurityProfileBindingMap":      "segment-security-profile-binding-maps",
+	"SegmentQosProfileBindingMap":           "segment-qos-profile-binding-maps",
+	"DhcpV4StaticBindingConfig":             "dhcp-static-binding-configs",
+	"DhcpV6StaticBindingConfig":             "dhcp-static-binding-configs",
+	"BgpNeighborConfig":                     "neighbors",
+	"PolicyTransportZone":                   "transport-zones",
+	"PolicyEdgeCluster":                     "edge-clusters",
+	"PolicyEdgeNode":                        "edge-nodes",
+	"EnforcementPoint":                      "enforcement-points",
+	"Site":                                  "sites",
+	"SegmentSecurityProfile":                "segment-security-profiles",
+	"SpoofGuardProfile":                     "spoofguard-profiles",
+	"IPDiscoveryProfile":                    "ip-discovery-profiles",
+	"MacDiscoveryProfile":                   "mac-discovery-profiles",
+	"QoSProfile":                            "qos-profiles",
+	"PolicyContextProfile":                  "context-profiles",
+	"IpAddressBlock":                        "ip-blocks",
+	"IpAddressPool":                         "ip-pools",
+	"Project":                               "projects",
+	"Tier0RouteMap":                         "route-maps",
+	"PrefixList":                            "prefix-lists",
+	"CommunityList":                         "community-lists",
+	"LBPool":                                "lb-pools",
+	"LBService":                             "lb-services",
+	"LBVirtualServer":                       "lb-virtual-servers",
+	"DhcpServerConfig":                      "dhcp-server-configs",
+	"DhcpRelayConfig":                       "dhcp-relay-configs",
+	"PolicyDnsForwarder":                    "dns-forwarder",
+	"PolicyDnsForwarderZone":                "dns-forwarder-zones",
+	"Tier0SecurityFeatureBinding":           "security-config",
+	"Tier1SecurityFeatureBinding":           "security-config",
+	"BfdProfile":                            "bfd-profiles",
+	"Ipv6NdraProfile":                       "ipv6-ndra-profiles",
+	"Ipv6DadProfile":                        "ipv6-dad-profiles",
+	"GatewayQosProfile":                     "gateway-qos-profiles",
+	"IdsProfile":                            "intrusion-service-profiles",
+	"IPSecVpnService":                       "ipsec-vpn-services",
+	"L2VPNService":                          "l2vpn-services",
+	"PolicyVirtualMachine":                  "virtual-machines",
+	"PolicyHostTransportNode":               "host-transport-nodes",
+	"PolicyHostTransportNodeProfile":        "host-transport-node-profiles",
+	"PolicyUplinkHostSwitchProfile":         "host-switch-profiles",
+	"TlsCertificate":                        "certificates",
+	"ContextProfileCustomAttribute":         "custom-attributes",
+	"VniPoolConfig":                         "vni-pools",
+	"EvpnTenantConfig":                      "evpn-tenant-configs",
+	"StaticRouteBfdPeer":                    "bfd-peers",
+	"IpAddressAllocation":                   "ip-allocations",
+	"IpAddressPoolBlockSubnet":              "ip-subnets",
+	"IpAddressPoolStaticSubnet":             "ip-subnets",
+	"Tier0HaVipConfig":                      "ha-vip-configs",
+	"OspfAreaConfig":                        "areas",
+	"SegmentConnectionBindingMap":           "segment-connection-binding-maps",
+	"PortDiscoveryProfileBindingMap":        "port-discovery-profile-binding-maps",
+	"PortQoSProfileBindingMap":              "port-qos-profile-binding-maps",
+	"PortSecurityProfileBindingMap":         "port-security-profile-binding-maps",
+	"LBAppProfile":                          "lb-app-profiles",
+	"LBClientSslProfile":                    "lb-client-ssl-profiles",
+	"LBServerSslProfile":                    "lb-server-ssl-profiles",
+	"LBMonitorProfile":                      "lb-monitor-profiles",
+	"LBPersistenceProfile":                  "lb-persistence-profiles",
+	"IPSecVpnSession":                       "sessions",
+	"L2VPNSession":                          "sessions",
+	"IPSecVpnLocalEndpoint":                 "local-endpoints",
+	"IPSecVpnIkeProfile":                    "ipsec-vpn-ike-profiles",
+	"IPSecVpnTunnelProfile":                 "ipsec-vpn-tunnel-profiles",
+	"IPSecVpnDpdProfile":                    "ipsec-vpn-dpd-profiles",
+	"CommunicationEntry":                    "communication-entries",
+	"Tier1DeploymentMap":                    "tier-1-deployment-maps",
+	"Tier0DeploymentMap":                    "tier-0-deployment-maps",
+	"GroupMonitoringProfileBindingMap":      "group-monitoring-profile-binding-maps",
+	"PolicyFirewallFloodProtectionProfile":  "flood-protection-profiles",
+	"PolicyFirewallSessionTimerProfile":     "session-timer-profiles",
+	"PolicyDraftFirewallConfiguration":      "drafts",
+	"SecurityPolicyContainerCluster":        "container-cluster-span",
+	"DomainDeploymentMap":                   "domain-deployment-maps",
+	"ProjectInfra":                          "infra",
+	"PolicyBridgeProfile":                   "edge-bridge-profiles",
+	"PolicyEdgeHighAvailabilityProfile":     "edge-high-availability-profiles",
+	"PolicyTransportNodeCollection":         "transport-node-collections",
+	"PolicyUplinkHostSwitchProfileOverride": "host-switch-profiles",
+}
+
+// Singleton objects are identified by fixed path segment with no id
+var mockSingletonResourceTypes = map[string]string{
+	"BgpRoutingConfig":  "bgp",
+	"OspfRoutingConfig": "ospf",
+	"EvpnConfig":        "evpn",
+	"PolicyNat":         "nat",
+}
+
+// Realized inventory collections served from objects stored by tests, by
+// collection path in the API
+var mockInventoryCollections = map[string]string{
+	"/infra/realized-state/virtual-machines":                "/infra/realized-state/enforcement-points/default/virtual-machines",
+	"/infra/realized-state/enforcement-points/default/vifs": "/infra/realized-state/enforcement-points/default/vifs",
+}
+
+type mockNsxObject struct {
+	order int64
+	data  map[string]interface{}
+}
+
+type mockNsxServer struct {
+	Server  *httptest.Server
+	Version string
+
+	lock     sync.Mutex
+	objects  map[string]*mockNsxObject
+	sessions map[string]string
+	counter  int64
+	ruleID   int64
+	// Effective group members by members API path, i.e.
+	// /infra/domains/default/groups/g1/members/ip-addresses
+	groupMembers map[string][]interface{}
+	// Realization error messages by intent path
+	realizationErrors map[string]string
+	// API paths rejected due to missing permissions
+	deniedPaths map[string]bool
+
+	// Number of upcoming API requests to be rejected as rate limited,
+	// and Retry-After value to be sent with the rejection
+	throttledRequests int
+	retryAfter        string
+	// Number of API requests served, and maximum observed concurrency
+	requests       int
+	inflight       int
+	maxInflight    int
+	requestLatency time.Duration
+}
+
+func newMockNsxServer() *mockNsxServer {
+	s := &mockNsxServer{
+		Version:           mockNsxDefaultVersion,
+		objects:           make(map[string]*mockNsxObject),
+		sessions:          make(map[string]string),
+		groupMembers:      make(map[string][]interface{}),
+		realizationErrors: make(map[string]string),
+		deniedPaths:       make(map[string]bool),
+	}
+	s.seed()
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Host returns manager address in the format expected by provider host setting
+func (s *mockNsxServer) Host() string {
+	return strings.TrimPrefix(s.Server.URL, "https://")
+}
+
+func (s *mockNsxServer) Close() {
+	s.Server.Close()
+}
+
+// Configure environment so that acceptance tests and provider configuration
+// are pointed at the mock server
+func (s *mockNsxServer) setTestEnvironment() {
+	os.Setenv("NSXT_MANAGER_HOST", s.Host())
+	os.Setenv("NSXT_USERNAME", mockNsxUsername)
+	os.Setenv("NSXT_PASSWORD", mockNsxPassword)
+	os.Setenv("NSXT_ALLOW_UNVERIFIED_SSL", "true")
+}
+
+func testAccIsMockServer() bool {
+	return os.Getenv("NSXT_TEST_MOCK_SERVER") != ""
+}
+
+// Pre-populate objects that acceptance tests expect to exist on NSX
+func (s *mockNsxServer) seed() {
+	ep := "/infra/sites/default/enforcement-points/default"
+	s.putObject("/infra/sites/default", "Site", "default", nil)
+	s.putObject(ep, "EnforcementPoint", "default", nil)
+	s.putObject("/infra/domains/default", "Domain", "default", nil)
+	s.putObject(ep+"/transport-zones/overlay-tz", "PolicyTransportZone", getOverlayTransportZoneName(), map[string]interface{}{
+		"tz_type":    "OVERLAY_STANDARD",
+		"is_default": true,
+	})
+	s.putObject(ep+"/transport-zones/vlan-tz", "PolicyTransportZone", getVlanTransportZoneName(), map[string]interface{}{
+		"tz_type":    "VLAN_BACKED",
+		"is_default": false,
+	})
+	s.putObject(ep+"/edge-clusters/edge-cluster", "PolicyEdgeCluster", getEdgeClusterName(), nil)
+	s.putObject(ep+"/edge-clusters/edge-cluster/edge-nodes/edge-node", "PolicyEdgeNode", "edge-node", map[string]interface{}{
+		"nsx_id": "edge-node",
+	})
+	tier0Name := getTier0RouterName()
+	s.putObject("/infra/tier-0s/"+tier0Name, "Tier0", tier0Name, map[string]interface{}{
+		"ha_mode": "ACTIVE_STANDBY",
+	})
+	profiles := map[string]string{
+		"/infra/segment-security-profiles/default-segment-security-profile": "SegmentSecurityProfile",
+		"/infra/spoofguard-profiles/default-spoofguard-profile":             "SpoofGuardProfile",
+		"/infra/ip-discovery-profiles/default-ip-discovery-profile":         "IPDiscoveryProfile",
+		"/infra/mac-discovery-profiles/default-mac-discovery-profile":       "MacDiscoveryProfile",
+		"/infra/qos-profiles/default-qos-profile":                           "QoSProfile",
+	}
+	for path, resourceType := range profiles {
+		s.putObject(path, resourceType, getPolicyIDFromPath(path), map[string]interface{}{"_system_owned": true})
+	}
+	for _, service := range []string{"DNS", "DNS-UDP", "HTTP", "HTTPS", "ICMP ALL", "SSH", "MySQL"} {
+		s.putObject("/infra/services/"+service, "Service", service, map[string]interface{}{"_system_owned": true})
+	}
+}
+
+func (s *mockNsxServer) putObject(path string, resourceType string, displayName string, attrs map[string]interface{}) {
+	obj := map[string]interface{}{
+		"resource_type": resourceType,
+		"display_name":  displayName,
+	}
+	for k, v := range attrs {
+		obj[k] = v
+	}
+	s.storeObject(path, obj)
+}
+
+func mockParentPath(path string) string {
+	segs := strings.Split(path, "/")
+	if len(segs) < 3 {
+		return ""
+	}
+	return strings.Join(segs[:len(segs)-2], "/")
+}
+
+func mockCollectionPath(path string) string {
+	return path[:strings.LastIndex(path, "/")]
+}
+
+// Reverse lookup of resource type by collection name
+func mockResourceTypeFromPath(path string) string {
+	segs := strings.Split(path, "/")
+	if len(segs) < 2 {
+		return ""
+	}
+	last := segs[len(segs)-1]
+	for resourceType, singleton := range mockSingletonResourceTypes {
+		if singleton == last {
+			return resourceType
+		}
+	}
+	collection := segs[len(segs)-2]
+	var candidates []string
+	for resourceType, c := range mockResourceTypeCollections {
+		if c == collection {
+			candidates = append(candidates, resourceType)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	// Prefer the shortest name in case of ambiguity (i.e. Rule vs IdsRule)
+	sort.Slice(candidates, func(i, j int) bool {
+		if len(candidates[i]) == len(candidates[j]) {
+			return candidates[i] < candidates[j]
+		}
+		return len(candidates[i]) < len(candidates[j])
+	})
+	return candidates[0]
+}
+
+// Should be called under lock
+func (s *mockNsxServer) storeObject(path string, obj map[string]interface{}) map[string]interface{} {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	id := getPolicyIDFromPath(path)
+	existing, exists := s.objects[path]
+	if _, ok := obj["resource_type"]; !ok {
+		if exists {
+			obj["resource_type"] = existing.data["resource_type"]
+		} else if resourceType := mockResourceTypeFromPath(path); resourceType != "" {
+			obj["resource_type"] = resourceType
+		}
+	}
+	if name, ok := obj["display_name"]; !ok || name == "" {
+		if exists {
+			obj["display_name"] = existing.data["display_name"]
+		} else {
+			obj["display_name"] = id
+		}
+	}
+	obj["id"] = id
+	obj["path"] = path
+	obj["relative_path"] = id
+	obj["parent_path"] = mockParentPath(path)
+	obj["marked_for_delete"] = false
+	obj["_last_modified_time"] = now
+	obj["_last_modified_user"] = mockNsxUsername
+	if exists {
+		obj["_revision"] = existing.data["_revision"].(int64) + 1
+		obj["_create_time"] = existing.data["_create_time"]
+		obj["_create_user"] = existing.data["_create_user"]
+		existing.data = obj
+		return obj
+	}
+	obj["_revision"] = int64(0)
+	obj["_create_time"] = now
+	obj["_create_user"] = mockNsxUsername
+	if _, ok := obj["_system_owned"]; !ok {
+		obj["_system_owned"] = false
+	}
+	if obj["resource_type"] == "Rule" || obj["resource_type"] == "IdsRule" {
+		s.ruleID++
+		obj["rule_id"] = s.ruleID + 1000
+	}
+	s.counter++
+	s.objects[path] = &mockNsxObject{order: s.counter, data: obj}
+	return obj
+}
+
+// Should be called under lock
+func (s *mockNsxServer) deleteObject(path string) bool {
+	if _, ok := s.objects[path]; !ok {
+		return false
+	}
+	for p := range s.objects {
+		if p == path || strings.HasPrefix(p, path+"/") {
+			delete(s.objects, p)
+		}
+	}
+	return true
+}
+
+// Should be called under lock
+func (s *mockNsxServer) listObjects(collection string) []map[string]interface{} {
+	var objs []*mockNsxObject
+	for path, obj := range s.objects {
+		if mockCollectionPath(path) == collection {
+			objs = append(objs, obj)
+		}
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].order < objs[j].order })
+	var result []map[string]interface{}
+	for _, obj := range objs {
+		result = append(result, s.expandObject(obj.data))
+	}
+	return result
+}
+
+// Some policy objects embed their children on GET
+func (s *mockNsxServer) expandObject(obj map[string]interface{}) map[string]interface{} {
+	resourceType, _ := obj["resource_type"].(string)
+	if resourceType != "SecurityPolicy" && resourceType != "GatewayPolicy" && resourceType != "IdsSecurityPolicy" {
+		return obj
+	}
+	rules := s.listObjects(obj["path"].(string) + "/rules")
+	sort.SliceStable(rules, func(i, j int) bool {
+		return mockSequenceNumber(rules[i]) < mockSequenceNumber(rules[j])
+	})
+	result := make(map[string]interface{})
+	for k, v := range obj {
+		result[k] = v
+	}
+	result["rules"] = rules
+	return result
+}
+
+func mockSequenceNumber(obj map[string]interface{}) float64 {
+	switch v := obj["sequence_number"].(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
+
+func mockRevision(obj map[string]interface{}) (int64, bool) {
+	switch v := obj["_revision"].(type) {
+	case float64:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+func (s *mockNsxServer) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			log.Printf("[ERROR] Mock NSX failed to encode response: %v", err)
+		}
+	}
+}
+
+func (s *mockNsxServer) writeError(w http.ResponseWriter, status int, code int, message string) {
+	s.writeJSON(w, status, map[string]interface{}{
+		"httpStatus":    http.StatusText(status),
+		"error_code":    code,
+		"module_name":   "mock",
+		"error_message": message,
+	})
+}
+
+func (s *mockNsxServer) authenticated(r *http.Request) bool {
+	// Session cookie takes precedence, and expired session is rejected even
+	// if credentials are present in the request
+	if cookie, err := r.Cookie("JSESSIONID"); err == nil {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		xsrf, ok := s.sessions[cookie.Value]
+		return ok && xsrf == r.Header.Get("X-XSRF-TOKEN")
+	}
+	if user, password, ok := r.BasicAuth(); ok {
+		return user == mockNsxUsername && password == mockNsxPassword
+	}
+	authHeader := r.Header.Get("Authorization")
+	return strings.HasPrefix(authHeader, "Remote ") || strings.HasPrefix(authHeader, "Bearer ")
+}
+
+// Reject requests to given API path, simulating user without permissions
+func (s *mockNsxServer) denyAccess(path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.deniedPaths[path] = true
+}
+
+func (s *mockNsxServer) isDenied(path string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.deniedPaths[path]
+}
+
+// Invalidate all sessions, simulating session timeout on NSX
+func (s *mockNsxServer) expireSessions() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.sessions = make(map[string]string)
+}
+
+func (s *mockNsxServer) sessionCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.sessions)
+}
+
+func (s *mockNsxServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	if path == "/api/session/create" && r.Method == http.MethodPost {
+		s.createSession(w, r)
+		return
+	}
+
+	if path == mockMPPrefix+"/reverse-proxy/node/health" {
+		s.writeJSON(w, http.StatusOK, map[string]interface{}{"healthy": true})
+		return
+	}
+
+	if !s.authenticated(r) {
+		s.writeError(w, http.StatusUnauthorized, 401, "The credentials were incorrect or the account specified has been locked.")
+		return
+	}
+
+	if s.trackRequest() {
+		w.Header().Set("Retry-After", s.retryAfter)
+		s.writeError(w, http.StatusTooManyRequests, 102, "Client has exceeded API rate limit")
+		return
+	}
+	defer s.untrackRequest()
+
+	if s.isDenied(path) {
+		s.writeError(w, http.StatusForbidden, 401, "The user does not have permission to perform this operation")
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Body != nil {
+		raw, _ := io.ReadAll(r.Body)
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &body); err != nil {
+				s.writeError(w, http.StatusBadRequest, 255, fmt.Sprintf("Failed to parse request body: %v", err))
+				return
+			}
+		}
+	}
+
+	switch {
+	case path == mockMPPrefix+"/node/version":
+		s.writeJSON(w, http.StatusOK, map[string]interface{}{
+			"node_version":    s.Version,
+			"product_version": s.Version,
+		})
+	case path == mockPolicyPrefix+"/search/query" || path == mockGlobalPrefix+"/search/query":
+		s.serveSearch(w, r)
+	case strings.HasPrefix(path, mockPolicyPrefix+"/"):
+		s.servePolicy(w, r, strings.TrimPrefix(path, mockPolicyPrefix), body)
+	case strings.HasPrefix(path, mockGlobalPrefix+"/"):
+		s.servePolicy(w, r, strings.TrimPrefix(path, mockGlobalPrefix), body)
+	case strings.HasPrefix(path, mockMPPrefix+"/"):
+		s.serveManager(w, r, path, body)
+	default:
+		s.writeError(w, http.StatusNotFound, 404, fmt.Sprintf("Unknown API %s", path))
+	}
+}
+
+// Accounts for API request, and returns true if the request should be throttled
+func (s *mockNsxServer) trackRequest() bool {
+	s.lock.Lock()
+	s.requests++
+	if s.throttledRequests > 0 {
+		s.throttledRequests--
+		s.lock.Unlock()
+		return true
+	}
+	s.inflight++
+	if s.inflight > s.maxInflight {
+		s.maxInflight = s.inflight
+	}
+	latency := s.requestLatency
+	s.lock.Unlock()
+
+	time.Sleep(latency)
+	return false
+}
+
+func (s *mockNsxServer) untrackRequest() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.inflight--
+}
+
+func (s *mockNsxServer) throttleRequests(count int, retryAfter string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.throttledRequests = count
+	s.retryAfter = retryAfter
+}
+
+func (s *mockNsxServer) requestStats() (int, int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests, s.maxInflight
+}
+
+func (s *mockNsxServer) createSession(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		s.writeError(w, http.StatusBadRequest, 255, err.Error())
+		return
+	}
+	if r.Form.Get("j_username") != mockNsxUsername || r.Form.Get("j_password") != mockNsxPassword {
+		s.writeError(w, http.StatusForbidden, 403, "Invalid credentials")
+		return
+	}
+	session := newRandomUUID()
+	xsrf := newRandomUUID()
+	s.lock.Lock()
+	s.sessions[session] = xsrf
+	s.lock.Unlock()
+
+	w.Header().Set("Set-Cookie", fmt.Sprintf("JSESSIONID=%s; Path=/; Secure; HttpOnly", session))
+	w.Header().Set("X-XSRF-TOKEN", xsrf)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *mockNsxServer) servePolicy(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	if strings.HasSuffix(path, "/realized-state/realized-entities") || strings.HasSuffix(path, "/realized-state/status") {
+		s.serveRealization(w, r, path)
+		return
+	}
+
+	path = strings.TrimSuffix(path, "/")
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		if obj, ok := s.objects[path]; ok {
+			s.writeJSON(w, http.StatusOK, s.expandObject(obj.data))
+			return
+		}
+		if s.serveState(w, path) {
+			return
+		}
+		if collection, ok := mockInventoryCollections[path]; ok {
+			s.writeList(w, r, s.listObjects(collection))
+			return
+		}
+		if members, ok := s.groupMembers[path]; ok {
+			s.writeValues(w, r, members)
+			return
+		}
+		if mockIsCollectionPath(path) {
+			s.writeList(w, r, s.listObjects(path))
+			return
+		}
+		s.writeError(w, http.StatusNotFound, 500090, fmt.Sprintf("The path=[%s] is invalid", path))
+	case http.MethodPatch:
+		if path == "/infra" || path == "/global-infra" || strings.HasSuffix(path, "/infra") && strings.HasPrefix(path, "/orgs/") {
+			s.patchHierarchy(w, r, path, body)
+			return
+		}
+		if s.revisionConflict(w, path, body) {
+			return
+		}
+		if body == nil {
+			body = make(map[string]interface{})
+		}
+		delete(body, "_revision")
+		obj, err := s.patchObject(path, body)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, 500012, err.Error())
+			return
+		}
+		s.writeJSON(w, http.StatusOK, s.expandObject(obj))
+	case http.MethodPut:
+		if s.revisionConflict(w, path, body) {
+			return
+		}
+		if body == nil {
+			body = make(map[string]interface{})
+		}
+		delete(body, "_revision")
+		obj, err := s.patchObject(path, body)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, 500012, err.Error())
+			return
+		}
+		s.writeJSON(w, http.StatusOK, s.expandObject(obj))
+	case http.MethodDelete:
+		s.deleteObject(path)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodPost:
+		if obj, ok := s.objects[path]; ok {
+			s.writeJSON(w, http.StatusOK, obj.data)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, map[string]interface{}{})
+	default:
+		s.writeError(w, http.StatusMethodNotAllowed, 405, "Method not allowed")
+	}
+}
+
+// Policy collection paths have even number of segments in infra subtree,
+// i.e. /infra/segments or /infra/tier-1s/t1/locale-services, and odd number
+// of segments outside of it, i.e. /orgs/default/projects
+func mockIsCollectionPath(path string) bool {
+	segs := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	start := -1
+	for i, seg := range segs {
+		if strings.HasSuffix(seg, "infra") {
+			start = i
+		}
+	}
+	if start < 0 {
+		return len(segs)%2 == 1
+	}
+	return (len(segs)-start)%2 == 0
+}
+
+// Should be called under lock
+// Rejects update of existing object if revision in request is stale
+func (s *mockNsxServer) revisionConflict(w http.ResponseWriter, path string, body map[string]interface{}) bool {
+	existing, ok := s.objects[path]
+	if !ok {
+		return false
+	}
+	if revision, ok := mockRevision(body); ok && revision != existing.data["_revision"].(int64) {
+		s.writeError(w, http.StatusPreconditionFailed, 604, "The object was modified by somebody else")
+		return true
+	}
+	return false
+}
+
+func (s *mockNsxServer) patchObject(path string, body map[string]interface{}) (map[string]interface{}, error) {
+	children, _ := body["children"].([]interface{})
+	delete(body, "children")
+	if rules, ok := body["rules"].([]interface{}); ok {
+		// Rules are children of security and gateway policies
+		for _, rule := range rules {
+			ruleMap, ok := rule.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			children = append(children, map[string]interface{}{
+				"resource_type": "ChildRule",
+				"Rule":          ruleMap,
+			})
+		}
+		delete(body, "rules")
+	}
+
+	obj := make(map[string]interface{})
+	if existing, ok := s.objects[path]; ok {
+		for k, v := range existing.data {
+			obj[k] = v
+		}
+	}
+	for k, v := range body {
+		obj[k] = v
+	}
+	result := s.storeObject(path, obj)
+
+	for _, child := range children {
+		childMap, ok := child.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if err := s.patchChild(path, childMap, false); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (s *mockNsxServer) patchHierarchy(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	enforceRevision := r.URL.Query().Get("enforce_revision_check") == "true"
+	children, _ := body["children"].([]interface{})
+	for _, child := range children {
+		childMap, ok := child.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if err := s.patchChild(path, childMap, enforceRevision); err != nil {
+			if strings.Contains(err.Error(), "modified") {
+				s.writeError(w, http.StatusPreconditionFailed, 604, err.Error())
+				return
+			}
+			s.writeError(w, http.StatusBadRequest, 500012, err.Error())
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Process H-API child wrapper, such as ChildSegment or ChildResourceReference
+// Should be called under lock
+func (s *mockNsxServer) patchChild(parentPath string, wrapper map[string]interface{}, enforceRevision bool) error {
+	wrapperType, _ := wrapper["resource_type"].(string)
+	if !strings.HasPrefix(wrapperType, "Child") {
+		return fmt.Errorf("unexpected child type %s under %s", wrapperType, parentPath)
+	}
+
+	if wrapperType == "ChildResourceReference" {
+		targetType, _ := wrapper["target_type"].(string)
+		id, _ := wrapper["id"].(string)
+		path := mockChildPath(parentPath, targetType, id)
+		if path == "" {
+			return fmt.Errorf("unsupported reference type %s", targetType)
+		}
+		children, _ := wrapper["children"].([]interface{})
+		for _, child := range children {
+			if childMap, ok := child.(map[string]interface{}); ok {
+				if err := s.patchChild(path, childMap, enforceRevision); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	var obj map[string]interface{}
+	objKey := strings.TrimPrefix(wrapperType, "Child")
+	for key, value := range wrapper {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			obj = valueMap
+			objKey = key
+			break
+		}
+	}
+	if obj == nil {
+		return fmt.Errorf("no object found in %s", wrapperType)
+	}
+	resourceType, _ := obj["resource_type"].(string)
+	if resourceType == "" {
+		resourceType = objKey
+		obj["resource_type"] = resourceType
+	}
+	id, _ := obj["id"].(string)
+	if id == "" {
+		id, _ = wrapper["id"].(string)
+	}
+	path := mockChildPath(parentPath, resourceType, id)
+	if path == "" {
+		return fmt.Errorf("unsupported child type %s", resourceType)
+	}
+
+	if markedForDelete, _ := wrapper["marked_for_delete"].(bool); markedForDelete {
+		s.deleteObject(path)
+		return nil
+	}
+
+	if enforceRevision {
+		if existing, ok := s.objects[path]; ok {
+			if revision, ok := mockRevision(obj); ok && revision != existing.data["_revision"].(int64) {
+				return fmt.Errorf("object %s was modified by somebody else", path)
+			}
+		}
+	}
+	delete(obj, "_revision")
+	_, err := s.patchObject(path, obj)
+	return err
+}
+
+func mockChildPath(parentPath string, resourceType string, id string) string {
+	if singleton, ok := mockSingletonResourceTypes[resourceType]; ok {
+		return parentPath + "/" + singleton
+	}
+	collection, ok := mockResourceTypeCollections[resourceType]
+	if !ok || id == "" {
+		return ""
+	}
+	return parentPath + "/" + collection + "/" + id
+}
+
+func (s *mockNsxServer) serveRealization(w http.ResponseWriter, r *http.Request, path string) {
+	intentPath := r.URL.Query().Get("intent_path")
+	if strings.HasSuffix(path, "/status") {
+		s.writeJSON(w, http.StatusOK, map[string]interface{}{
+			"intent_path":    intentPath,
+			"publish_status": "REALIZED",
+			"consolidated_status": map[string]interface{}{
+				"consolidated_status": "SUCCESS",
+			},
+		})
+		return
+	}
+
+	s.lock.Lock()
+	_, exists := s.objects[intentPath]
+	errorMessage, failed := s.realizationErrors[intentPath]
+	s.lock.Unlock()
+	var results []interface{}
+	if exists {
+		entity := map[string]interface{}{
+			"id":                              getPolicyIDFromPath(intentPath),
+			"display_name":                    getPolicyIDFromPath(intentPath),
+			"resource_type":                   "GenericPolicyRealizedResource",
+			"intent_paths":                    []string{intentPath},
+			"state":                           "REALIZED",
+			"runtime_status":                  "UNINITIALIZED",
+			"realization_specific_identifier": getPolicyIDFromPath(intentPath),
+		}
+		if failed {
+			entity["state"] = "ERROR"
+			entity["alarms"] = []interface{}{
+				map[string]interface{}{
+					"message":       errorMessage,
+					"resource_type": "PolicyAlarmResource",
+				},
+			}
+		}
+		results = append(results, entity)
+	}
+	s.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"results":      results,
+		"result_count": len(results),
+	})
+}
+
+// Reports realization of given intent path as failed with given message
+func (s *mockNsxServer) setRealizationError(intentPath string, message string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.realizationErrors[intentPath] = message
+}
+
+// Sets effective members of given type for the group, i.e. virtual-machines
+func (s *mockNsxServer) setGroupMembers(groupPath string, memberType string, members []interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.groupMembers[fmt.Sprintf("%s/members/%s", groupPath, memberType)] = members
+}
+
+func (s *mockNsxServer) writeList(w http.ResponseWriter, r *http.Request, objs []map[string]interface{}) {
+	values := make([]interface{}, 0, len(objs))
+	for _, obj := range objs {
+		values = append(values, obj)
+	}
+	s.writeValues(w, r, values)
+}
+
+// Writes page of list results, according to cursor and page size in request
+func (s *mockNsxServer) writeValues(w http.ResponseWriter, r *http.Request, objs []interface{}) {
+	pageSize := mockNsxDefaultPageSize
+	if size, err := strconv.Atoi(r.URL.Query().Get("page_size")); err == nil && size > 0 {
+		pageSize = size
+	}
+	start := 0
+	if cursor, err := strconv.Atoi(r.URL.Query().Get("cursor")); err == nil && cursor > 0 {
+		start = cursor
+	}
+	if start > len(objs) {
+		start = len(objs)
+	}
+	end := start + pageSize
+	if end > len(objs) {
+		end = len(objs)
+	}
+	results := make([]interface{}, 0, end-start)
+	results = append(results, objs[start:end]...)
+	response := map[string]interface{}{
+		"results":      results,
+		"result_count": len(objs),
+	}
+	if end < len(objs) {
+		response["cursor"] = strconv.Itoa(end)
+	}
+	s.writeJSON(w, http.StatusOK, response)
+}
+
+func (s *mockNsxServer) serveSearch(w http.ResponseWriter, r *http.Request) {
+	query, err := parseMockSearchQuery(r.URL.Query().Get("query"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, 60503, err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	var objs []*mockNsxObject
+	for _, obj := range s.objects {
+		if query.match(obj.data) {
+			objs = append(objs, obj)
+		}
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].order < objs[j].order })
+	var results []map[string]interface{}
+	for _, obj := range objs {
+		results = append(results, s.expandObject(obj.data))
+	}
+	s.lock.Unlock()
+
+	s.writeList(w, r, results)
+}
+
+// Configuration state of existing object (i.e. transport node or edge cluster)
+// is always reported as successful
+func (s *mockNsxServer) serveState(w http.ResponseWriter, path string) bool {
+	if !strings.HasSuffix(path, "/state") {
+		return false
+	}
+	if _, ok := s.objects[strings.TrimSuffix(path, "/state")]; !ok {
+		return false
+	}
+	s.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"state": "success",
+	})
+	return true
+}
+
+func (s *mockNsxServer) serveManager(w http.ResponseWriter, r *http.Request, path string, body map[string]interface{}) {
+	path = strings.TrimSuffix(path, "/")
+	segs := strings.Split(strings.TrimPrefix(path, mockMPPrefix+"/"), "/")
+	isCollection := len(segs)%2 == 1
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		if obj, ok := s.objects[path]; ok {
+			s.writeJSON(w, http.StatusOK, obj.data)
+			return
+		}
+		if s.serveState(w, path) {
+			return
+		}
+		if isCollection {
+			s.writeList(w, r, s.listObjects(path))
+			return
+		}
+		s.writeError(w, http.StatusNotFound, 202, fmt.Sprintf("The requested object : %s could not be found", path))
+	case http.MethodPost:
+		if !isCollection {
+			// Action on existing object
+			if obj, ok := s.objects[path]; ok {
+				s.writeJSON(w, http.StatusOK, obj.data)
+				return
+			}
+			s.writeError(w, http.StatusNotFound, 202, fmt.Sprintf("The requested object : %s could not be found", path))
+			return
+		}
+		if body == nil {
+			body = make(map[string]interface{})
+		}
+		id, _ := body["id"].(string)
+		if id == "" {
+			id = newRandomUUID()
+		}
+		obj := s.storeObject(path+"/"+id, body)
+		delete(obj, "path")
+		delete(obj, "parent_path")
+		delete(obj, "relative_path")
+		s.writeJSON(w, http.StatusCreated, obj)
+	case http.MethodPut:
+		existing, ok := s.objects[path]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, 202, fmt.Sprintf("The requested object : %s could not be found", path))
+			return
+		}
+		if revision, ok := mockRevision(body); !ok || revision != existing.data["_revision"].(int64) {
+			s.writeError(w, http.StatusPreconditionFailed, 604, "The object was modified by somebody else")
+			return
+		}
+		delete(body, "_revision")
+		obj := s.storeObject(path, body)
+		delete(obj, "path")
+		delete(obj, "parent_path")
+		delete(obj, "relative_path")
+		s.writeJSON(w, http.StatusOK, obj)
+	case http.MethodDelete:
+		if !s.deleteObject(path) {
+			s.writeError(w, http.StatusNotFound, 202, fmt.Sprintf("The requested object : %s could not be found", path))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		s.writeError(w, http.StatusMethodNotAllowed, 405, "Method not allowed")
+	}
+}
+
+// Minimal Lucene-style query support for search API: field:value terms with
+// leading or trailing wildcard, combined with AND/OR/NOT operators and parenthesis
+type mockSearchQuery interface {
+	match(obj map[string]interface{}) bool
+}
+
+type mockSearchTerm struct {
+	field  string
+	value  string
+	prefix bool
+	suffix bool
+}
+
+type mockSearchAnd []mockSearchQuery
+type mockSearchOr []mockSearchQuery
+type mockSearchNot struct {
+	query mockSearchQuery
+}
+
+func (q mockSearchAnd) match(obj map[string]interface{}) bool {
+	for _, sub := range q {
+		if !sub.match(obj) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q mockSearchOr) match(obj map[string]interface{}) bool {
+	for _, sub := range q {
+		if sub.match(obj) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q mockSearchNot) match(obj map[string]interface{}) bool {
+	return !q.query.match(obj)
+}
+
+func (q mockSearchTerm) matchValue(value string) bool {
+	switch {
+	case q.prefix && q.suffix:
+		return strings.Contains(value, q.value)
+	case q.prefix:
+		return strings.HasPrefix(value, q.value)
+	case q.suffix:
+		return strings.HasSuffix(value, q.value)
+	}
+	return value == q.value
+}
+
+func (q mockSearchTerm) match(obj map[string]interface{}) bool {
+	if q.field == "" {
+		// Free text search against display name
+		name, _ := obj["display_name"].(string)
+		return q.matchValue(name)
+	}
+	for _, value := range mockFieldValues(obj, strings.Split(q.field, ".")) {
+		if q.matchValue(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func mockFieldValues(obj interface{}, fields []string) []string {
+	if len(fields) == 0 {
+		switch v := obj.(type) {
+		case string:
+			return []string{v}
+		case nil:
+			return nil
+		default:
+			return []string{fmt.Sprintf("%v", v)}
+		}
+	}
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		return mockFieldValues(v[fields[0]], fields[1:])
+	case []interface{}:
+		var result []string
+		for _, elem := range v {
+			result = append(result, mockFieldValues(elem, fields)...)
+		}
+		return result
+	}
+	return nil
+}
+
+type mockSearchParser struct {
+	tokens []string
+	pos    int
+}
+
+func parseMockSearchQuery(query string) (mockSearchQuery, error) {
+	parser := mockSearchParser{tokens: tokenizeMockSearchQuery(query)}
+	if len(parser.tokens) == 0 {
+		return mockSearchAnd{}, nil
+	}
+	result, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if parser.pos < len(parser.tokens) {
+		return nil, fmt.Errorf("unexpected token %s in query %s", parser.tokens[parser.pos], query)
+	}
+	return result, nil
+}
+
+// Split query into terms, operators and parenthesis, respecting backslash escapes
+func tokenizeMockSearchQuery(query string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	escaped := false
+	for _, chr := range query {
+		switch {
+		case escaped:
+			current.WriteRune('\\')
+			current.WriteRune(chr)
+			escaped = false
+		case chr == '\\':
+			escaped = true
+		case chr == '(' || chr == ')':
+			flush()
+			tokens = append(tokens, string(chr))
+		case chr == ' ':
+			flush()
+		default:
+			current.WriteRune(chr)
+		}
+	}
+	flush()
+
+	// Glue unescaped tokens that belong to same value, such as names with spaces
+	var result []string
+	for _, token := range tokens {
+		isOperator := token == "AND" || token == "OR" || token == "NOT" || token == "(" || token == ")"
+		if !isOperator && len(result) > 0 && !strings.Contains(token, ":") {
+			last := result[len(result)-1]
+			lastIsOperator := last == "AND" || last == "OR" || last == "NOT" || last == "(" || last == ")"
+			if !lastIsOperator {
+				result[len(result)-1] = last + " " + token
+				continue
+			}
+		}
+		result = append(result, token)
+	}
+	return result
+}
+
+func (p *mockSearchParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *mockSearchParser) parseOr() (mockSearchQuery, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	result := mockSearchOr{first}
+	for p.peek() == "OR" {
+		p.pos++
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, next)
+	}
+	if len(result) == 1 {
+		return first, nil
+	}
+	return result, nil
+}
+
+func (p *mockSearchParser) parseAnd() (mockSearchQuery, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	result := mockSearchAnd{first}
+	for p.peek() == "AND" {
+		p.pos++
+		next, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, next)
+	}
+	if len(result) == 1 {
+		return first, nil
+	}
+	return result, nil
+}
+
+func (p *mockSearchParser) parseUnary() (mockSearchQuery, error) {
+	token := p.peek()
+	switch token {
+	case "":
+		return nil, fmt.Errorf("unexpected end of query")
+	case "NOT":
+		p.pos++
+		sub, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return mockSearchNot{query: sub}, nil
+	case "(":
+		p.pos++
+		sub, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return sub, nil
+	}
+	p.pos++
+	return newMockSearchTerm(token), nil
+}
+
+func newMockSearchTerm(token string) mockSearchTerm {
+	term := mockSearchTerm{}
+	value := token
+	// Field separator is the first unescaped colon
+	for i := 0; i < len(token); i++ {
+		if token[i] == '\\' {
+			i++
+			continue
+		}
+		if token[i] == ':' {
+			term.field = token[:i]
+			value = token[i+1:]
+			break
+		}
+	}
+	if strings.HasSuffix(value, "*") && !strings.HasSuffix(value, "\\*") {
+		term.prefix = true
+		value = strings.TrimSuffix(value, "*")
+	}
+	if strings.HasPrefix(value, "*") {
+		term.suffix = true
+		value = strings.TrimPrefix(value, "*")
+	}
+	value = strings.Trim(value, "\"")
+	var unescaped strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+		}
+		unescaped.WriteByte(value[i])
+	}
+	term.value = unescaped.String()
+	return term
+}
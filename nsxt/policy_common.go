@@ -121,6 +121,14 @@ func getDataSourceStringSchema(description string) *schema.Schema {
 	}
 }
 
+func getComputedStringSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: description,
+		Computed:    true,
+	}
+}
+
 func getRequiredStringSchema(description string) *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeString,
@@ -174,7 +182,7 @@ func getPolicyRuleActionSchema(isIds bool) *schema.Schema {
 	}
 }
 
-func getSecurityPolicyAndGatewayRulesSchema(scopeRequired bool, isIds bool, nsxIDReadOnly bool) *schema.Schema {
+func getPolicyRuleSchema(scopeRequired bool, isIds bool, nsxIDReadOnly bool) map[string]*schema.Schema {
 	ruleSchema := map[string]*schema.Schema{
 		"nsx_id":       getFlexNsxIDSchema(nsxIDReadOnly),
 		"display_name": getDisplayNameSchema(),
@@ -291,17 +299,30 @@ func getSecurityPolicyAndGatewayRulesSchema(scopeRequired bool, isIds bool, nsxI
 	if isIds {
 		ruleSchema["ids_profiles"] = getIdsProfilesSchema()
 	}
+	return ruleSchema
+}
+
+func getSecurityPolicyAndGatewayRulesSchema(scopeRequired bool, isIds bool, nsxIDReadOnly bool) *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
 		Description: "List of rules in the section",
 		Optional:    true,
 		MaxItems:    1000,
 		Elem: &schema.Resource{
-			Schema: ruleSchema,
+			Schema: getPolicyRuleSchema(scopeRequired, isIds, nsxIDReadOnly),
 		},
 	}
 }
 
+func getIgnoreUnmanagedRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Ignore rules that are not configured in this resource, such as rules managed by standalone rule resources",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
 func getPolicyGatewayPolicySchema() map[string]*schema.Schema {
 	secPolicy := getPolicySecurityPolicySchema(false, true)
 	// GW Policies don't support scope
@@ -309,6 +330,7 @@ func getPolicyGatewayPolicySchema() map[string]*schema.Schema {
 	secPolicy["category"].ValidateFunc = validation.StringInSlice(gatewayPolicyCategoryWritableValues, false)
 	// GW Policy rules require scope to be set
 	secPolicy["rule"] = getSecurityPolicyAndGatewayRulesSchema(true, false, true)
+	secPolicy["ignore_unmanaged_rules"] = getIgnoreUnmanagedRulesSchema()
 	return secPolicy
 }
 
@@ -383,45 +405,69 @@ func getPolicySecurityPolicySchema(isIds bool, withContext bool) map[string]*sch
 	return result
 }
 
+func getPolicyRuleMap(rule model.Rule) map[string]interface{} {
+	elem := make(map[string]interface{})
+	elem["display_name"] = rule.DisplayName
+	elem["description"] = rule.Description
+	elem["notes"] = rule.Notes
+	elem["logged"] = rule.Logged
+	elem["log_label"] = rule.Tag
+	elem["action"] = rule.Action
+	elem["destinations_excluded"] = rule.DestinationsExcluded
+	elem["sources_excluded"] = rule.SourcesExcluded
+	if rule.IpProtocol == nil {
+		elem["ip_version"] = "NONE"
+	} else {
+		elem["ip_version"] = rule.IpProtocol
+	}
+	elem["direction"] = rule.Direction
+	elem["disabled"] = rule.Disabled
+	elem["revision"] = rule.Revision
+	setPathListInMap(elem, "source_groups", rule.SourceGroups)
+	setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
+	setPathListInMap(elem, "profiles", rule.Profiles)
+	setPathListInMap(elem, "services", rule.Services)
+	setPathListInMap(elem, "scope", rule.Scope)
+	elem["sequence_number"] = rule.SequenceNumber
+	elem["nsx_id"] = rule.Id
+	elem["rule_id"] = rule.RuleId
+
+	var tagList []map[string]string
+	for _, tag := range rule.Tags {
+		tags := make(map[string]string)
+		tags["scope"] = *tag.Scope
+		tags["tag"] = *tag.Tag
+		tagList = append(tagList, tags)
+	}
+	elem["tag"] = tagList
+
+	return elem
+}
+
 func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
 	var rulesList []map[string]interface{}
-	for _, rule := range rules {
-		elem := make(map[string]interface{})
-		elem["display_name"] = rule.DisplayName
-		elem["description"] = rule.Description
-		elem["notes"] = rule.Notes
-		elem["logged"] = rule.Logged
-		elem["log_label"] = rule.Tag
-		elem["action"] = rule.Action
-		elem["destinations_excluded"] = rule.DestinationsExcluded
-		elem["sources_excluded"] = rule.SourcesExcluded
-		if rule.IpProtocol == nil {
-			elem["ip_version"] = "NONE"
-		} else {
-			elem["ip_version"] = rule.IpProtocol
+	ignoreUnmanaged := false
+	if val, ok := d.GetOk("ignore_unmanaged_rules"); ok {
+		ignoreUnmanaged = val.(bool)
+	}
+	managedIDs := make(map[string]bool)
+	if ignoreUnmanaged {
+		// Only rules configured within this resource are managed here, while
+		// the rest are expected to be managed by standalone rule resources
+		for _, rule := range d.Get("rule").([]interface{}) {
+			if rule == nil {
+				continue
+			}
+			if nsxID := rule.(map[string]interface{})["nsx_id"].(string); nsxID != "" {
+				managedIDs[nsxID] = true
+			}
 		}
-		elem["direction"] = rule.Direction
-		elem["disabled"] = rule.Disabled
-		elem["revision"] = rule.Revision
-		setPathListInMap(elem, "source_groups", rule.SourceGroups)
-		setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
-		setPathListInMap(elem, "profiles", rule.Profiles)
-		setPathListInMap(elem, "services", rule.Services)
-		setPathListInMap(elem, "scope", rule.Scope)
-		elem["sequence_number"] = rule.SequenceNumber
-		elem["nsx_id"] = rule.Id
-		elem["rule_id"] = rule.RuleId
-
-		var tagList []map[string]string
-		for _, tag := range rule.Tags {
-			tags := make(map[string]string)
-			tags["scope"] = *tag.Scope
-			tags["tag"] = *tag.Tag
-			tagList = append(tagList, tags)
+	}
+	for _, rule := range rules {
+		if ignoreUnmanaged && (rule.Id == nil || !managedIDs[*rule.Id]) {
+			continue
 		}
-		elem["tag"] = tagList
-
-		rulesList = append(rulesList, elem)
+		rulesList = append(rulesList, getPolicyRuleMap(rule))
 	}
 
 	return d.Set("rule", rulesList)
@@ -448,6 +494,50 @@ func validatePolicyRuleSequence(d *schema.ResourceData) error {
 	return nil
 }
 
+func getPolicyRuleFromMap(data map[string]interface{}, id string, sequenceNumber int64) model.Rule {
+	displayName := data["display_name"].(string)
+	description := data["description"].(string)
+	action := data["action"].(string)
+	logged := data["logged"].(bool)
+	tag := data["log_label"].(string)
+	disabled := data["disabled"].(bool)
+	sourcesExcluded := data["sources_excluded"].(bool)
+	destinationsExcluded := data["destinations_excluded"].(bool)
+
+	var ipProtocol *string
+	ipp := data["ip_version"].(string)
+	if ipp != "NONE" {
+		ipProtocol = &ipp
+	}
+	direction := data["direction"].(string)
+	notes := data["notes"].(string)
+	tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
+	resourceType := "Rule"
+
+	return model.Rule{
+		ResourceType:         &resourceType,
+		Id:                   &id,
+		DisplayName:          &displayName,
+		Notes:                &notes,
+		Description:          &description,
+		Action:               &action,
+		Logged:               &logged,
+		Tag:                  &tag,
+		Tags:                 tagStructs,
+		Disabled:             &disabled,
+		SourcesExcluded:      &sourcesExcluded,
+		DestinationsExcluded: &destinationsExcluded,
+		IpProtocol:           ipProtocol,
+		Direction:            &direction,
+		SourceGroups:         getPathListFromMap(data, "source_groups"),
+		DestinationGroups:    getPathListFromMap(data, "destination_groups"),
+		Services:             getPathListFromMap(data, "services"),
+		Scope:                getPathListFromMap(data, "scope"),
+		Profiles:             getPathListFromMap(data, "profiles"),
+		SequenceNumber:       &sequenceNumber,
+	}
+}
+
 func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 	rules := d.Get("rule").([]interface{})
 	var ruleList []model.Rule
@@ -455,24 +545,8 @@ func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 	for _, rule := range rules {
 		data := rule.(map[string]interface{})
 		displayName := data["display_name"].(string)
-		description := data["description"].(string)
-		action := data["action"].(string)
-		logged := data["logged"].(bool)
-		tag := data["log_label"].(string)
-		disabled := data["disabled"].(bool)
-		sourcesExcluded := data["sources_excluded"].(bool)
-		destinationsExcluded := data["destinations_excluded"].(bool)
-
-		var ipProtocol *string
-		ipp := data["ip_version"].(string)
-		if ipp != "NONE" {
-			ipProtocol = &ipp
-		}
-		direction := data["direction"].(string)
-		notes := data["notes"].(string)
 		seq := data["sequence_number"].(int)
 		sequenceNumber := int64(seq)
-		tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
 
 		id := newUUID()
 		nsxID := data["nsx_id"].(string)
@@ -480,7 +554,6 @@ func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 			id = nsxID
 		}
 
-		resourceType := "Rule"
 		if sequenceNumber == 0 || sequenceNumber <= lastSequence {
 			// We overwrite sequence number in case its not specified,
 			// or out of order, which might be due to provider upgrade
@@ -492,30 +565,7 @@ func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 		}
 		lastSequence = sequenceNumber
 
-		elem := model.Rule{
-			ResourceType:         &resourceType,
-			Id:                   &id,
-			DisplayName:          &displayName,
-			Notes:                &notes,
-			Description:          &description,
-			Action:               &action,
-			Logged:               &logged,
-			Tag:                  &tag,
-			Tags:                 tagStructs,
-			Disabled:             &disabled,
-			SourcesExcluded:      &sourcesExcluded,
-			DestinationsExcluded: &destinationsExcluded,
-			IpProtocol:           ipProtocol,
-			Direction:            &direction,
-			SourceGroups:         getPathListFromMap(data, "source_groups"),
-			DestinationGroups:    getPathListFromMap(data, "destination_groups"),
-			Services:             getPathListFromMap(data, "services"),
-			Scope:                getPathListFromMap(data, "scope"),
-			Profiles:             getPathListFromMap(data, "profiles"),
-			SequenceNumber:       &sequenceNumber,
-		}
-
-		ruleList = append(ruleList, elem)
+		ruleList = append(ruleList, getPolicyRuleFromMap(data, id, sequenceNumber))
 	}
 
 	return ruleList
@@ -0,0 +1,80 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes that control provider behavior and are not sent to NSX,
+// hence change in those alone does not require update of the NSX object
+var policyResourceLifecycleAttributes = []string{"wait_for_realization", "deletion_protection", "retain_on_destroy"}
+
+func getDeletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Refuse to delete the resource while set",
+		Optional:    true,
+	}
+}
+
+func getRetainOnDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Only remove the resource from terraform state on destroy, leaving the object in NSX",
+		Optional:    true,
+	}
+}
+
+func resourceDeletionProtectionDeleteWrapper(name string, operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.Get("deletion_protection").(bool) {
+			return diag.Errorf("Cannot delete %s with ID %s since deletion_protection is set. In order to delete the resource, set deletion_protection to false and apply the configuration first", name, d.Id())
+		}
+		if d.Get("retain_on_destroy").(bool) {
+			log.Printf("[INFO] Removing %s with ID %s from state, the object is retained in NSX", name, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return operation(ctx, d, m)
+	}
+}
+
+func resourceDeletionProtectionUpdateWrapper(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.HasChanges(policyResourceLifecycleAttributes...) && !d.HasChangesExcept(policyResourceLifecycleAttributes...) {
+			// Nothing to update in NSX
+			return nil
+		}
+		return operation(ctx, d, m)
+	}
+}
+
+// Adds deletion protection and retain on destroy options to policy resources
+func addDeletionProtectionSupport(name string, resource *schema.Resource) {
+	if !strings.HasPrefix(name, "nsxt_policy_") || resource.DeleteContext == nil {
+		return
+	}
+	if _, ok := resource.Schema["deletion_protection"]; ok {
+		return
+	}
+
+	resource.Schema["deletion_protection"] = getDeletionProtectionSchema()
+	resource.Schema["retain_on_destroy"] = getRetainOnDestroySchema()
+	resource.DeleteContext = resourceDeletionProtectionDeleteWrapper(name, resource.DeleteContext)
+	if resource.UpdateContext == nil {
+		// All other attributes of this resource force re-creation, hence
+		// update only needs to store new settings in state
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return nil
+		}
+		return
+	}
+	resource.UpdateContext = resourceDeletionProtectionUpdateWrapper(resource.UpdateContext)
+}
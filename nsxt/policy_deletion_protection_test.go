@@ -0,0 +1,76 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMockNsxDeletionProtection(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	resource := Provider().ResourcesMap["nsxt_policy_segment"]
+	config := map[string]interface{}{
+		"display_name":        "mock-protected-segment",
+		"transport_zone_path": "/infra/sites/default/enforcement-points/default/transport-zones/overlay-tz",
+		"subnet": []interface{}{
+			map[string]interface{}{"cidr": "12.12.2.1/24"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	path := d.Get("path").(string)
+	server.lock.Lock()
+	revision := server.objects[path].data["_revision"]
+	server.lock.Unlock()
+
+	// Turning on protection does not modify the segment in NSX
+	config["deletion_protection"] = true
+	state := d.State()
+	diff, err := resource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), m)
+	if err != nil {
+		t.Fatalf("Diff failed: %v", err)
+	}
+	d, err = schema.InternalMap(resource.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("Failed to apply diff: %v", err)
+	}
+	if diags := resource.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	server.lock.Lock()
+	updatedRevision := server.objects[path].data["_revision"]
+	server.lock.Unlock()
+	if updatedRevision != revision {
+		t.Fatalf("Expected segment not to be updated in NSX")
+	}
+
+	diags := resource.DeleteContext(context.Background(), d, m)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "deletion_protection") {
+		t.Fatalf("Expected delete to be refused, got %v", diags)
+	}
+
+	d.Set("deletion_protection", false)
+	d.Set("retain_on_destroy", true)
+	if diags := resource.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected segment to be removed from state")
+	}
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	if _, ok := server.objects[path]; !ok {
+		t.Fatalf("Expected segment %s to be retained in NSX", path)
+	}
+}
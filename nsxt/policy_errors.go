@@ -139,6 +139,37 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
+// NSX error code for object modified concurrently
+const concurrentModificationErrorCode = 604
+
+// HTTP status reported in NSX error body when revision of the object does not match
+const preconditionFailedHTTPStatus = "PRECONDITION_FAILED"
+
+// Returns true if NSX rejected the request since the object was modified
+// concurrently, either with precondition failed status or with concurrent
+// modification error code
+func isConcurrentModificationError(err error) bool {
+	vapiError, ok := err.(errors.InvalidRequest)
+	if !ok || vapiError.Data == nil {
+		return false
+	}
+	if value, fieldErr := vapiError.Data.Field("httpStatus"); fieldErr == nil {
+		if status, ok := value.(*data.StringValue); ok && status.Value() == preconditionFailedHTTPStatus {
+			return true
+		}
+	}
+	var typeConverter = bindings.NewTypeConverter()
+	data, convErr := typeConverter.ConvertToGolang(vapiError.Data, model.ApiErrorBindingType())
+	if convErr != nil {
+		return false
+	}
+	apiError, ok := data.(model.ApiError)
+	if !ok || apiError.ErrorCode == nil {
+		return false
+	}
+	return *apiError.ErrorCode == concurrentModificationErrorCode
+}
+
 func handleCreateError(resourceType string, resource string, err error) error {
 	msg := fmt.Sprintf("Failed to create %s %s", resourceType, resource)
 	return logAPIError(msg, err)
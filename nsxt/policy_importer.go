@@ -0,0 +1,188 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// Import ID prefix for import by display name, such as name:app-segment, or
+// name:dev/app-segment for object in project dev
+const policyImportNamePrefix = "name:"
+
+func getPolicyImportStateFunc(importer *schema.ResourceImporter) schema.StateContextFunc {
+	if importer.StateContext != nil {
+		return importer.StateContext
+	}
+	if importer.State != nil {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			return importer.State(d, m)
+		}
+	}
+	return schema.ImportStatePassthroughContext
+}
+
+// Returns session context to search for the object within, and display name
+// of the object. Project can be specified for resources that support context,
+// in which case an empty project, such as in name:/app/segment, refers to
+// default space.
+func getPolicyImportNameContext(importID string, resource *schema.Resource, d *schema.ResourceData, m interface{}) (utl.SessionContext, string) {
+	name := strings.TrimPrefix(importID, policyImportNamePrefix)
+	if _, ok := resource.Schema["context"]; !ok || !strings.Contains(name, "/") {
+		return getSessionContext(d, m), name
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if parts[0] != "" {
+		return utl.SessionContext{ProjectID: parts[0], ClientType: utl.Multitenancy}, parts[1]
+	}
+	if isPolicyGlobalManager(m) {
+		return utl.SessionContext{ClientType: utl.Global}, parts[1]
+	}
+	return utl.SessionContext{ClientType: utl.Local}, parts[1]
+}
+
+// Returns whether policy path belongs to the space of the session context
+func isPolicyPathInContext(path string, sessionContext utl.SessionContext) bool {
+	if sessionContext.ClientType == utl.Multitenancy {
+		return strings.HasPrefix(path, fmt.Sprintf("/orgs/%s/projects/%s/", sessionContext.GetOrgID(), sessionContext.ProjectID))
+	}
+	return !strings.HasPrefix(path, "/orgs/")
+}
+
+// Returns NSX resource type of the resource, if known
+func getPolicyImportResourceType(name string) *exportResourceType {
+	for i := range exportResourceTypes {
+		if exportResourceTypes[i].resourceType == name {
+			return &exportResourceTypes[i]
+		}
+	}
+	return nil
+}
+
+// Resolves display name to policy path of the object of this resource type.
+// Search is narrowed down by NSX resource type when known for the resource.
+// Otherwise, search API returns objects of all types with given name, hence
+// each candidate is imported and read in order to validate it belongs to this
+// resource.
+func resolvePolicyImportName(ctx context.Context, name string, resource *schema.Resource, importState schema.StateContextFunc, d *schema.ResourceData, m interface{}) (string, error) {
+	sessionContext, displayName := getPolicyImportNameContext(d.Id(), resource, d, m)
+	if displayName == "" {
+		return "", fmt.Errorf("display name is expected after %s prefix in import ID", policyImportNamePrefix)
+	}
+	if _, ok := resource.Schema["path"]; !ok {
+		return "", fmt.Errorf("import by display name is not supported for %s", name)
+	}
+
+	connector := getPolicyConnector(m)
+	query := fmt.Sprintf("display_name:%s AND marked_for_delete:false", escapeSpecialCharacters(displayName))
+	resourceType := getPolicyImportResourceType(name)
+	if resourceType != nil {
+		query = fmt.Sprintf("resource_type:%s AND %s", resourceType.nsxType, query)
+	}
+	results, err := searchPolicyResources(connector, sessionContext, query, nil)
+	if err != nil {
+		return "", err
+	}
+
+	converter := bindings.NewTypeConverter()
+	var paths []string
+	// Importer error for candidates that could not be imported by path,
+	// which means this resource does not support import by path
+	var importErr error
+	imported := false
+	for _, result := range results {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return "", errs[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		// Search is not case sensitive and matches name tokens
+		if obj.DisplayName == nil || *obj.DisplayName != displayName || obj.Path == nil {
+			continue
+		}
+		if !isPolicyPathInContext(*obj.Path, sessionContext) {
+			continue
+		}
+
+		if resourceType != nil {
+			if resourceType.match == nil || resourceType.match(*obj.Path, result) {
+				paths = append(paths, *obj.Path)
+			}
+			continue
+		}
+
+		candidate := resource.Data(nil)
+		candidate.SetId(*obj.Path)
+		candidates, err := importState(ctx, candidate, m)
+		if err != nil || len(candidates) == 0 {
+			log.Printf("[DEBUG] Object %s does not match %s: %v", *obj.Path, name, err)
+			if err != nil {
+				importErr = err
+			}
+			continue
+		}
+		imported = true
+		candidate = candidates[0]
+		if diags := resource.ReadContext(ctx, candidate, m); diags.HasError() {
+			log.Printf("[DEBUG] Object %s does not match %s: %v", *obj.Path, name, diags)
+			continue
+		}
+		if candidate.Id() != "" && candidate.Get("path").(string) == *obj.Path {
+			paths = append(paths, *obj.Path)
+		}
+	}
+
+	if len(paths) == 0 && !imported && importErr != nil {
+		return "", fmt.Errorf("import by display name is not supported for %s, since import by policy path failed: %v", name, importErr)
+	}
+	if len(paths) == 0 {
+		return "", fmt.Errorf("%s with display name %s was not found", name, displayName)
+	}
+	if len(paths) > 1 {
+		return "", fmt.Errorf("found %d objects of %s with display name %s, please import by policy path: %s", len(paths), name, displayName, strings.Join(paths, ", "))
+	}
+	return paths[0], nil
+}
+
+func resourcePolicyImportWrapper(name string, resource *schema.Resource, importState schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if strings.HasPrefix(d.Id(), policyImportNamePrefix) {
+			path, err := resolvePolicyImportName(ctx, name, resource, importState, d, m)
+			if err != nil {
+				return nil, err
+			}
+			log.Printf("[INFO] Importing %s %s by policy path %s", name, d.Id(), path)
+			d.SetId(path)
+			if _, ok := resource.Schema["context"]; ok {
+				if err := setPolicyContextFromPath(d, path); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return importState(ctx, d, m)
+	}
+}
+
+// Adds import by display name to policy resources, in addition to import by
+// ID and by policy path that is supported by resource importers
+func addPolicyImportSupport(name string, resource *schema.Resource) {
+	if !strings.HasPrefix(name, "nsxt_policy_") || resource.Importer == nil {
+		return
+	}
+
+	importState := resourcePolicyImportWrapper(name, resource, getPolicyImportStateFunc(resource.Importer))
+	resource.Importer = &schema.ResourceImporter{
+		StateContext: importState,
+	}
+}
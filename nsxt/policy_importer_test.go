@@ -0,0 +1,216 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testMockImport(resource *schema.Resource, m interface{}, importID string) (*schema.ResourceData, error) {
+	d := resource.Data(nil)
+	d.SetId(importID)
+	results, err := resource.Importer.StateContext(context.Background(), d, m)
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+func TestMockNsxPolicyImport(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+	provider := Provider()
+
+	segment := provider.ResourcesMap["nsxt_policy_segment"]
+	segmentConfig := map[string]interface{}{
+		"display_name":        "mock import",
+		"transport_zone_path": "/infra/sites/default/enforcement-points/default/transport-zones/overlay-tz",
+		"subnet": []interface{}{
+			map[string]interface{}{"cidr": "12.12.4.1/24"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, segment.Schema, segmentConfig)
+	if diags := segment.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	segmentID := d.Id()
+
+	group := provider.ResourcesMap["nsxt_policy_group"]
+	d = schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{
+		"display_name": "mock import",
+	})
+	if diags := group.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	groupID := d.Id()
+
+	// Objects of other types with same name are ignored
+	imported, err := testMockImport(segment, m, "name:mock import")
+	if err != nil {
+		t.Fatalf("Import by name failed: %v", err)
+	}
+	if imported.Id() != segmentID {
+		t.Fatalf("Expected segment %s to be imported by name, got %s", segmentID, imported.Id())
+	}
+	imported, err = testMockImport(group, m, "name:mock import")
+	if err != nil {
+		t.Fatalf("Import by name failed: %v", err)
+	}
+	if imported.Id() != groupID || imported.Get("domain").(string) != "default" {
+		t.Fatalf("Expected group %s in default domain to be imported by name, got %s", groupID, imported.Id())
+	}
+
+	if _, err := testMockImport(segment, m, "name:mock missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("Expected import of missing name to fail, got %v", err)
+	}
+
+	d = schema.TestResourceDataRaw(t, segment.Schema, segmentConfig)
+	if diags := segment.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if _, err := testMockImport(segment, m, "name:mock import"); err == nil || !strings.Contains(err.Error(), "found 2 objects") {
+		t.Fatalf("Expected import of ambiguous name to fail, got %v", err)
+	}
+
+	tier0 := provider.ResourcesMap["nsxt_policy_tier0_gateway"]
+	imported, err = testMockImport(tier0, m, "/infra/tier-0s/"+getTier0RouterName())
+	if err != nil {
+		t.Fatalf("Import by path failed: %v", err)
+	}
+	if imported.Id() != getTier0RouterName() {
+		t.Fatalf("Expected tier0 %s to be imported by path, got %s", getTier0RouterName(), imported.Id())
+	}
+
+	imported, err = testMockImport(segment, m, "/orgs/default/projects/dev/infra/segments/project-segment")
+	if err != nil {
+		t.Fatalf("Import by path failed: %v", err)
+	}
+	if imported.Id() != "project-segment" || getProjectIDFromSchema(imported) != "dev" {
+		t.Fatalf("Expected context to be set from project path, got %v", imported.Get("context"))
+	}
+}
+
+func TestMockNsxPolicyImportByNameInProject(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+	provider := Provider()
+
+	server.lock.Lock()
+	for _, path := range []string{"/infra/segments/app", "/orgs/default/projects/dev/infra/segments/app"} {
+		server.storeObject(path, map[string]interface{}{
+			"display_name":        "app/segment",
+			"transport_zone_path": "/infra/sites/default/enforcement-points/default/transport-zones/overlay-tz",
+		})
+	}
+	server.lock.Unlock()
+
+	segment := provider.ResourcesMap["nsxt_policy_segment"]
+	imported, err := testMockImport(segment, m, "name:dev/app/segment")
+	if err != nil {
+		t.Fatalf("Import by name in project failed: %v", err)
+	}
+	if imported.Id() != "app" || getProjectIDFromSchema(imported) != "dev" {
+		t.Fatalf("Expected segment in project dev to be imported, got %v", imported.State())
+	}
+
+	// Empty project refers to default space, objects in projects are ignored
+	imported, err = testMockImport(segment, m, "name:/app/segment")
+	if err != nil {
+		t.Fatalf("Import by name in default space failed: %v", err)
+	}
+	if imported.Id() != "app" || getProjectIDFromSchema(imported) != "" {
+		t.Fatalf("Expected segment in default space to be imported, got %v", imported.State())
+	}
+
+	if _, err = testMockImport(segment, m, "name:test/app/segment"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("Expected import from project without the segment to fail, got %v", err)
+	}
+}
+
+func TestMockNsxPolicyImportByPath(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+	provider := Provider()
+
+	localeServicePath := "/infra/tier-0s/t0/locale-services/default"
+	edgePath := "/infra/sites/default/enforcement-points/default/edge-clusters/ec/edge-nodes/"
+	server.lock.Lock()
+	server.storeObject("/infra/tier-0s/t0", map[string]interface{}{})
+	server.storeObject(localeServicePath, map[string]interface{}{
+		"route_redistribution_config": map[string]interface{}{"bgp_enabled": true},
+		"ha_vip_configs":              []interface{}{},
+	})
+	server.storeObject(localeServicePath+"/interfaces/if0", map[string]interface{}{"type": "EXTERNAL", "edge_path": edgePath + "0"})
+	server.storeObject(localeServicePath+"/interfaces/if1", map[string]interface{}{"type": "EXTERNAL", "edge_path": edgePath + "1"})
+	server.storeObject(localeServicePath+"/evpn-tunnel-endpoints/ep1", map[string]interface{}{"edge_path": edgePath + "1"})
+	server.lock.Unlock()
+
+	imported, err := testMockImport(provider.ResourcesMap["nsxt_policy_tier0_gateway_interface"], m, localeServicePath+"/interfaces/if0")
+	if err != nil {
+		t.Fatalf("Import by path failed: %v", err)
+	}
+	if imported.Id() != "if0" || imported.Get("gateway_path").(string) != "/infra/tier-0s/t0" || imported.Get("locale_service_id").(string) != "default" {
+		t.Fatalf("Unexpected imported interface state: %v", imported.State())
+	}
+
+	imported, err = testMockImport(provider.ResourcesMap["nsxt_policy_evpn_tunnel_endpoint"], m, localeServicePath+"/evpn-tunnel-endpoints/ep1")
+	if err != nil {
+		t.Fatalf("Import by path failed: %v", err)
+	}
+	if imported.Id() != "ep1" || imported.Get("external_interface_path").(string) != localeServicePath+"/interfaces/if1" {
+		t.Fatalf("Expected interface to be resolved by edge node, got %v", imported.State())
+	}
+
+	for _, name := range []string{"nsxt_policy_gateway_redistribution_config", "nsxt_policy_tier0_gateway_ha_vip_config"} {
+		imported, err = testMockImport(provider.ResourcesMap[name], m, localeServicePath)
+		if err != nil {
+			t.Fatalf("Import of %s by path failed: %v", name, err)
+		}
+		if imported.Get("locale_service_id").(string) != "default" {
+			t.Fatalf("Expected locale service to be set on import of %s, got %v", name, imported.State())
+		}
+	}
+
+	imported, err = testMockImport(provider.ResourcesMap["nsxt_policy_user_management_role"], m, "/aaa/roles/auditor")
+	if err != nil || imported.Id() != "auditor" {
+		t.Fatalf("Expected role to be imported by path, got %v", err)
+	}
+	if _, err = testMockImport(provider.ResourcesMap["nsxt_policy_user_management_role"], m, "/aaa/role-bindings/auditor"); err == nil {
+		t.Fatalf("Expected import of role by path of other type to fail")
+	}
+
+	customAttribute := provider.ResourcesMap["nsxt_policy_context_profile_custom_attribute"]
+	imported, err = testMockImport(customAttribute, m, "/infra/context-profiles/custom-attributes/default/DOMAIN_NAME~test.example.com")
+	if err != nil || imported.Id() != "DOMAIN_NAME~test.example.com" {
+		t.Fatalf("Expected custom attribute to be imported by path, got %v", err)
+	}
+	if _, err = testMockImport(customAttribute, m, "DOMAIN_NAME"); err == nil {
+		t.Fatalf("Expected import of custom attribute without attribute value to fail")
+	}
+
+	// Import by name reports resources that can not be imported by path
+	server.lock.Lock()
+	server.storeObject("/infra/services/legacy", map[string]interface{}{"display_name": "legacy"})
+	server.lock.Unlock()
+	legacy := &schema.Resource{
+		Schema: map[string]*schema.Schema{"path": getPathSchema()},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				return nil, fmt.Errorf("Please provide <id> as an input")
+			},
+		},
+	}
+	addPolicyImportSupport("nsxt_policy_legacy", legacy)
+	if _, err = testMockImport(legacy, m, "name:legacy"); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("Expected import by name to be reported as not supported, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Schema for plural data sources that list policy objects of given type
+func getPolicyListDataSourceSchema(objectName string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"display_name_regex": {
+			Type:         schema.TypeString,
+			Description:  fmt.Sprintf("Regular expression to filter %s by display name", objectName),
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"tag": getDataSourceTagFilterSchema(),
+		"parent_path": {
+			Type:         schema.TypeString,
+			Description:  fmt.Sprintf("Policy path of the parent object to filter %s by", objectName),
+			Optional:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"items": {
+			Type:        schema.TypeMap,
+			Description: fmt.Sprintf("Mapping of %s policy path by display name", objectName),
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"results": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("List of %s matching the filters, sorted by display name and path", objectName),
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":           getComputedStringSchema("ID of the object"),
+					"path":         getComputedStringSchema("Policy path of the object"),
+					"display_name": getComputedDisplayNameSchema(),
+					"parent_path":  getComputedStringSchema("Policy path of the parent object"),
+				},
+			},
+		},
+		"context": getContextSchema(),
+	}
+}
+
+func getPolicyListDataSource(resourceType string, objectName string) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return policyListDataSourceRead(d, m, resourceType, objectName)
+		},
+		Schema: getPolicyListDataSourceSchema(objectName),
+	}
+}
+
+func policyListDataSourceRead(d *schema.ResourceData, m interface{}, resourceType string, objectName string) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	var nameRegexp *regexp.Regexp
+	if expression := d.Get("display_name_regex").(string); expression != "" {
+		var err error
+		nameRegexp, err = regexp.Compile(expression)
+		if err != nil {
+			return diag.Errorf("Invalid display_name_regex '%s': %v", expression, err)
+		}
+	}
+	tags := getDataSourceTagFilterFromSchema(d)
+	parentPath := d.Get("parent_path").(string)
+
+	query := fmt.Sprintf("resource_type:%s AND marked_for_delete:false", resourceType)
+	if parentPath != "" {
+		query = fmt.Sprintf("%s AND parent_path:%s", query, escapeSpecialCharacters(parentPath))
+	}
+	if tagsQuery := buildPolicyTagsQuery(tags); tagsQuery != "" {
+		query = fmt.Sprintf("%s AND %s", query, tagsQuery)
+	}
+	includedFields := strings.Join([]string{"id", "path", "display_name", "parent_path", "resource_type", "tags"}, ",")
+	resultValues, err := searchPolicyResources(connector, getSessionContext(d, m), query, &includedFields)
+	if err != nil {
+		return diag.Errorf("Error while reading %s: %v", objectName, err)
+	}
+
+	var objs []model.PolicyResource
+	for _, result := range resultValues {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return diag.FromErr(errs[0])
+		}
+		obj := dataValue.(model.PolicyResource)
+		if obj.Id == nil || obj.Path == nil || obj.DisplayName == nil {
+			continue
+		}
+		if nameRegexp != nil && !nameRegexp.MatchString(*obj.DisplayName) {
+			continue
+		}
+		if !policyTagsMatch(tags, getCommonTagsFromPolicyTags(obj.Tags)) {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	// Search API does not guarantee order of results
+	sort.Slice(objs, func(i, j int) bool {
+		if *objs[i].DisplayName != *objs[j].DisplayName {
+			return *objs[i].DisplayName < *objs[j].DisplayName
+		}
+		return *objs[i].Path < *objs[j].Path
+	})
+
+	itemMap := make(map[string]interface{})
+	var results []map[string]interface{}
+	for _, obj := range objs {
+		// Objects with same display name are sorted by path, and the first one
+		// is kept in items, while results hold all of them
+		if existingPath, ok := itemMap[*obj.DisplayName]; ok {
+			log.Printf("[WARNING] Found multiple %s with display name %s, items hold %s, while %s is only available in results", objectName, *obj.DisplayName, existingPath, *obj.Path)
+		} else {
+			itemMap[*obj.DisplayName] = *obj.Path
+		}
+		elem := make(map[string]interface{})
+		elem["id"] = obj.Id
+		elem["path"] = obj.Path
+		elem["display_name"] = obj.DisplayName
+		elem["parent_path"] = obj.ParentPath
+		results = append(results, elem)
+	}
+
+	d.SetId(newUUID())
+	d.Set("items", itemMap)
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMockNsxPolicyListDataSource(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	group := resourceNsxtPolicyGroup()
+	for _, name := range []string{"web-2", "web-1", "db-1"} {
+		d := schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{
+			"display_name": name,
+			"tag":          []interface{}{map[string]interface{}{"scope": "tier", "tag": strings.Split(name, "-")[0]}},
+		})
+		if diags := group.CreateContext(context.Background(), d, m); diags.HasError() {
+			t.Fatalf("Create failed: %v", diags)
+		}
+	}
+
+	dataSource := dataSourceNsxtPolicyGroups()
+	read := func(config map[string]interface{}) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, dataSource.Schema, config)
+		if diags := dataSource.ReadContext(context.Background(), d, m); diags.HasError() {
+			t.Fatalf("Read failed: %v", diags)
+		}
+		return d
+	}
+
+	d := read(map[string]interface{}{"parent_path": "/infra/domains/default"})
+	if count := d.Get("results.#").(int); count != 3 {
+		t.Fatalf("Expected 3 results, got %d", count)
+	}
+	if d.Get("results.0.display_name").(string) != "db-1" || d.Get("results.2.display_name").(string) != "web-2" {
+		t.Fatalf("Expected results sorted by display name, got %v", d.Get("results"))
+	}
+
+	d = read(map[string]interface{}{"display_name_regex": "^web-"})
+	items := d.Get("items").(map[string]interface{})
+	if len(items) != 2 || !strings.HasPrefix(items["web-1"].(string), "/infra/domains/default/groups/") {
+		t.Fatalf("Expected items to map web groups to paths, got %v", items)
+	}
+
+	d = read(map[string]interface{}{
+		"tag": []interface{}{map[string]interface{}{"scope": "tier", "tag": "db"}},
+	})
+	if count := d.Get("results.#").(int); count != 1 || d.Get("results.0.display_name").(string) != "db-1" {
+		t.Fatalf("Expected results to be filtered by tag, got %v", d.Get("results"))
+	}
+
+	d = read(map[string]interface{}{"parent_path": "/infra/domains/other"})
+	if count := d.Get("results.#").(int); count != 0 {
+		t.Fatalf("Expected results to be filtered by parent path, got %d", count)
+	}
+
+	duplicate := schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{"display_name": "web-1"})
+	if diags := group.CreateContext(context.Background(), duplicate, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	d = read(map[string]interface{}{"display_name_regex": "^web-"})
+	if count := d.Get("results.#").(int); count != 3 {
+		t.Fatalf("Expected results to include objects with duplicate display names, got %d", count)
+	}
+	firstPath := d.Get("results.0.path").(string)
+	if d.Get("results.1.display_name").(string) != "web-1" || firstPath > d.Get("results.1.path").(string) {
+		t.Fatalf("Expected objects with same display name to be sorted by path, got %v", d.Get("results"))
+	}
+	items = d.Get("items").(map[string]interface{})
+	if len(items) != 2 || items["web-1"].(string) != firstPath {
+		t.Fatalf("Expected items to hold first of objects with duplicate display name, got %v", items)
+	}
+}
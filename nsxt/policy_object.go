@@ -0,0 +1,129 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Generic client for policy objects of any type, identified by policy path.
+// Only attributes common to all policy objects are exposed, hence this client
+// is suitable for operations that do not depend on object type, such as tagging.
+type policyObjectClient struct {
+	connector client.Connector
+}
+
+func newPolicyObjectClient(connector client.Connector) *policyObjectClient {
+	return &policyObjectClient{connector: connector}
+}
+
+func getPolicyObjectURL(path string) string {
+	basePath := "/policy/api/v1"
+	if strings.HasPrefix(path, "/global-infra") {
+		basePath = "/global-manager/api/v1"
+	}
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for i, seg := range segs {
+		segs[i] = url.PathEscape(seg)
+	}
+	return basePath + "/" + strings.Join(segs, "/")
+}
+
+func getPolicyObjectRestMetadata(method string, path string, withBody bool, successCode int) protocol.OperationRestMetadata {
+	fields := map[string]bindings.BindingType{}
+	fieldNameMap := map[string]string{}
+	paramsTypeMap := map[string]bindings.BindingType{}
+	bodyParam := ""
+	if withBody {
+		fields["object"] = bindings.NewReferenceType(model.PolicyResourceBindingType)
+		fieldNameMap["object"] = "Object"
+		paramsTypeMap["object"] = bindings.NewReferenceType(model.PolicyResourceBindingType)
+		bodyParam = "object"
+	}
+	return protocol.NewOperationRestMetadata(
+		fields,
+		fieldNameMap,
+		paramsTypeMap,
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		"",
+		bodyParam,
+		method,
+		getPolicyObjectURL(path),
+		"",
+		map[string]string{},
+		successCode,
+		"",
+		map[string]map[string]string{},
+		map[string]int{"com.vmware.vapi.std.errors.invalid_request": 400, "com.vmware.vapi.std.errors.unauthorized": 403, "com.vmware.vapi.std.errors.service_unavailable": 503, "com.vmware.vapi.std.errors.internal_server_error": 500, "com.vmware.vapi.std.errors.not_found": 404})
+}
+
+func getPolicyObjectInputType(withBody bool) bindings.StructType {
+	fields := make(map[string]bindings.BindingType)
+	fieldNameMap := make(map[string]string)
+	if withBody {
+		fields["object"] = bindings.NewReferenceType(model.PolicyResourceBindingType)
+		fieldNameMap["object"] = "Object"
+	}
+	return bindings.NewStructType("operation-input", fields, reflect.TypeOf(data.StructValue{}), fieldNameMap, []bindings.Validator{})
+}
+
+func (c *policyObjectClient) invoke(operation string, metadata protocol.OperationRestMetadata, obj *model.PolicyResource) (data.DataValue, error) {
+	typeConverter := c.connector.TypeConverter()
+	executionContext := c.connector.NewExecutionContext()
+	executionContext.SetConnectionMetadata(core.RESTMetadataKey, metadata)
+	executionContext.SetConnectionMetadata(core.ResponseTypeKey, core.NewResponseType(true, false))
+
+	sv := bindings.NewStructValueBuilder(getPolicyObjectInputType(obj != nil), typeConverter)
+	if obj != nil {
+		sv.AddStructField("Object", *obj)
+	}
+	inputDataValue, inputError := sv.GetStructValue()
+	if inputError != nil {
+		return nil, bindings.VAPIerrorsToError(inputError)
+	}
+
+	methodResult := c.connector.GetApiProvider().Invoke("com.vmware.nsx_policy.infra", operation, inputDataValue, executionContext)
+	if methodResult.IsSuccess() {
+		return methodResult.Output(), nil
+	}
+	methodError, errorInError := typeConverter.ConvertToGolang(methodResult.Error(), errors.ERROR_BINDINGS_MAP[methodResult.Error().Name()])
+	if errorInError != nil {
+		return nil, bindings.VAPIerrorsToError(errorInError)
+	}
+	return nil, methodError.(error)
+}
+
+func (c *policyObjectClient) Get(path string) (model.PolicyResource, error) {
+	var obj model.PolicyResource
+	output, err := c.invoke("get", getPolicyObjectRestMetadata("GET", path, false, 200), nil)
+	if err != nil {
+		return obj, err
+	}
+	result, errs := c.connector.TypeConverter().ConvertToGolang(output, bindings.NewReferenceType(model.PolicyResourceBindingType))
+	if errs != nil {
+		return obj, bindings.VAPIerrorsToError(errs)
+	}
+	return result.(model.PolicyResource), nil
+}
+
+// Patches attributes that are set in the object, other attributes of the
+// policy object are not modified
+func (c *policyObjectClient) Patch(path string, obj model.PolicyResource) error {
+	_, err := c.invoke("patch", getPolicyObjectRestMetadata("PATCH", path, true, 200), &obj)
+	return err
+}
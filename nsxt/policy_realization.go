@@ -0,0 +1,181 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	policyRealizationStateUnknown    = "UNKNOWN"
+	policyRealizationStateUnrealized = "UNREALIZED"
+	policyRealizationStateRealized   = "REALIZED"
+	policyRealizationStateError      = "ERROR"
+	// No realized entities were reported for the intent
+	policyRealizationStateNone = "NONE"
+)
+
+// Time to wait for realized entities to be reported for the intent
+var policyRealizationGracePeriod = 10 * time.Second
+
+func getWaitForRealizationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for realization of the resource on create and update, overrides provider setting",
+		Optional:    true,
+	}
+}
+
+func getRealizationStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Realization state of the resource, as observed when waiting for realization on last create or update",
+		Computed:    true,
+	}
+}
+
+// Resource level setting takes precedence over provider setting
+func isPolicyWaitForRealization(d *schema.ResourceData, m interface{}) bool {
+	wait, isSet := d.GetOkExists("wait_for_realization")
+	if isSet {
+		return wait.(bool)
+	}
+	if m == nil {
+		return false
+	}
+	return getCommonProviderConfig(m).WaitForRealization
+}
+
+// Aggregates state of all entities realized for the intent, along with
+// error messages reported by entities that failed to realize
+func getPolicyRealizationState(entities []model.GenericPolicyRealizedResource) (string, []string) {
+	if len(entities) == 0 {
+		return policyRealizationStateUnknown, nil
+	}
+
+	state := policyRealizationStateRealized
+	var messages []string
+	for _, entity := range entities {
+		if entity.State == nil {
+			state = policyRealizationStateUnknown
+			continue
+		}
+		switch *entity.State {
+		case policyRealizationStateRealized:
+		case policyRealizationStateError:
+			messages = append(messages, getPolicyRealizationErrors(entity)...)
+		default:
+			state = policyRealizationStateUnrealized
+		}
+	}
+	if len(messages) > 0 {
+		state = policyRealizationStateError
+	}
+	return state, messages
+}
+
+func getPolicyRealizationErrors(entity model.GenericPolicyRealizedResource) []string {
+	var messages []string
+	for _, alarm := range entity.Alarms {
+		if alarm.Message != nil && *alarm.Message != "" {
+			messages = append(messages, *alarm.Message)
+		}
+	}
+	if entity.PublishStatusError != nil && *entity.PublishStatusError != "" {
+		messages = append(messages, *entity.PublishStatusError)
+	}
+	if entity.RuntimeError != nil && *entity.RuntimeError != "" {
+		messages = append(messages, *entity.RuntimeError)
+	}
+	if len(messages) == 0 {
+		name := ""
+		if entity.DisplayName != nil {
+			name = *entity.DisplayName
+		}
+		messages = append(messages, fmt.Sprintf("realized entity %s is in error state", name))
+	}
+	return messages
+}
+
+// Waits for realization of the intent path, and sets realization_status in
+// schema. Wait is aborted when ctx is cancelled.
+func waitForPolicyRealization(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	intentPath := d.Get("path").(string)
+	if intentPath == "" {
+		return nil
+	}
+	if isPolicyGlobalManager(m) {
+		// Realization is tracked per site on Global Manager
+		log.Printf("[DEBUG] Skipping realization wait for %s on Global Manager", intentPath)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for realization of %s", intentPath)
+	stateConf := nsxtPolicyWaitForRealizationStateConf(getSessionContext(d, m), getPolicyConnector(m), intentPath, timeout)
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get realization state for %s: %v", intentPath, err)
+	}
+	entities := result.(model.GenericPolicyRealizedResourceListResult).Results
+	state, messages := getPolicyRealizationState(entities)
+	if len(entities) == 0 {
+		state = policyRealizationStateNone
+	}
+	d.Set("realization_status", state)
+	if len(messages) > 0 {
+		return fmt.Errorf("realization of %s failed: %s", intentPath, strings.Join(messages, "; "))
+	}
+	return nil
+}
+
+func resourceRealizationWaitWrapper(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, timeoutKey string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := operation(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if !isPolicyWaitForRealization(d, m) {
+			// Status observed on previous wait is no longer relevant
+			d.Set("realization_status", "")
+			return diags
+		}
+		if err := waitForPolicyRealization(ctx, d, m, d.Timeout(timeoutKey)); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// Adds realization wait option to policy resources that expose intent path
+func addRealizationSupport(name string, resource *schema.Resource) {
+	if !strings.HasPrefix(name, "nsxt_policy_") || resource.CreateContext == nil {
+		return
+	}
+	if _, ok := resource.Schema["path"]; !ok {
+		return
+	}
+	if _, ok := resource.Schema["wait_for_realization"]; ok {
+		return
+	}
+
+	waitSchema := getWaitForRealizationSchema()
+	resource.Schema["wait_for_realization"] = waitSchema
+	resource.Schema["realization_status"] = getRealizationStatusSchema()
+	resource.CreateContext = resourceRealizationWaitWrapper(resource.CreateContext, schema.TimeoutCreate)
+	if resource.UpdateContext == nil {
+		// Resource can not be updated, hence the setting is only
+		// relevant on creation
+		waitSchema.ForceNew = true
+		return
+	}
+	resource.UpdateContext = resourceRealizationWaitWrapper(resource.UpdateContext, schema.TimeoutUpdate)
+}
@@ -0,0 +1,128 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMockNsxWaitForRealization(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	resource := Provider().ResourcesMap["nsxt_policy_group"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name":         "mock-realized-group",
+		"wait_for_realization": true,
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if status := d.Get("realization_status").(string); status != "REALIZED" {
+		t.Fatalf("Expected REALIZED realization status, got %s", status)
+	}
+
+	errorMessage := "Failed to realize group on transport node"
+	server.setRealizationError(d.Get("path").(string), errorMessage)
+	d.Set("description", "updated")
+	diags := resource.UpdateContext(context.Background(), d, m)
+	if !diags.HasError() {
+		t.Fatalf("Expected update to fail on realization error")
+	}
+	if !strings.Contains(diags[0].Summary, errorMessage) {
+		t.Fatalf("Expected realization error message in %s", diags[0].Summary)
+	}
+	if status := d.Get("realization_status").(string); status != "ERROR" {
+		t.Fatalf("Expected ERROR realization status, got %s", status)
+	}
+
+	// Provider setting applies unless overridden by resource
+	m = testMockGetProviderMetaWithConfig(t, server.Host(), map[string]interface{}{
+		"wait_for_realization": true,
+	})
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-unrealized-group",
+		"nsx_id":       "unrealized",
+	})
+	server.setRealizationError("/infra/domains/default/groups/unrealized", errorMessage)
+	server.setRealizationError("/infra/domains/default/groups/not-waited", errorMessage)
+	if diags := resource.CreateContext(context.Background(), d, m); !diags.HasError() {
+		t.Fatalf("Expected create to fail on realization error")
+	}
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name":         "mock-unrealized-group",
+		"nsx_id":               "not-waited",
+		"wait_for_realization": false,
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if status := d.Get("realization_status").(string); status != "" {
+		t.Fatalf("Expected empty realization status without waiting, got %s", status)
+	}
+}
+
+func TestMockNsxWaitForRealizationNoEntities(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	gracePeriod := policyRealizationGracePeriod
+	policyRealizationGracePeriod = 0
+	defer func() { policyRealizationGracePeriod = gracePeriod }()
+
+	// Intent without realized entities is not waited for until timeout
+	resource := Provider().ResourcesMap["nsxt_policy_group"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	d.Set("path", "/infra/domains/default/groups/not-realized")
+	if err := waitForPolicyRealization(context.Background(), d, m, 10*time.Second); err != nil {
+		t.Fatalf("Realization wait failed: %v", err)
+	}
+	if status := d.Get("realization_status").(string); status != policyRealizationStateNone {
+		t.Fatalf("Expected %s realization status, got %s", policyRealizationStateNone, status)
+	}
+
+	// Status of previous wait is cleared on update without waiting
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name":         "mock-realized-group",
+		"wait_for_realization": true,
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	d.Set("wait_for_realization", false)
+	if diags := resource.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	if status := d.Get("realization_status").(string); status != "" {
+		t.Fatalf("Expected realization status to be cleared, got %s", status)
+	}
+}
+
+func TestMockNsxWaitForRealizationCancel(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	// Intent without realized entities is pending within grace period, until
+	// the wait is cancelled
+	resource := Provider().ResourcesMap["nsxt_policy_group"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	d.Set("path", "/infra/domains/default/groups/not-realized")
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := waitForPolicyRealization(ctx, d, m, time.Minute); err == nil {
+		t.Fatalf("Expected cancelled realization wait to fail")
+	}
+	if elapsed := time.Since(start); elapsed >= policyRealizationGracePeriod {
+		t.Fatalf("Expected realization wait to stop on cancellation, took %v", elapsed)
+	}
+}
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
-	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -24,13 +26,170 @@ type policySearchDataValue struct {
 	Resource    model.PolicyResource
 }
 
+const (
+	dataSourceMatchModeExact  = "exact"
+	dataSourceMatchModePrefix = "prefix"
+	dataSourceMatchModeRegex  = "regex"
+)
+
+var dataSourceMatchModeValues = []string{dataSourceMatchModeExact, dataSourceMatchModePrefix, dataSourceMatchModeRegex}
+
+func getDataSourceMatchModeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "How display_name is matched against object names",
+		Optional:     true,
+		Default:      dataSourceMatchModeExact,
+		ValidateFunc: validation.StringInSlice(dataSourceMatchModeValues, false),
+	}
+}
+
+// policyDataSourceMatcher matches objects against display_name of the data
+// source according to match_mode, and against tag filter
+type policyDataSourceMatcher struct {
+	name       string
+	mode       string
+	nameRegexp *regexp.Regexp
+	tags       []common.Tag
+}
+
+func newPolicyDataSourceMatcher(d *schema.ResourceData) (*policyDataSourceMatcher, error) {
+	matcher := policyDataSourceMatcher{
+		name: d.Get("display_name").(string),
+		tags: getDataSourceTagFilterFromSchema(d),
+	}
+	if mode, ok := d.Get("match_mode").(string); ok {
+		matcher.mode = mode
+	}
+	if matcher.mode == dataSourceMatchModeRegex {
+		nameRegexp, err := regexp.Compile(matcher.name)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid display_name regular expression '%s': %v", matcher.name, err)
+		}
+		matcher.nameRegexp = nameRegexp
+	}
+	return &matcher, nil
+}
+
+// Returns whether the object is a match. Objects are matched by tags only if
+// display_name is not specified.
+func (matcher *policyDataSourceMatcher) match(displayName *string, tags []common.Tag) bool {
+	if !policyTagsMatch(matcher.tags, tags) {
+		return false
+	}
+	if matcher.name == "" {
+		return true
+	}
+	if displayName == nil {
+		return false
+	}
+	switch matcher.mode {
+	case dataSourceMatchModePrefix:
+		return strings.HasPrefix(*displayName, matcher.name)
+	case dataSourceMatchModeRegex:
+		return matcher.nameRegexp.MatchString(*displayName)
+	}
+	return *displayName == matcher.name
+}
+
+// Describes what the data source is looking for, to be used in error messages
+func (matcher *policyDataSourceMatcher) String() string {
+	var criteria []string
+	switch {
+	case matcher.name == "":
+	case matcher.mode == dataSourceMatchModePrefix:
+		criteria = append(criteria, fmt.Sprintf("name starting with '%s'", matcher.name))
+	case matcher.mode == dataSourceMatchModeRegex:
+		criteria = append(criteria, fmt.Sprintf("name matching '%s'", matcher.name))
+	default:
+		criteria = append(criteria, fmt.Sprintf("name '%s'", matcher.name))
+	}
+	if len(matcher.tags) > 0 {
+		var tags []string
+		for _, tag := range matcher.tags {
+			tags = append(tags, fmt.Sprintf("%s:%s", tag.Scope, tag.Tag))
+		}
+		criteria = append(criteria, fmt.Sprintf("tags %s", strings.Join(tags, ", ")))
+	}
+	return strings.Join(criteria, " and ")
+}
+
+// Error for multiple objects matching data source criteria, listing the candidates
+func policyDataSourceMultipleMatchError(resourceType string, criteria string, names []string, paths []string) error {
+	var candidates []string
+	for i, name := range names {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", name, paths[i]))
+	}
+	return fmt.Errorf("Found multiple %s with %s: %s", resourceType, criteria, strings.Join(candidates, ", "))
+}
+
+// Object listed by data source, to be matched against data source criteria
+type policyDataSourceCandidate struct {
+	displayName *string
+	path        *string
+	tags        []common.Tag
+}
+
+// Selects the single candidate that matches data source criteria, and returns
+// its index. Criteria applied by the data source itself, such as object type,
+// are only used to describe the search in error messages.
+func (matcher *policyDataSourceMatcher) selectMatch(resourceType string, extraCriteria string, candidates []policyDataSourceCandidate) (int, error) {
+	var matches []int
+	for i, candidate := range candidates {
+		if matcher.match(candidate.displayName, candidate.tags) {
+			matches = append(matches, i)
+		}
+	}
+
+	describe := func(criteria string) string {
+		var result []string
+		for _, c := range []string{criteria, extraCriteria} {
+			if c != "" {
+				result = append(result, c)
+			}
+		}
+		return strings.Join(result, " and ")
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+	if len(matches) > 1 {
+		var names, paths []string
+		for _, i := range matches {
+			var name, path string
+			if candidates[i].displayName != nil {
+				name = *candidates[i].displayName
+			}
+			if candidates[i].path != nil {
+				path = *candidates[i].path
+			}
+			names = append(names, name)
+			paths = append(paths, path)
+		}
+		return -1, policyDataSourceMultipleMatchError(resourceType, describe(matcher.String()), names, paths)
+	}
+	if criteria := describe(matcher.String()); criteria != "" {
+		return -1, fmt.Errorf("%s with %s was not found", resourceType, criteria)
+	}
+	return -1, fmt.Errorf("%s was not found", resourceType)
+}
+
 func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues []*data.StructValue, resourceType string) (*data.StructValue, error) {
-	var perfectMatch, prefixMatch []policySearchDataValue
-	var obj policySearchDataValue
-	objName := d.Get("display_name").(string)
+	var objs []policySearchDataValue
+	var candidates []policyDataSourceCandidate
 	objID := d.Get("id").(string)
 	converter := bindings.NewTypeConverter()
+	matcher, err := newPolicyDataSourceMatcher(d)
+	if err != nil {
+		return nil, err
+	}
 
+	var extraCriteria string
+	if objID != "" {
+		// Object found by ID is only matched against tag filter
+		matcher.name = ""
+		extraCriteria = fmt.Sprintf("ID '%s'", objID)
+	}
 	for _, result := range resultValues {
 		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
 		if len(errors) > 0 {
@@ -40,39 +199,19 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 		if resourceType != *policyResource.ResourceType {
 			continue
 		}
-
-		if objID != "" {
-			perfectMatch = append(perfectMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
-			break
-		} else {
-			if *policyResource.DisplayName == objName {
-				perfectMatch = append(perfectMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
-			}
-			if strings.HasPrefix(*policyResource.DisplayName, objName) {
-				prefixMatch = append(prefixMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
-			}
-		}
+		objs = append(objs, policySearchDataValue{StructValue: result, Resource: policyResource})
+		candidates = append(candidates, policyDataSourceCandidate{
+			displayName: policyResource.DisplayName,
+			path:        policyResource.Path,
+			tags:        getCommonTagsFromSearchResult(policyResource.Tags),
+		})
 	}
 
-	if len(perfectMatch) > 0 {
-		if len(perfectMatch) > 1 {
-			if objID != "" {
-				return nil, fmt.Errorf("Found multiple %s with ID '%s'", resourceType, objID)
-			}
-			return nil, fmt.Errorf("Found multiple %s with name '%s'", resourceType, objName)
-		}
-		obj = perfectMatch[0]
-	} else if len(prefixMatch) > 0 {
-		if len(prefixMatch) > 1 {
-			return nil, fmt.Errorf("Found multiple %s with name starting with '%s'", resourceType, objName)
-		}
-		obj = prefixMatch[0]
-	} else {
-		if objID != "" {
-			return nil, fmt.Errorf("%s with ID '%s' was not found", resourceType, objID)
-		}
-		return nil, fmt.Errorf("%s with name '%s' was not found", resourceType, objName)
+	i, err := matcher.selectMatch(resourceType, extraCriteria, candidates)
+	if err != nil {
+		return nil, err
 	}
+	obj := objs[i]
 
 	d.SetId(*obj.Resource.Id)
 	d.Set("display_name", obj.Resource.DisplayName)
@@ -89,11 +228,16 @@ func policyDataSourceResourceRead(d *schema.ResourceData, connector client.Conne
 func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connector client.Connector, context utl.SessionContext, resourceType string, additionalQuery map[string]string, paramsValidation bool) (*data.StructValue, error) {
 	objName := d.Get("display_name").(string)
 	objID := d.Get("id").(string)
+	matchMode, _ := d.Get("match_mode").(string)
+	tags := getDataSourceTagFilterFromSchema(d)
 	var err error
 	var resultValues []*data.StructValue
 	additionalQueryString := buildQueryStringFromMap(additionalQuery)
-	if paramsValidation && objID == "" && objName == "" {
-		return nil, fmt.Errorf("No 'id' or 'display_name' specified for %s", resourceType)
+	if tagsQuery := buildPolicyTagsQuery(tags); tagsQuery != "" {
+		additionalQueryString = *buildPolicyResourcesQuery(&tagsQuery, &additionalQueryString)
+	}
+	if paramsValidation && objID == "" && objName == "" && len(tags) == 0 {
+		return nil, fmt.Errorf("No 'id', 'display_name' or 'tag' specified for %s", resourceType)
 	}
 	if objID != "" {
 		if resourceType == "PolicyEdgeNode" {
@@ -103,6 +247,9 @@ func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connecto
 		} else {
 			resultValues, err = listPolicyResourcesByID(connector, context, &objID, &additionalQueryString)
 		}
+	} else if matchMode == dataSourceMatchModeRegex {
+		// Regular expression is evaluated on the client side
+		resultValues, err = listPolicyResourcesByType(connector, context, resourceType, &additionalQueryString)
 	} else {
 		resultValues, err = listPolicyResourcesByNameAndType(connector, context, objName, resourceType, &additionalQueryString)
 	}
@@ -113,18 +260,14 @@ func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connecto
 	return policyDataSourceResourceFilterAndSet(d, resultValues, resourceType)
 }
 
+func listPolicyResourcesByType(connector client.Connector, context utl.SessionContext, resourceType string, additionalQuery *string) ([]*data.StructValue, error) {
+	query := fmt.Sprintf("resource_type:%s AND marked_for_delete:false", resourceType)
+	return searchPolicyResources(connector, context, *buildPolicyResourcesQuery(&query, additionalQuery), nil)
+}
+
 func listPolicyResourcesByNameAndType(connector client.Connector, context utl.SessionContext, displayName string, resourceType string, additionalQuery *string) ([]*data.StructValue, error) {
 	query := fmt.Sprintf("resource_type:%s AND display_name:%s* AND marked_for_delete:false", resourceType, escapeSpecialCharacters(displayName))
-	switch context.ClientType {
-	case utl.Local:
-		return searchLMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
-	case utl.Global:
-		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
-	case utl.Multitenancy:
-		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, *buildPolicyResourcesQuery(&query, additionalQuery))
-	}
-
-	return nil, errors.New("invalid ClientType %d")
+	return searchPolicyResources(connector, context, *buildPolicyResourcesQuery(&query, additionalQuery), nil)
 }
 
 func escapeSpecialCharacters(str string) string {
@@ -143,29 +286,27 @@ func escapeSpecialCharacters(str string) string {
 
 func listPolicyResourcesByID(connector client.Connector, context utl.SessionContext, resourceID *string, additionalQuery *string) ([]*data.StructValue, error) {
 	query := fmt.Sprintf("id:%s AND marked_for_delete:false", escapeSpecialCharacters(*resourceID))
-	switch context.ClientType {
-	case utl.Local:
-		return searchLMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
-	case utl.Global:
-		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
-	case utl.Multitenancy:
-		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, *buildPolicyResourcesQuery(&query, additionalQuery))
-	}
-
-	return nil, errors.New("invalid ClientType %d")
+	return searchPolicyResources(connector, context, *buildPolicyResourcesQuery(&query, additionalQuery), nil)
 }
 
 func listPolicyResourcesByNsxID(connector client.Connector, context utl.SessionContext, resourceID *string, additionalQuery *string) ([]*data.StructValue, error) {
 	query := fmt.Sprintf("nsx_id:%s AND marked_for_delete:false", escapeSpecialCharacters(*resourceID))
+	return searchPolicyResources(connector, context, *buildPolicyResourcesQuery(&query, additionalQuery), nil)
+}
+
+// Search policy objects within the session context, paging through all results.
+// If includedFields is specified, only listed fields are retrieved.
+func searchPolicyResources(connector client.Connector, context utl.SessionContext, query string, includedFields *string) ([]*data.StructValue, error) {
 	switch context.ClientType {
 	case utl.Local:
-		return searchLMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
+		return searchLMPolicyResources(connector, query, includedFields)
 	case utl.Global:
-		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
+		return searchGMPolicyResources(connector, query, includedFields)
 	case utl.Multitenancy:
-		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, *buildPolicyResourcesQuery(&query, additionalQuery))
+		return searchMultitenancyPolicyResources(connector, context.GetOrgID(), context.ProjectID, query, includedFields)
 	}
-	return nil, errors.New("invalid ClientType %d")
+
+	return nil, fmt.Errorf("invalid ClientType %d", context.ClientType)
 }
 
 func buildPolicyResourcesQuery(query *string, additionalQuery *string) *string {
@@ -175,7 +316,7 @@ func buildPolicyResourcesQuery(query *string, additionalQuery *string) *string {
 	return query
 }
 
-func searchGMPolicyResources(connector client.Connector, query string) ([]*data.StructValue, error) {
+func searchGMPolicyResources(connector client.Connector, query string, includedFields *string) ([]*data.StructValue, error) {
 	client := search.NewQueryClient(connector)
 	var results []*data.StructValue
 	var cursor *string
@@ -185,7 +326,7 @@ func searchGMPolicyResources(connector client.Connector, query string) ([]*data.
 	query = query + " AND path:\\/global-infra*"
 
 	for {
-		searchResponse, err := client.List(query, cursor, nil, nil, nil, nil)
+		searchResponse, err := client.List(query, cursor, includedFields, nil, nil, nil)
 		if err != nil {
 			return results, err
 		}
@@ -201,7 +342,7 @@ func searchGMPolicyResources(connector client.Connector, query string) ([]*data.
 	}
 }
 
-func searchLMPolicyResources(connector client.Connector, query string) ([]*data.StructValue, error) {
+func searchLMPolicyResources(connector client.Connector, query string, includedFields *string) ([]*data.StructValue, error) {
 	client := lm_search.NewQueryClient(connector)
 	var results []*data.StructValue
 	var cursor *string
@@ -211,7 +352,7 @@ func searchLMPolicyResources(connector client.Connector, query string) ([]*data.
 	query = query + " AND path:\\/infra*"
 
 	for {
-		searchResponse, err := client.List(query, cursor, nil, nil, nil, nil)
+		searchResponse, err := client.List(query, cursor, includedFields, nil, nil, nil)
 		if err != nil {
 			return results, err
 		}
@@ -227,7 +368,7 @@ func searchLMPolicyResources(connector client.Connector, query string) ([]*data.
 	}
 }
 
-func searchMultitenancyPolicyResources(connector client.Connector, org string, project string, query string) ([]*data.StructValue, error) {
+func searchMultitenancyPolicyResources(connector client.Connector, org string, project string, query string, includedFields *string) ([]*data.StructValue, error) {
 	client := lm_search.NewQueryClient(connector)
 	var results []*data.StructValue
 	var cursor *string
@@ -236,7 +377,7 @@ func searchMultitenancyPolicyResources(connector client.Connector, org string, p
 	query = query + fmt.Sprintf(" AND path:\\/orgs\\/%s\\/projects\\/%s*", org, project)
 
 	for {
-		searchResponse, err := client.List(query, cursor, nil, nil, nil, nil)
+		searchResponse, err := client.List(query, cursor, includedFields, nil, nil, nil)
 		if err != nil {
 			return results, err
 		}
@@ -251,3 +392,84 @@ func searchMultitenancyPolicyResources(connector client.Connector, org string, p
 		}
 	}
 }
+
+func getDataSourceTagFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Only objects carrying all of the specified tags are matched",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// Search query for tag filter. Since search API does not correlate scope and
+// tag of same tag entry, results need to be verified with policyTagsMatch.
+func buildPolicyTagsQuery(tags []common.Tag) string {
+	var terms []string
+	for _, tag := range tags {
+		if tag.Scope != "" {
+			terms = append(terms, fmt.Sprintf("tags.scope:%s", escapeSpecialCharacters(tag.Scope)))
+		}
+		if tag.Tag != "" {
+			terms = append(terms, fmt.Sprintf("tags.tag:%s", escapeSpecialCharacters(tag.Tag)))
+		}
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// Returns true if object tags contain all tags in the filter. Empty scope or
+// tag in the filter matches any value.
+func policyTagsMatch(filter []common.Tag, tags []common.Tag) bool {
+	for _, filterTag := range filter {
+		found := false
+		for _, tag := range tags {
+			if filterTag.Scope != "" && tag.Scope != filterTag.Scope {
+				continue
+			}
+			if filterTag.Tag != "" && tag.Tag != filterTag.Tag {
+				continue
+			}
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func getDataSourceTagFilterFromSchema(d *schema.ResourceData) []common.Tag {
+	tags, ok := d.Get("tag").(*schema.Set)
+	if !ok {
+		// Tag filter is not supported by this data source
+		return nil
+	}
+	return getTagsFromSetList(tags.List())
+}
+
+func getCommonTagsFromSearchResult(tags []model.Tag) []common.Tag {
+	var tagList []common.Tag
+	for _, tag := range tags {
+		elem := common.Tag{}
+		if tag.Scope != nil {
+			elem.Scope = *tag.Scope
+		}
+		if tag.Tag != nil {
+			elem.Tag = *tag.Tag
+		}
+		tagList = append(tagList, elem)
+	}
+	return tagList
+}
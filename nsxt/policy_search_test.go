@@ -0,0 +1,134 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestMockNsxSearch(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+	connector := getPolicyConnector(m)
+
+	objs, err := listPolicyResourcesByNameAndType(connector, utl.SessionContext{ClientType: utl.Local}, getVlanTransportZoneName(), "PolicyTransportZone", nil)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(objs) != 1 {
+		t.Fatalf("Expected single transport zone %s, got %d", getVlanTransportZoneName(), len(objs))
+	}
+}
+
+func TestMockNsxDataSourceMatchMode(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	group := resourceNsxtPolicyGroup()
+	for _, name := range []string{"web-legacy", "web-new"} {
+		d := schema.TestResourceDataRaw(t, group.Schema, map[string]interface{}{
+			"display_name": name,
+			"tag":          []interface{}{map[string]interface{}{"scope": "app", "tag": name}},
+		})
+		if diags := group.CreateContext(context.Background(), d, m); diags.HasError() {
+			t.Fatalf("Create failed: %v", diags)
+		}
+	}
+
+	dataSource := dataSourceNsxtPolicyGroup()
+	read := func(config map[string]interface{}) (*schema.ResourceData, error) {
+		d := schema.TestResourceDataRaw(t, dataSource.Schema, config)
+		if diags := dataSource.ReadContext(context.Background(), d, m); diags.HasError() {
+			return d, fmt.Errorf("%s", diags[0].Summary)
+		}
+		return d, nil
+	}
+
+	if _, err := read(map[string]interface{}{"display_name": "web", "match_mode": "exact"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("Expected exact match to fail, got %v", err)
+	}
+	if _, err := read(map[string]interface{}{"display_name": "web-n"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("Expected exact match by default, got %v", err)
+	}
+	_, err := read(map[string]interface{}{"display_name": "web", "match_mode": "prefix"})
+	if err == nil || !strings.Contains(err.Error(), "web-legacy (/infra/domains/default/groups/") || !strings.Contains(err.Error(), "web-new (") {
+		t.Fatalf("Expected ambiguity error listing candidates, got %v", err)
+	}
+	d, err := read(map[string]interface{}{"display_name": "^web-n.w$", "match_mode": "regex"})
+	if err != nil || d.Get("display_name").(string) != "web-new" {
+		t.Fatalf("Expected regex to match web-new, got %v", err)
+	}
+	d, err = read(map[string]interface{}{
+		"display_name": "web",
+		"match_mode":   "prefix",
+		"tag":          []interface{}{map[string]interface{}{"scope": "app", "tag": "web-legacy"}},
+	})
+	if err != nil || d.Get("display_name").(string) != "web-legacy" {
+		t.Fatalf("Expected tag filter to match web-legacy, got %v", err)
+	}
+}
+
+func TestMockNsxListDataSourceMatchMode(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	server.lock.Lock()
+	for _, name := range []string{"app", "app-legacy", "app-new"} {
+		server.storeObject("/infra/ip-blocks/"+name, map[string]interface{}{
+			"display_name": name,
+			"cidr":         "10.0.0.0/16",
+			"tags":         []interface{}{map[string]interface{}{"scope": "app", "tag": name}},
+		})
+	}
+	for _, name := range []string{"web-http", "web-tcp"} {
+		server.storeObject("/infra/lb-monitor-profiles/"+name, map[string]interface{}{
+			"display_name":  name,
+			"resource_type": "LBHttpMonitorProfile",
+		})
+	}
+	server.lock.Unlock()
+
+	read := func(dataSource *schema.Resource, config map[string]interface{}) (*schema.ResourceData, error) {
+		d := schema.TestResourceDataRaw(t, dataSource.Schema, config)
+		if diags := dataSource.ReadContext(context.Background(), d, m); diags.HasError() {
+			return d, fmt.Errorf("%s", diags[0].Summary)
+		}
+		return d, nil
+	}
+
+	ipBlock := dataSourceNsxtPolicyIPBlock()
+	d, err := read(ipBlock, map[string]interface{}{"display_name": "app"})
+	if err != nil || d.Id() != "app" {
+		t.Fatalf("Expected exact match to be preferred, got %v", err)
+	}
+	_, err = read(ipBlock, map[string]interface{}{"display_name": "app-", "match_mode": "prefix"})
+	if err == nil || !strings.Contains(err.Error(), "app-legacy (/infra/ip-blocks/app-legacy)") || !strings.Contains(err.Error(), "app-new (/infra/ip-blocks/app-new)") {
+		t.Fatalf("Expected ambiguity error listing candidates, got %v", err)
+	}
+	d, err = read(ipBlock, map[string]interface{}{
+		"tag": []interface{}{map[string]interface{}{"scope": "app", "tag": "app-new"}},
+	})
+	if err != nil || d.Id() != "app-new" {
+		t.Fatalf("Expected tag filter to match app-new, got %v", err)
+	}
+
+	monitor := dataSourceNsxtPolicyLBMonitor()
+	d, err = read(monitor, map[string]interface{}{"display_name": "^web-t", "match_mode": "regex", "type": "HTTP"})
+	if err != nil || d.Id() != "web-tcp" {
+		t.Fatalf("Expected regex to match web-tcp, got %v", err)
+	}
+	_, err = read(monitor, map[string]interface{}{"type": "HTTP"})
+	if err == nil || !strings.Contains(err.Error(), "type HTTP") || !strings.Contains(err.Error(), "web-http (") {
+		t.Fatalf("Expected ambiguity error listing candidates of type, got %v", err)
+	}
+}
@@ -13,12 +13,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	sdkerrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
-	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
+	realizedstate "github.com/vmware/terraform-provider-nsxt/api/infra/realized_state"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 )
 
@@ -67,11 +67,20 @@ func getOrGenerateID(d *schema.ResourceData, m interface{}, presenceChecker func
 	return id, nil
 }
 
-func newUUID() string {
+func newRandomUUID() string {
 	uuid, _ := uuid.NewRandom()
 	return uuid.String()
 }
 
+func newUUID() string {
+	// Generated IDs are part of recorded API calls, hence in cassette mode
+	// they need to be recorded as well
+	if cassette, _ := getHTTPCassette(); cassette != nil {
+		return cassette.generateID(newRandomUUID)
+	}
+	return newRandomUUID()
+}
+
 func getPolicyTagsFromSet(tagSet *schema.Set) []model.Tag {
 	tags := tagSet.List()
 	var tagList []model.Tag
@@ -129,12 +138,33 @@ func setCustomizedPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag, s
 	}
 }
 
-func getPolicyTagsFromSchema(d *schema.ResourceData) []model.Tag {
-	return getCustomizedPolicyTagsFromSchema(d, "tag")
+func getPolicyTagsFromSchema(d *schema.ResourceData, m interface{}) []model.Tag {
+	tagList := make([]model.Tag, 0)
+	for _, tag := range getAllTagsFromSchema(d, m) {
+		tagScope := tag.Scope
+		tagTag := tag.Tag
+		tagList = append(tagList, model.Tag{Scope: &tagScope, Tag: &tagTag})
+	}
+	return tagList
 }
 
-func setPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag) {
-	setCustomizedPolicyTagsInSchema(d, tags, "tag")
+func getCommonTagsFromPolicyTags(tags []model.Tag) []common.Tag {
+	var tagList []common.Tag
+	for _, tag := range tags {
+		elem := common.Tag{}
+		if tag.Scope != nil {
+			elem.Scope = *tag.Scope
+		}
+		if tag.Tag != nil {
+			elem.Tag = *tag.Tag
+		}
+		tagList = append(tagList, elem)
+	}
+	return tagList
+}
+
+func setPolicyTagsInSchema(d *schema.ResourceData, tags []model.Tag, m interface{}) {
+	setAllTagsInSchema(d, getCommonTagsFromPolicyTags(tags), m)
 }
 
 func getPathListFromMap(data map[string]interface{}, attrName string) []string {
@@ -222,16 +252,9 @@ func nsxtPolicyPathResourceImporterHelper(d *schema.ResourceData, m interface{})
 		if strings.Contains(pathSegs[1], "infra") {
 			d.SetId(pathSegs[len(pathSegs)-1])
 		} else if pathSegs[1] == "orgs" && pathSegs[3] == "projects" {
-			if len(pathSegs) < 5 {
-				return nil, fmt.Errorf("invalid policy multitenancy path %s", importID)
+			if err := setPolicyContextFromPath(d, importID); err != nil {
+				return nil, err
 			}
-			// pathSegs[2] should contain the organization. Once we support multiple organization, it should be
-			// assigned into the context as well
-			contexts := make([]interface{}, 1)
-			ctxMap := make(map[string]interface{})
-			ctxMap["project_id"] = pathSegs[4]
-			contexts[0] = ctxMap
-			d.Set("context", contexts)
 			d.SetId(pathSegs[len(pathSegs)-1])
 		}
 		return []*schema.ResourceData{d}, nil
@@ -239,6 +262,41 @@ func nsxtPolicyPathResourceImporterHelper(d *schema.ResourceData, m interface{})
 	return []*schema.ResourceData{d}, ErrNotAPolicyPath
 }
 
+// Sets context of the resource for policy path of multitenancy object, such
+// as /orgs/default/projects/dev/infra/segments/app
+func setPolicyContextFromPath(d *schema.ResourceData, path string) error {
+	pathSegs := strings.Split(path, "/")
+	if len(pathSegs) < 4 || pathSegs[1] != "orgs" || pathSegs[3] != "projects" {
+		return nil
+	}
+	if len(pathSegs) < 5 {
+		return fmt.Errorf("invalid policy multitenancy path %s", path)
+	}
+	ctxMap := make(map[string]interface{})
+	ctxMap["project_id"] = pathSegs[4]
+	if pathSegs[2] != defaultOrgID {
+		ctxMap["org_id"] = pathSegs[2]
+	}
+	d.Set("context", []interface{}{ctxMap})
+	return nil
+}
+
+// Importer for objects outside of policy infra tree, such as AAA objects,
+// that accepts either ID or API path of the object, for example /aaa/roles/auditor
+func nsxtPolicyAPIPathResourceImporter(pathPrefix string) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if strings.HasPrefix(importID, "/") {
+			id := strings.TrimPrefix(importID, pathPrefix+"/")
+			if id == importID || id == "" || strings.Contains(id, "/") {
+				return nil, fmt.Errorf("invalid path %s, expected %s/<id>", importID, pathPrefix)
+			}
+			d.SetId(id)
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 func isPolicyPath(policyPath string) bool {
 	pathSegs := strings.Split(policyPath, "/")
 	if len(pathSegs) < 4 {
@@ -305,29 +363,34 @@ func commaSeparatedStringToStringList(commaString string) []string {
 	return strList
 }
 
-func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema.ResourceData, realizedEntityPath string) *resource.StateChangeConf {
-	client := realized_state.NewRealizedEntitiesClient(connector)
-	pendingStates := []string{"UNKNOWN", "UNREALIZED"}
-	targetStates := []string{"REALIZED", "ERROR"}
+// Polls realized entities of the intent path until all of them are either
+// realized or failed. Realized entities list is the result of the wait. Since
+// not every intent is realized on enforcement point, intent that reports no
+// realized entities within grace period is considered done as well.
+func nsxtPolicyWaitForRealizationStateConf(sessionContext utl.SessionContext, connector client.Connector, realizedEntityPath string, timeout time.Duration) *resource.StateChangeConf {
+	client := realizedstate.NewRealizedEntitiesClient(sessionContext, connector)
+	pendingStates := []string{policyRealizationStateUnknown, policyRealizationStateUnrealized}
+	targetStates := []string{policyRealizationStateRealized, policyRealizationStateError, policyRealizationStateNone}
+	start := time.Now()
 	stateConf := &resource.StateChangeConf{
 		Pending: pendingStates,
 		Target:  targetStates,
 		Refresh: func() (interface{}, string, error) {
-
+			if client == nil {
+				return nil, "", policyResourceNotSupportedError()
+			}
 			realizationResult, realizationError := client.List(realizedEntityPath, nil)
-			if realizationError == nil {
-				// Find the right entry
-				for _, objInList := range realizationResult.Results {
-					if objInList.State != nil {
-						return objInList, *objInList.State, nil
-					}
-				}
-				// Realization info not found yet
-				return nil, "UNKNOWN", nil
+			if realizationError != nil {
+				return nil, "", realizationError
+			}
+			state, _ := getPolicyRealizationState(realizationResult.Results)
+			if len(realizationResult.Results) == 0 && time.Since(start) >= policyRealizationGracePeriod {
+				log.Printf("[DEBUG] No realized entities reported for %s", realizedEntityPath)
+				state = policyRealizationStateNone
 			}
-			return nil, "", realizationError
+			return realizationResult, state, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
@@ -359,7 +422,7 @@ func convertModelBindingType(obj interface{}, sourceType bindings.BindingType, d
 }
 
 func retryUponPreconditionFailed(readAndUpdate func() error, maxRetryAttempts int) error {
-	// This retry specific to Precondition Error, and solution
+	// This retry specific to concurrent modification error, and solution
 	// here required refreshing the object, and updating revision
 	// in request body. This can not be solved with SDK-based retry
 	// functionality since it always retries with same request.
@@ -370,7 +433,7 @@ func retryUponPreconditionFailed(readAndUpdate func() error, maxRetryAttempts in
 			return nil
 		}
 
-		if _, ok := err.(sdkerrors.InvalidRequest); !ok {
+		if !isConcurrentModificationError(err) {
 			// other type of error
 			return err
 		}
@@ -381,6 +444,27 @@ func retryUponPreconditionFailed(readAndUpdate func() error, maxRetryAttempts in
 	return err
 }
 
+// Update of policy object that sends revision known from state. When NSX reports
+// concurrent modification, current revision of the object is read into state,
+// and the update is repeated, re-applying intended changes on top of current object.
+// Revisions of other objects in the request are expected to be read by update itself.
+func retryPolicyUpdateUponPreconditionFailed(d *schema.ResourceData, m interface{}, update func() error) error {
+	attempt := 0
+	readAndUpdate := func() error {
+		if attempt > 0 {
+			obj, err := newPolicyObjectClient(getPolicyConnector(m)).Get(d.Get("path").(string))
+			if err != nil {
+				return err
+			}
+			d.Set("revision", obj.Revision)
+		}
+		attempt++
+		return update()
+	}
+
+	return retryUponPreconditionFailed(readAndUpdate, getCommonProviderConfig(m).MaxRetries)
+}
+
 func getElemOrEmptyMapFromSchema(d *schema.ResourceData, key string) map[string]interface{} {
 	e := d.Get(key)
 	if e != nil {
@@ -0,0 +1,171 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func testMockRevisionConflict(t *testing.T, resource *schema.Resource, config map[string]interface{}) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	// Concurrent modification makes revision in state stale
+	server.lock.Lock()
+	path := d.Get("path").(string)
+	data := server.objects[path].data
+	data["description"] = "concurrent"
+	server.storeObject(path, data)
+	server.lock.Unlock()
+
+	// Update with stale revision is rejected, rather than overwriting concurrent change
+	d.Set("description", "updated")
+	if diags := resource.UpdateContext(context.Background(), d, m); !diags.HasError() {
+		t.Fatalf("Expected update with stale revision to fail")
+	}
+	server.lock.Lock()
+	description := server.objects[path].data["description"]
+	server.lock.Unlock()
+	if description != "concurrent" {
+		t.Fatalf("Expected concurrent change to be preserved, got description %v", description)
+	}
+}
+
+func TestMockNsxRevisionConflict(t *testing.T) {
+	testMockRevisionConflict(t, resourceNsxtPolicyIPBlock(), map[string]interface{}{
+		"display_name": "mock-conflict-block",
+		"cidr":         "10.10.0.0/16",
+	})
+}
+
+func TestMockNsxRevisionConflictRetry(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	resource := resourceNsxtPolicyTier1Gateway()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-conflict-tier1",
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+
+	// Concurrent modification makes revision in state stale
+	server.lock.Lock()
+	path := d.Get("path").(string)
+	data := server.objects[path].data
+	data["tags"] = []interface{}{map[string]interface{}{"scope": "owner", "tag": "concurrent"}}
+	server.storeObject(path, data)
+	revision := data["_revision"]
+	server.lock.Unlock()
+
+	// Update is repeated with current revision, re-applying intended changes
+	d.Set("description", "updated")
+	if diags := resource.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Expected update with stale revision to be retried, got %v", diags)
+	}
+	server.lock.Lock()
+	data = server.objects[path].data
+	server.lock.Unlock()
+	if data["description"] != "updated" || data["_revision"] == revision {
+		t.Fatalf("Expected intended change to be applied on top of current object, got %v", data)
+	}
+}
+
+func TestIsConcurrentModificationError(t *testing.T) {
+	newError := func(httpStatus string, errorCode int64) error {
+		vapiError := errors.NewInvalidRequest()
+		vapiError.Data = data.NewStructValue("", map[string]data.DataValue{
+			"httpStatus": data.NewStringValue(httpStatus),
+			"error_code": data.NewIntegerValue(errorCode),
+		})
+		return *vapiError
+	}
+
+	if !isConcurrentModificationError(newError("PRECONDITION_FAILED", 500090)) {
+		t.Errorf("Expected precondition failed status to be detected as concurrent modification")
+	}
+	if !isConcurrentModificationError(newError("BAD_REQUEST", 604)) {
+		t.Errorf("Expected error code 604 to be detected as concurrent modification")
+	}
+	if isConcurrentModificationError(newError("BAD_REQUEST", 500012)) {
+		t.Errorf("Expected other invalid request not to be detected as concurrent modification")
+	}
+}
+
+func TestMockNsxRetryUponConcurrentModification(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+	client := infra.NewIpBlocksClient(getPolicyConnector(m))
+
+	cidr := "10.20.0.0/16"
+	if err := client.Patch("retry-block", model.IpAddressBlock{Cidr: &cidr}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	staleBlock, err := client.Get("retry-block")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	concurrent := "concurrent"
+	if err := client.Patch("retry-block", model.IpAddressBlock{Description: &concurrent}); err != nil {
+		t.Fatalf("Concurrent update failed: %v", err)
+	}
+
+	// First attempt uses stale revision, next attempt re-reads the object
+	attempts := 0
+	err = retryUponPreconditionFailed(func() error {
+		attempts++
+		block := staleBlock
+		if attempts > 1 {
+			var err error
+			if block, err = client.Get("retry-block"); err != nil {
+				return err
+			}
+		}
+		displayName := "updated"
+		block.DisplayName = &displayName
+		_, err := client.Update("retry-block", block)
+		return err
+	}, 2)
+	if err != nil {
+		t.Fatalf("Update with retry failed: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected update to be retried once, got %d attempts", attempts)
+	}
+	block, err := client.Get("retry-block")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if *block.Description != concurrent || *block.DisplayName != "updated" {
+		t.Fatalf("Expected both concurrent and retried changes to be applied, got %s, %s", *block.Description, *block.DisplayName)
+	}
+
+	// Errors other than concurrent modification are not retried
+	server.denyAccess(mockPolicyPrefix + "/infra/ip-blocks/retry-block")
+	attempts = 0
+	err = retryUponPreconditionFailed(func() error {
+		attempts++
+		_, err := client.Update("retry-block", block)
+		return err
+	}, 2)
+	if err == nil || attempts != 1 {
+		t.Fatalf("Expected denied update to fail without retry, got %v after %d attempts", err, attempts)
+	}
+}
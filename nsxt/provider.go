@@ -4,13 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math/rand"
 	"net/http"
 	"net/http/httputil"
 	"os"
@@ -18,9 +18,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client/middleware/retry"
@@ -46,6 +48,10 @@ type commonProviderConfig struct {
 	Username               string
 	Password               string
 	LicenseKeys            []string
+	DefaultTags            []common.Tag
+	WaitForRealization     bool
+	ProjectID              string
+	OrgID                  string
 }
 
 type nsxtClients struct {
@@ -66,11 +72,18 @@ type nsxtClients struct {
 	Host                   string
 	PolicyEnforcementPoint string
 	PolicyGlobalManager    bool
+	NsxVersion             *nsxtVersionInfo
+	// Session shared by policy and MP clients, nil if session auth is disabled
+	Session *nsxtSession
+	// Manager failover state shared by policy and MP clients, nil if single host is configured
+	ManagerPool *nsxtManagerPool
+	// API rate and concurrency limits shared by policy and MP clients, nil if not configured
+	APIThrottle *nsxtAPIThrottle
 }
 
 // Provider for VMWare NSX-T
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 
 		Schema: map[string]*schema.Schema{
 			"allow_unverified_ssl": {
@@ -104,7 +117,7 @@ func Provider() *schema.Provider {
 				Optional:     true,
 				DefaultFunc:  schema.EnvDefaultFunc("NSXT_MANAGER_HOST", nil),
 				ValidateFunc: validateNsxtProviderHostFormat(),
-				Description:  "The hostname or IP address of the NSX manager.",
+				Description:  "The hostname or IP address of the NSX manager. Multiple managers can be specified as comma-separated list in order of preference",
 			},
 			"client_auth_cert_file": {
 				Type:        schema.TypeString,
@@ -139,6 +152,20 @@ func Provider() *schema.Provider {
 				Description: "Maximum delay in milliseconds between retries of a request",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_RETRY_MAX_DELAY", 500),
 			},
+			"api_rate_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of API requests per second to each NSX manager, 0 means no limit",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_API_RATE_LIMIT", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"api_max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of concurrent API requests to each NSX manager, 0 means no limit",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_API_MAX_CONCURRENCY", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"retry_on_status_codes": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -222,6 +249,34 @@ func Provider() *schema.Provider {
 				Description: "Avoid initializing NSX connection on startup",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_ON_DEMAND_CONNECTION", false),
 			},
+			"default_tags": getDefaultTagsSchema(),
+			"wait_for_realization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Wait for realization of policy resources on create and update, and fail on realization errors",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_WAIT_FOR_REALIZATION", false),
+			},
+			"context": {
+				Type:        schema.TypeList,
+				Description: "Default context for resources and data sources that support multitenancy",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:         schema.TypeString,
+							Description:  "Id of the project which resources and data sources belong to by default",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"org_id": {
+							Type:        schema.TypeString,
+							Description: "Id of the organization which the project belongs to",
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -258,6 +313,15 @@ func Provider() *schema.Provider {
 			"nsxt_policy_mac_discovery_profile":       dataSourceNsxtPolicyMacDiscoveryProfile(),
 			"nsxt_policy_vm":                          dataSourceNsxtPolicyVM(),
 			"nsxt_policy_vms":                         dataSourceNsxtPolicyVMs(),
+			"nsxt_policy_search":                      dataSourceNsxtPolicySearch(),
+			"nsxt_policy_groups":                      dataSourceNsxtPolicyGroups(),
+			"nsxt_policy_group_members":               dataSourceNsxtPolicyGroupMembers(),
+			"nsxt_policy_segments":                    dataSourceNsxtPolicySegments(),
+			"nsxt_policy_tier0_gateways":              dataSourceNsxtPolicyTier0Gateways(),
+			"nsxt_policy_tier1_gateways":              dataSourceNsxtPolicyTier1Gateways(),
+			"nsxt_policy_services":                    dataSourceNsxtPolicyServices(),
+			"nsxt_policy_security_policies":           dataSourceNsxtPolicySecurityPolicies(),
+			"nsxt_policy_transport_zones":             dataSourceNsxtPolicyTransportZones(),
 			"nsxt_policy_lb_app_profile":              dataSourceNsxtPolicyLBAppProfile(),
 			"nsxt_policy_lb_client_ssl_profile":       dataSourceNsxtPolicyLBClientSslProfile(),
 			"nsxt_policy_lb_server_ssl_profile":       dataSourceNsxtPolicyLBServerSslProfile(),
@@ -364,8 +428,10 @@ func Provider() *schema.Provider {
 			"nsxt_policy_group":                            resourceNsxtPolicyGroup(),
 			"nsxt_policy_domain":                           resourceNsxtPolicyDomain(),
 			"nsxt_policy_security_policy":                  resourceNsxtPolicySecurityPolicy(),
+			"nsxt_policy_security_policy_rule":             resourceNsxtPolicySecurityPolicyRule(),
 			"nsxt_policy_service":                          resourceNsxtPolicyService(),
 			"nsxt_policy_gateway_policy":                   resourceNsxtPolicyGatewayPolicy(),
+			"nsxt_policy_gateway_policy_rule":              resourceNsxtPolicyGatewayPolicyRule(),
 			"nsxt_policy_predefined_gateway_policy":        resourceNsxtPolicyPredefinedGatewayPolicy(),
 			"nsxt_policy_predefined_security_policy":       resourceNsxtPolicyPredefinedSecurityPolicy(),
 			"nsxt_policy_segment":                          resourceNsxtPolicySegment(),
@@ -374,9 +440,11 @@ func Provider() *schema.Provider {
 			"nsxt_policy_static_route":                     resourceNsxtPolicyStaticRoute(),
 			"nsxt_policy_gateway_prefix_list":              resourceNsxtPolicyGatewayPrefixList(),
 			"nsxt_policy_vm_tags":                          resourceNsxtPolicyVMTags(),
+			"nsxt_policy_object_tags":                      resourceNsxtPolicyObjectTags(),
 			"nsxt_policy_nat_rule":                         resourceNsxtPolicyNATRule(),
 			"nsxt_policy_ip_block":                         resourceNsxtPolicyIPBlock(),
 			"nsxt_policy_lb_pool":                          resourceNsxtPolicyLBPool(),
+			"nsxt_policy_lb_pool_member":                   resourceNsxtPolicyLBPoolMember(),
 			"nsxt_policy_ip_pool":                          resourceNsxtPolicyIPPool(),
 			"nsxt_policy_ip_pool_block_subnet":             resourceNsxtPolicyIPPoolBlockSubnet(),
 			"nsxt_policy_ip_pool_static_subnet":            resourceNsxtPolicyIPPoolStaticSubnet(),
@@ -441,8 +509,43 @@ func Provider() *schema.Provider {
 			"nsxt_policy_lb_http_application_profile":      resourceNsxtPolicyLBHttpApplicationProfile(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
+	}
+
+	for name, resource := range provider.ResourcesMap {
+		addDefaultTagsSupport(name, resource)
+		addRealizationSupport(name, resource)
+		addDeletionProtectionSupport(name, resource)
+		addPolicyImportSupport(name, resource)
 	}
+
+	return provider
+}
+
+func configureManagerPool(d *schema.ResourceData, clients *nsxtClients) error {
+	hosts := getProviderHosts(d)
+	if len(hosts) < 2 {
+		return nil
+	}
+
+	tlsConfig, err := getConnectorTLSConfig(d)
+	if err != nil {
+		return err
+	}
+	tr, err := newCassetteTransport(&http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+	})
+	if err != nil {
+		return err
+	}
+	clients.ManagerPool = newNsxtManagerPool(hosts, tr)
+
+	if d.Get("on_demand_connection").(bool) {
+		// health probe will happen on demand
+		return nil
+	}
+	return clients.ManagerPool.selectHealthyHost()
 }
 
 func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
@@ -495,13 +598,11 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		}
 	}
 
-	host := d.Get("host").(string)
-	// Remove schema
-	host = strings.TrimPrefix(host, "https://")
-
-	if host == "" {
+	hosts := getProviderHosts(d)
+	if len(hosts) == 0 {
 		return fmt.Errorf("host must be provided")
 	}
+	host := hosts[0]
 
 	caFile := d.Get("ca_file").(string)
 	caString := d.Get("ca").(string)
@@ -534,11 +635,57 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		SkipSessionAuth:      skipSessionAuth,
 	}
 
+	cassette, err := getHTTPCassette()
+	if err != nil {
+		return err
+	}
+
+	if sessionAuth || clients.ManagerPool != nil || clients.APIThrottle != nil || cassette != nil {
+		err := api.InitHttpClient(clients.NsxtClientConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cassette != nil {
+		transport := clients.NsxtClientConfig.HTTPClient.Transport
+		clients.NsxtClientConfig.HTTPClient.Transport, err = newCassetteTransport(transport)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Session is re-created over the unthrottled transport, since the request
+	// that triggers re-creation may still hold a concurrency slot
+	baseTransport := clients.NsxtClientConfig.HTTPClient.Transport
+	if clients.APIThrottle != nil {
+		transport := clients.NsxtClientConfig.HTTPClient.Transport
+		clients.NsxtClientConfig.HTTPClient.Transport = newAPIThrottleTransport(transport, clients.APIThrottle)
+	}
+
+	if sessionAuth {
+		// Session support for policy and MP resources (main rationale - vIDM environment where auth is slow)
+		// Initial session is created by MP sdk, and re-created by session transport when expired
+		transport := clients.NsxtClientConfig.HTTPClient.Transport
+		clients.Session = newNsxtSession(username, password, clients.CommonConfig.RemoteAuth, baseTransport)
+		clients.NsxtClientConfig.HTTPClient.Transport = newSessionAuthTransport(transport, clients.Session)
+	}
+
+	if clients.ManagerPool != nil {
+		transport := clients.NsxtClientConfig.HTTPClient.Transport
+		clients.NsxtClientConfig.HTTPClient.Transport = newManagerFailoverTransport(transport, clients.ManagerPool)
+	}
+
 	nsxClient, err := api.NewAPIClient(clients.NsxtClientConfig)
 	if err != nil {
 		return err
 	}
 
+	if clients.Session != nil {
+		defaultHeader := clients.NsxtClientConfig.DefaultHeader
+		clients.Session.setHeaders(defaultHeader["Cookie"], defaultHeader["X-XSRF-TOKEN"])
+	}
+
 	clients.NsxtClient = nsxClient
 
 	return nil
@@ -651,7 +798,6 @@ func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
 
 func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients) error {
 	onDemandConn := d.Get("on_demand_connection").(bool)
-	host := d.Get("host").(string)
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
 	vmcAccessToken := d.Get("vmc_token").(string)
@@ -671,13 +817,15 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		}
 	}
 
-	if host == "" {
-		return fmt.Errorf("host must be provided")
+	if policyGlobalManager && clients.CommonConfig.ProjectID != "" {
+		return fmt.Errorf("context is not supported with global manager")
 	}
 
-	if !strings.HasPrefix(host, "https://") {
-		host = fmt.Sprintf("https://%s", host)
+	hosts := getProviderHosts(d)
+	if len(hosts) == 0 {
+		return fmt.Errorf("host must be provided")
 	}
+	host := fmt.Sprintf("https://%s", hosts[0])
 
 	securityContextNeeded := true
 	if clientAuthDefined && !clients.CommonConfig.RemoteAuth {
@@ -696,12 +844,24 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		return err
 	}
 
-	tr := &http.Transport{
+	tr, err := newCassetteTransport(&http.Transport{
 		Proxy:           http.ProxyFromEnvironment,
 		TLSClientConfig: tlsConfig,
+	})
+	if err != nil {
+		return err
 	}
 
 	httpClient := http.Client{Transport: tr}
+	if clients.APIThrottle != nil {
+		httpClient.Transport = newAPIThrottleTransport(httpClient.Transport, clients.APIThrottle)
+	}
+	if clients.Session != nil {
+		httpClient.Transport = newSessionAuthTransport(httpClient.Transport, clients.Session)
+	}
+	if clients.ManagerPool != nil {
+		httpClient.Transport = newManagerFailoverTransport(httpClient.Transport, clients.ManagerPool)
+	}
 	clients.PolicyHTTPClient = &httpClient
 	clients.Host = host
 	clients.PolicyEnforcementPoint = policyEnforcementPoint
@@ -719,7 +879,7 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		}
 	}
 
-	err = initNSXVersion(getPolicyConnectorForInit(*clients, true))
+	err = initNSXVersion(*clients, getPolicyConnectorForInit(*clients, true))
 	if err != nil && isVMC {
 		// In case version API does not work for VMC, we workaround by testing version-specific APIs
 		// TODO - remove this when /node/version API works for all auth methods on VMC
@@ -806,12 +966,8 @@ func (processor logRequestProcessor) Process(req *http.Request) error {
 		log.Fatal(err)
 	}
 
-	// Replace sensitive information in HTTP headers
-	authHeaderRegexp := regexp.MustCompile(`(?i)Authorization:.*`)
-	cspHeaderRegexp := regexp.MustCompile(`(?i)Csp-Auth-Token:.*`)
-	replaced := authHeaderRegexp.ReplaceAllString(string(reqDump), "<Omitted Authorization header>")
-	replaced = cspHeaderRegexp.ReplaceAllString(replaced, "<Omitted Csp-Auth-Token header>")
-
+	// Replace sensitive information in HTTP headers and body
+	replaced := redactHTTPDump(reqDump, req.Header)
 	log.Printf("Issuing request towards NSX:\n%s", replaced)
 	return nil
 }
@@ -828,7 +984,7 @@ func (processor logResponseAcceptor) Accept(req *http.Response) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	log.Printf("Received NSX response:\n%s", dumpResponse)
+	log.Printf("Received NSX response:\n%s", redactHTTPDump(dumpResponse, req.Header))
 }
 
 type bearerAuthHeaderProcessor struct {
@@ -845,24 +1001,6 @@ func (processor bearerAuthHeaderProcessor) Process(req *http.Request) error {
 	return nil
 }
 
-type sessionHeaderProcessor struct {
-	cookie string
-	xsrf   string
-}
-
-func newSessionHeaderProcessor(cookie string, xsrf string) *sessionHeaderProcessor {
-	return &sessionHeaderProcessor{
-		cookie: cookie,
-		xsrf:   xsrf,
-	}
-}
-
-func (processor sessionHeaderProcessor) Process(req *http.Request) error {
-	req.Header.Set("Cookie", processor.cookie)
-	req.Header.Set("X-XSRF-TOKEN", processor.xsrf)
-	return nil
-}
-
 func getLicenses(connector client.Connector) ([]string, error) {
 	var licenseList []string
 	client := nsx.NewLicensesClient(connector)
@@ -939,6 +1077,10 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 	}
 
 	licenses := interfaceListToStringList(d.Get("license_keys").([]interface{}))
+	defaultTags := getTagsFromSetList(d.Get("default_tags").(*schema.Set).List())
+	waitForRealization := d.Get("wait_for_realization").(bool)
+	projectID := getProjectIDFromSchema(d)
+	orgID := getOrgIDFromSchema(d)
 	return commonProviderConfig{
 		RemoteAuth:             remoteAuth,
 		ToleratePartialSuccess: toleratePartialSuccess,
@@ -949,23 +1091,35 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		Username:               username,
 		Password:               password,
 		LicenseKeys:            licenses,
+		DefaultTags:            defaultTags,
+		WaitForRealization:     waitForRealization,
+		ProjectID:              projectID,
+		OrgID:                  orgID,
 	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	commonConfig := initCommonConfig(d)
 	clients := nsxtClients{
 		CommonConfig: commonConfig,
+		NsxVersion:   &nsxtVersionInfo{},
+	}
+
+	configureAPIThrottle(d, &clients)
+
+	err := configureManagerPool(d, &clients)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
-	err := configureNsxtClient(d, &clients)
+	err = configureNsxtClient(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 
 	err = configurePolicyConnectorData(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 
 	return clients, nil
@@ -1000,13 +1154,19 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 		if !shouldRetry {
 			return false
 		}
+		if retryContext.Attempt >= uint(c.CommonConfig.MaxRetries) {
+			// No retries left, no point in waiting
+			return false
+		}
 
-		min := c.CommonConfig.MinRetryInterval
-		max := c.CommonConfig.MaxRetryInterval
-		if max > 0 {
-			interval := (rand.Intn(max-min) + min)
-			time.Sleep(time.Duration(interval) * time.Millisecond)
-			log.Printf("[DEBUG]: Waited %d ms before retrying", interval)
+		interval := getRetryBackoffInterval(retryContext.Attempt, c.CommonConfig.MinRetryInterval, c.CommonConfig.MaxRetryInterval)
+		if retryAfter, ok := getRetryAfterInterval(retryContext.Response); ok && retryAfter > interval {
+			log.Printf("[DEBUG]: Server requested to retry after %v", retryAfter)
+			interval = retryAfter
+		}
+		if interval > 0 {
+			time.Sleep(interval)
+			log.Printf("[DEBUG]: Waited %v before retrying", interval)
 		}
 
 		return true
@@ -1033,20 +1193,6 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 		requestProcessors = append(requestProcessors, newCustomHeaderProcessor(customHeaders).Process)
 	}
 
-	// Session support for policy resources (main rationale - vIDM environment where auth is slow)
-	// Currently session creation is done via old MP sdk.
-	// TODO - when MP resources are removed, switch to official SDK to initiate session/create API
-	// TODO - re-trigger session/create when token is expired
-	if c.NsxtClientConfig != nil && len(c.NsxtClientConfig.DefaultHeader["Cookie"]) > 0 {
-		cookie := c.NsxtClientConfig.DefaultHeader["Cookie"]
-		xsrf := ""
-		if len(c.NsxtClientConfig.DefaultHeader["X-XSRF-TOKEN"]) > 0 {
-			xsrf = c.NsxtClientConfig.DefaultHeader["X-XSRF-TOKEN"]
-		}
-		requestProcessors = append(requestProcessors, newSessionHeaderProcessor(cookie, xsrf).Process)
-		log.Printf("[INFO]: Session headers configured for policy objects")
-	}
-
 	if os.Getenv("TF_LOG_PROVIDER_NSX_HTTP") != "" {
 		requestProcessors = append(requestProcessors, newLogRequestProcessor().Process)
 		responseAcceptors = append(responseAcceptors, newLogResponseAcceptor().Accept)
@@ -1061,8 +1207,8 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 	connector := client.NewConnector(c.Host, connectorOptions...)
 	// Init NSX version on demand if not done yet
 	// This is also our indication to apply licenses, in case of delayed connection
-	if nsxVersion == "" && !initFlow {
-		initNSXVersion(connector)
+	if getProviderNSXVersion(c) == "" && !initFlow {
+		initNSXVersion(c, connector)
 		err := configureLicenses(connector, c.CommonConfig.LicenseKeys)
 		if err != nil {
 			log.Printf("[ERROR]: Failed to apply NSX licenses")
@@ -1100,9 +1246,29 @@ func getProjectIDFromSchema(d *schema.ResourceData) string {
 	return ""
 }
 
+func getOrgIDFromSchema(d *schema.ResourceData) string {
+	ctxPtr := d.Get("context")
+	if ctxPtr != nil {
+		contexts := ctxPtr.([]interface{})
+		for _, context := range contexts {
+			data := context.(map[string]interface{})
+
+			return data["org_id"].(string)
+		}
+	}
+	return ""
+}
+
 func getSessionContext(d *schema.ResourceData, m interface{}) tf_api.SessionContext {
 	var clientType tf_api.ClientType
 	projectID := getProjectIDFromSchema(d)
+	orgID := getOrgIDFromSchema(d)
+	if projectID == "" && d.Get("context") != nil {
+		// Resource supports context, but does not specify one - fall back
+		// to provider default, if configured
+		projectID = getCommonProviderConfig(m).ProjectID
+		orgID = getCommonProviderConfig(m).OrgID
+	}
 	if projectID != "" {
 		clientType = tf_api.Multitenancy
 	} else if isPolicyGlobalManager(m) {
@@ -1110,5 +1276,5 @@ func getSessionContext(d *schema.ResourceData, m interface{}) tf_api.SessionCont
 	} else {
 		clientType = tf_api.Local
 	}
-	return tf_api.SessionContext{ProjectID: projectID, ClientType: clientType}
+	return tf_api.SessionContext{ProjectID: projectID, OrgID: orgID, ClientType: clientType}
 }
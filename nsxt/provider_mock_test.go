@@ -0,0 +1,166 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Helpers for tests that run against the mock NSX server and do not require
+// NSX endpoint or terraform binary. Mock tests are located next to the code
+// they cover, and are named TestMockNsx*
+
+func testMockGetProviderMeta(t *testing.T, server *mockNsxServer) interface{} {
+	return testMockGetProviderMetaWithHost(t, server.Host())
+}
+
+func testMockGetProviderMetaWithHost(t *testing.T, host string) interface{} {
+	return testMockGetProviderMetaWithConfig(t, host, nil)
+}
+
+func testMockGetProviderMetaWithConfig(t *testing.T, host string, extraConfig map[string]interface{}) interface{} {
+	provider := Provider()
+	config := map[string]interface{}{
+		"host":                 host,
+		"username":             mockNsxUsername,
+		"password":             mockNsxPassword,
+		"allow_unverified_ssl": true,
+	}
+	for key, value := range extraConfig {
+		config[key] = value
+	}
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider against mock server: %v", diags)
+	}
+	return provider.Meta()
+}
+
+func testMockResourceLifecycle(t *testing.T, resource *schema.Resource, create map[string]interface{}, update map[string]interface{}) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, create)
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	id := d.Id()
+	if id == "" {
+		t.Fatalf("Resource ID is not set after create")
+	}
+	if d.Get("path").(string) == "" {
+		t.Fatalf("Resource path is not set after create")
+	}
+	revision := d.Get("revision").(int)
+
+	for key, value := range update {
+		if err := d.Set(key, value); err != nil {
+			t.Fatalf("Failed to set %s: %v", key, err)
+		}
+	}
+	if diags := resource.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	for key, value := range update {
+		if d.Get(key) != value {
+			t.Fatalf("Expected %s to be %v after update, got %v", key, value, d.Get(key))
+		}
+	}
+	if d.Get("revision").(int) <= revision {
+		t.Fatalf("Expected revision to grow after update, got %d", d.Get("revision").(int))
+	}
+
+	if diags := resource.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+	if diags := resource.ReadContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Read after delete failed: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Resource %s still exists after delete", id)
+	}
+}
+
+func TestMockNsxVersionPerProvider(t *testing.T) {
+	server1 := newMockNsxServer()
+	defer server1.Close()
+	server2 := newMockNsxServer()
+	server2.Version = "3.2.0.0.0"
+	defer server2.Close()
+
+	m1 := testMockGetProviderMeta(t, server1)
+	m2 := testMockGetProviderMeta(t, server2)
+	if !nsxVersionHigherOrEqual(m1, "4.0.0") {
+		t.Fatalf("Expected version %s for first provider, got %s", server1.Version, getProviderNSXVersion(m1))
+	}
+	if !nsxVersionLower(m2, "4.0.0") {
+		t.Fatalf("Expected version %s for second provider, got %s", server2.Version, getProviderNSXVersion(m2))
+	}
+}
+
+func TestMockNsxProviderDefaultContext(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMetaWithConfig(t, server.Host(), map[string]interface{}{
+		"context": []interface{}{
+			map[string]interface{}{"project_id": "dev"},
+		},
+	})
+
+	resource := resourceNsxtPolicyGroup()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-project-group",
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	expectedPrefix := "/orgs/default/projects/dev/infra/"
+	if path := d.Get("path").(string); !strings.HasPrefix(path, expectedPrefix) {
+		t.Fatalf("Expected group to be created under provider default project, got path %s", path)
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-override-group",
+		"context": []interface{}{
+			map[string]interface{}{"project_id": "prod"},
+		},
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	expectedPrefix = "/orgs/default/projects/prod/infra/"
+	if path := d.Get("path").(string); !strings.HasPrefix(path, expectedPrefix) {
+		t.Fatalf("Expected resource context to override provider default, got path %s", path)
+	}
+}
+
+func TestMockNsxContextOrg(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	resource := resourceNsxtPolicySegment()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-org-segment",
+		"context": []interface{}{
+			map[string]interface{}{"project_id": "dev", "org_id": "tenant1"},
+		},
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	expectedPrefix := "/orgs/tenant1/projects/dev/infra/segments/"
+	if path := d.Get("path").(string); !strings.HasPrefix(path, expectedPrefix) {
+		t.Fatalf("Expected segment to be created in organization tenant1, got path %s", path)
+	}
+	if diags := resource.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+}
@@ -32,6 +32,18 @@ func init() {
 	}
 }
 
+func TestMain(m *testing.M) {
+	if testAccIsMockServer() {
+		// Run the tests against in-process mock NSX server
+		server := newMockNsxServer()
+		server.setTestEnvironment()
+		code := m.Run()
+		server.Close()
+		os.Exit(code)
+	}
+	os.Exit(m.Run())
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -85,46 +97,77 @@ func testAccGetClient() (*api.APIClient, error) {
 		Insecure:   insecure,
 	}
 
+	// Test clients go through cassette as well, if configured
+	if err := api.InitHttpClient(&cfg); err != nil {
+		return nil, err
+	}
+	transport, err := newCassetteTransport(cfg.HTTPClient.Transport)
+	if err != nil {
+		return nil, err
+	}
+	cfg.HTTPClient.Transport = transport
+
 	return api.NewAPIClient(&cfg)
 }
 
-func testAccNSXVersion(t *testing.T, requiredVersion string) {
-	if nsxVersion == "" {
-		connector, err := testAccGetPolicyConnector()
-		if err != nil {
-			t.Errorf("Failed to get policy connector")
-			return
-		}
+// NSX version for test configuration and skip logic, discovered once per test run
+var testAccNsxVersion = ""
 
-		err = initNSXVersion(connector)
-		if err != nil {
-			t.Errorf("Failed to retrieve NSX version")
-			return
-		}
+func testAccInitNSXVersion(t *testing.T) bool {
+	if testAccNsxVersion != "" {
+		return true
+	}
+
+	if os.Getenv("TF_ACC") == "" {
+		// Acceptance tests will be skipped anyway
+		return false
+	}
+
+	connector, err := testAccGetPolicyConnector()
+	if err != nil {
+		t.Errorf("Failed to get policy connector")
+		return false
 	}
 
-	if nsxVersionLower(requiredVersion) {
-		t.Skipf("This test can only run in NSX %s or above (Current version %s)", requiredVersion, nsxVersion)
+	testAccNsxVersion, err = getNSXVersion(connector)
+	if err != nil {
+		t.Errorf("Failed to retrieve NSX version")
+		return false
 	}
+	return true
 }
 
-func testAccNSXVersionLessThan(t *testing.T, requiredVersion string) {
-	if nsxVersion == "" {
-		connector, err := testAccGetPolicyConnector()
-		if err != nil {
-			t.Errorf("Failed to get policy connector")
-			return
-		}
+func testAccNSXVersionLower(t *testing.T, requiredVersion string) bool {
+	if !testAccInitNSXVersion(t) {
+		return false
+	}
+	return versionLower(testAccNsxVersion, requiredVersion)
+}
 
-		err = initNSXVersion(connector)
-		if err != nil {
-			t.Errorf("Failed to retrieve NSX version")
-			return
-		}
+func testAccNSXVersionHigherOrEqual(t *testing.T, requiredVersion string) bool {
+	if !testAccInitNSXVersion(t) {
+		return false
+	}
+	return versionHigherOrEqual(testAccNsxVersion, requiredVersion)
+}
+
+func testAccNSXVersion(t *testing.T, requiredVersion string) {
+	if !testAccInitNSXVersion(t) {
+		return
 	}
 
-	if nsxVersionHigherOrEqual(requiredVersion) {
-		t.Skipf("This test can only run in NSX below %s (Current version %s)", requiredVersion, nsxVersion)
+	if versionLower(testAccNsxVersion, requiredVersion) {
+		t.Skipf("This test can only run in NSX %s or above (Current version %s)", requiredVersion, testAccNsxVersion)
+	}
+}
+
+func testAccNSXVersionLessThan(t *testing.T, requiredVersion string) {
+	if !testAccInitNSXVersion(t) {
+		return
+	}
+
+	if versionHigherOrEqual(testAccNsxVersion, requiredVersion) {
+		t.Skipf("This test can only run in NSX below %s (Current version %s)", requiredVersion, testAccNsxVersion)
 	}
 }
 
@@ -155,9 +198,12 @@ func testAccGetPolicyConnector() (client.Connector, error) {
 	securityCtx.SetProperty(security.USER_KEY, username)
 	securityCtx.SetProperty(security.PASSWORD_KEY, password)
 
-	tr := &http.Transport{
+	tr, err := newCassetteTransport(&http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
 		Proxy:           http.ProxyFromEnvironment,
+	})
+	if err != nil {
+		return nil, err
 	}
 	httpClient := http.Client{Transport: tr}
 	connector := client.NewConnector(host, client.UsingRest(nil), client.WithHttpClient(&httpClient), client.WithSecurityContext(securityCtx))
@@ -4,8 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
@@ -23,10 +25,10 @@ var DefaultIPv6VirtualAddress = "::"
 
 func resourceNsxtClusterVirualIP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtClusterVirualIPCreate,
-		Read:   resourceNsxtClusterVirualIPRead,
-		Update: resourceNsxtClusterVirualIPUpdate,
-		Delete: resourceNsxtClusterVirualIPDelete,
+		CreateContext: resourceNsxtClusterVirualIPCreate,
+		ReadContext:   resourceNsxtClusterVirualIPRead,
+		UpdateContext: resourceNsxtClusterVirualIPUpdate,
+		DeleteContext: resourceNsxtClusterVirualIPDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -56,7 +58,7 @@ func resourceNsxtClusterVirualIP() *schema.Resource {
 	}
 }
 
-func resourceNsxtClusterVirualIPCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtClusterVirualIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Create and update workflow are mostly the same for virtual IP resource
 	// except that create workflow sets the ID of this resource
 	id := d.Id()
@@ -66,18 +68,18 @@ func resourceNsxtClusterVirualIPCreate(d *schema.ResourceData, m interface{}) er
 	d.SetId(id)
 	err := setClusterVirtualIP(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceNsxtClusterVirualIPRead(d, m)
+	return resourceNsxtClusterVirualIPRead(ctx, d, m)
 }
 
-func resourceNsxtClusterVirualIPRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtClusterVirualIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := cluster.NewApiVirtualIpClient(connector)
 
 	obj, err := client.Get()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// For some reason the Get() function of ApiVirtulIPClient will only return ip address information
@@ -107,24 +109,24 @@ func setClusterVirtualIP(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtClusterVirualIPUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtClusterVirualIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	err := setClusterVirtualIP(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceNsxtClusterVirualIPRead(d, m)
+	return resourceNsxtClusterVirualIPRead(ctx, d, m)
 }
 
-func resourceNsxtClusterVirualIPDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtClusterVirualIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := cluster.NewApiVirtualIpClient(connector)
 	_, err := client.Clearvirtualip()
 	if err != nil {
-		return fmt.Errorf("Failed to clear cluster virtual IPv4 address: %s", err)
+		return diag.Errorf("Failed to clear cluster virtual IPv4 address: %s", err)
 	}
 	_, err = client.Clearvirtualip6()
 	if err != nil {
-		return fmt.Errorf("Failed to clear cluster virtual IPv6 address: %s", err)
+		return diag.Errorf("Failed to clear cluster virtual IPv6 address: %s", err)
 	}
 	return nil
 }
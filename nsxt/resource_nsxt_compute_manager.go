@@ -4,10 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -23,10 +24,10 @@ var accessLevelForOidcValues = []string{
 
 func resourceNsxtComputeManager() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtComputeManagerCreate,
-		Read:   resourceNsxtComputeManagerRead,
-		Update: resourceNsxtComputeManagerUpdate,
-		Delete: resourceNsxtComputeManagerDelete,
+		CreateContext: resourceNsxtComputeManagerCreate,
+		ReadContext:   resourceNsxtComputeManagerRead,
+		UpdateContext: resourceNsxtComputeManagerUpdate,
+		DeleteContext: resourceNsxtComputeManagerDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -215,13 +216,13 @@ func resourceNsxtComputeManager() *schema.Resource {
 	}
 }
 
-func resourceNsxtComputeManagerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtComputeManagerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := fabric.NewComputeManagersClient(connector)
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 
 	var accessLevelForOidc *string
 	alfo := d.Get("access_level_for_oidc").(string)
@@ -240,7 +241,7 @@ func resourceNsxtComputeManagerCreate(d *schema.ResourceData, m interface{}) err
 	setAsOidcProvider := d.Get("set_as_oidc_provider").(bool)
 	credential, err := getCredentialValues(d)
 	if err != nil {
-		return handleCreateError("ComputeManager", displayName, err)
+		return diag.FromErr(handleCreateError("ComputeManager", displayName, err))
 	}
 
 	obj := model.ComputeManager{
@@ -261,11 +262,11 @@ func resourceNsxtComputeManagerCreate(d *schema.ResourceData, m interface{}) err
 	log.Printf("[INFO] Creating Compute Manager %s", displayName)
 	obj, err = client.Create(obj)
 	if err != nil {
-		return handleCreateError("Compute Manager", displayName, err)
+		return diag.FromErr(handleCreateError("Compute Manager", displayName, err))
 	}
 
 	d.SetId(*obj.Id)
-	return resourceNsxtComputeManagerRead(d, m)
+	return resourceNsxtComputeManagerRead(ctx, d, m)
 }
 
 func getCredentialData(data map[string]interface{}) (string, map[string]interface{}) {
@@ -364,24 +365,24 @@ func getExtensionCertificate(d *schema.ResourceData) *model.CertificateData {
 	return nil
 }
 
-func resourceNsxtComputeManagerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtComputeManagerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := fabric.NewComputeManagersClient(connector)
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "ComputeManager", id, err)
+		return diag.FromErr(handleReadError(d, "ComputeManager", id, err))
 	}
 
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 
 	d.Set("access_level_for_oidc", obj.AccessLevelForOidc)
 	d.Set("create_service_account", obj.CreateServiceAccount)
@@ -467,18 +468,18 @@ func setCredentialValuesInSchema(d *schema.ResourceData, credential *data.Struct
 	return nil
 }
 
-func resourceNsxtComputeManagerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtComputeManagerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := fabric.NewComputeManagersClient(connector)
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	var accessLevelForOidc *string
 	alfo := d.Get("access_level_for_oidc").(string)
 	if alfo != "" {
@@ -496,7 +497,7 @@ func resourceNsxtComputeManagerUpdate(d *schema.ResourceData, m interface{}) err
 	setAsOidcProvider := d.Get("set_as_oidc_provider").(bool)
 	credential, err := getCredentialValues(d)
 	if err != nil {
-		return handleUpdateError("ComputeManager", id, err)
+		return diag.FromErr(handleUpdateError("ComputeManager", id, err))
 	}
 
 	obj := model.ComputeManager{
@@ -516,25 +517,25 @@ func resourceNsxtComputeManagerUpdate(d *schema.ResourceData, m interface{}) err
 
 	_, err = client.Update(id, obj)
 	if err != nil {
-		return handleUpdateError("ComputeManager", id, err)
+		return diag.FromErr(handleUpdateError("ComputeManager", id, err))
 	}
 
-	return resourceNsxtComputeManagerRead(d, m)
+	return resourceNsxtComputeManagerRead(ctx, d, m)
 }
 
-func resourceNsxtComputeManagerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtComputeManagerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := fabric.NewComputeManagersClient(connector)
 
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("ComputeManager", id, err)
+		return diag.FromErr(handleDeleteError("ComputeManager", id, err))
 	}
 	return nil
 }
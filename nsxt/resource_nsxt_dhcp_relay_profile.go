@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtDhcpRelayProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpRelayProfileCreate,
-		Read:   resourceNsxtDhcpRelayProfileRead,
-		Update: resourceNsxtDhcpRelayProfileUpdate,
-		Delete: resourceNsxtDhcpRelayProfileDelete,
+		CreateContext: resourceNsxtDhcpRelayProfileCreate,
+		ReadContext:   resourceNsxtDhcpRelayProfileRead,
+		UpdateContext: resourceNsxtDhcpRelayProfileUpdate,
+		DeleteContext: resourceNsxtDhcpRelayProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -49,15 +50,15 @@ func resourceNsxtDhcpRelayProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtDhcpRelayProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	serverAddresses := getStringListFromSchemaSet(d, "server_addresses")
 	dhcpRelayProfile := manager.DhcpRelayProfile{
 		Description:     description,
@@ -69,26 +70,26 @@ func resourceNsxtDhcpRelayProfileCreate(d *schema.ResourceData, m interface{}) e
 	dhcpRelayProfile, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateDhcpRelayProfile(nsxClient.Context, dhcpRelayProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayProfile create: %v", err)
+		return diag.Errorf("Error during DhcpRelayProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during DhcpRelayProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during DhcpRelayProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(dhcpRelayProfile.Id)
 
-	return resourceNsxtDhcpRelayProfileRead(d, m)
+	return resourceNsxtDhcpRelayProfileRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpRelayProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay profile id")
+		return diag.Errorf("Error obtaining dhcp relay profile id")
 	}
 
 	dhcpRelayProfile, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadDhcpRelayProfile(nsxClient.Context, id)
@@ -98,33 +99,33 @@ func resourceNsxtDhcpRelayProfileRead(d *schema.ResourceData, m interface{}) err
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayProfile read: %v", err)
+		return diag.Errorf("Error during DhcpRelayProfile read: %v", err)
 	}
 
 	d.Set("revision", dhcpRelayProfile.Revision)
 	d.Set("description", dhcpRelayProfile.Description)
 	d.Set("display_name", dhcpRelayProfile.DisplayName)
-	setTagsInSchema(d, dhcpRelayProfile.Tags)
+	setTagsInSchema(d, dhcpRelayProfile.Tags, m)
 	d.Set("server_addresses", dhcpRelayProfile.ServerAddresses)
 
 	return nil
 }
 
-func resourceNsxtDhcpRelayProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay profile id")
+		return diag.Errorf("Error obtaining dhcp relay profile id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	serverAddresses := interface2StringList(d.Get("server_addresses").(*schema.Set).List())
 	dhcpRelayProfile := manager.DhcpRelayProfile{
 		Revision:        revision,
@@ -137,26 +138,26 @@ func resourceNsxtDhcpRelayProfileUpdate(d *schema.ResourceData, m interface{}) e
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateDhcpRelayProfile(nsxClient.Context, id, dhcpRelayProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during DhcpRelayProfile update: %v", err)
+		return diag.Errorf("Error during DhcpRelayProfile update: %v", err)
 	}
 
-	return resourceNsxtDhcpRelayProfileRead(d, m)
+	return resourceNsxtDhcpRelayProfileRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpRelayProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay profile id")
+		return diag.Errorf("Error obtaining dhcp relay profile id")
 	}
 
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteDhcpRelayProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayProfile delete: %v", err)
+		return diag.Errorf("Error during DhcpRelayProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
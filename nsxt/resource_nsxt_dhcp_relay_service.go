@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtDhcpRelayService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpRelayServiceCreate,
-		Read:   resourceNsxtDhcpRelayServiceRead,
-		Update: resourceNsxtDhcpRelayServiceUpdate,
-		Delete: resourceNsxtDhcpRelayServiceDelete,
+		CreateContext: resourceNsxtDhcpRelayServiceCreate,
+		ReadContext:   resourceNsxtDhcpRelayServiceRead,
+		UpdateContext: resourceNsxtDhcpRelayServiceUpdate,
+		DeleteContext: resourceNsxtDhcpRelayServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -45,15 +46,15 @@ func resourceNsxtDhcpRelayService() *schema.Resource {
 	}
 }
 
-func resourceNsxtDhcpRelayServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpRelayProfileID := d.Get("dhcp_relay_profile_id").(string)
 	dhcpRelayService := manager.DhcpRelayService{
 		Description:        description,
@@ -65,26 +66,26 @@ func resourceNsxtDhcpRelayServiceCreate(d *schema.ResourceData, m interface{}) e
 	dhcpRelayService, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateDhcpRelay(nsxClient.Context, dhcpRelayService)
 
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayService create: %v", err)
+		return diag.Errorf("Error during DhcpRelayService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during DhcpRelayService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during DhcpRelayService create: %v", resp.StatusCode)
 	}
 	d.SetId(dhcpRelayService.Id)
 
-	return resourceNsxtDhcpRelayServiceRead(d, m)
+	return resourceNsxtDhcpRelayServiceRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpRelayServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay service id")
+		return diag.Errorf("Error obtaining dhcp relay service id")
 	}
 
 	dhcpRelayService, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadDhcpRelay(nsxClient.Context, id)
@@ -94,33 +95,33 @@ func resourceNsxtDhcpRelayServiceRead(d *schema.ResourceData, m interface{}) err
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayService read: %v", err)
+		return diag.Errorf("Error during DhcpRelayService read: %v", err)
 	}
 
 	d.Set("revision", dhcpRelayService.Revision)
 	d.Set("description", dhcpRelayService.Description)
 	d.Set("display_name", dhcpRelayService.DisplayName)
-	setTagsInSchema(d, dhcpRelayService.Tags)
+	setTagsInSchema(d, dhcpRelayService.Tags, m)
 	d.Set("dhcp_relay_profile_id", dhcpRelayService.DhcpRelayProfileId)
 
 	return nil
 }
 
-func resourceNsxtDhcpRelayServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay service id")
+		return diag.Errorf("Error obtaining dhcp relay service id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpRelayProfileID := d.Get("dhcp_relay_profile_id").(string)
 	dhcpRelayService := manager.DhcpRelayService{
 		Revision:           revision,
@@ -133,26 +134,26 @@ func resourceNsxtDhcpRelayServiceUpdate(d *schema.ResourceData, m interface{}) e
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateDhcpRelay(nsxClient.Context, id, dhcpRelayService)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during DhcpRelayService update: %v", err)
+		return diag.Errorf("Error during DhcpRelayService update: %v", err)
 	}
 
-	return resourceNsxtDhcpRelayServiceRead(d, m)
+	return resourceNsxtDhcpRelayServiceRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpRelayServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay service id")
+		return diag.Errorf("Error obtaining dhcp relay service id")
 	}
 
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteDhcpRelay(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayService delete: %v", err)
+		return diag.Errorf("Error during DhcpRelayService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -16,10 +18,10 @@ import (
 
 func resourceNsxtDhcpServerIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpServerIPPoolCreate,
-		Read:   resourceNsxtDhcpServerIPPoolRead,
-		Update: resourceNsxtDhcpServerIPPoolUpdate,
-		Delete: resourceNsxtDhcpServerIPPoolDelete,
+		CreateContext: resourceNsxtDhcpServerIPPoolCreate,
+		ReadContext:   resourceNsxtDhcpServerIPPoolRead,
+		UpdateContext: resourceNsxtDhcpServerIPPoolUpdate,
+		DeleteContext: resourceNsxtDhcpServerIPPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtDhcpServerIPPoolImport,
 		},
@@ -79,10 +81,10 @@ func resourceNsxtDhcpServerIPPool() *schema.Resource {
 	}
 }
 
-func resourceNsxtDhcpServerIPPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -100,7 +102,7 @@ func resourceNsxtDhcpServerIPPoolCreate(d *schema.ResourceData, m interface{}) e
 			StaticRoutes: opt121Routes,
 		}
 	}
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	pool := manager.DhcpIpPool{
 		DisplayName: displayName,
 		Description: description,
@@ -118,32 +120,32 @@ func resourceNsxtDhcpServerIPPoolCreate(d *schema.ResourceData, m interface{}) e
 
 	createdPool, resp, err := nsxClient.ServicesApi.CreateDhcpIpPool(nsxClient.Context, serverID, pool)
 	if resp != nil && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during DhcpIPPool create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during DhcpIPPool create: %v", resp.StatusCode)
 	}
 	if err != nil {
-		return fmt.Errorf("Error during DhcpIPPool create: %v", err)
+		return diag.Errorf("Error during DhcpIPPool create: %v", err)
 	}
 
 	d.SetId(createdPool.Id)
 
-	return resourceNsxtDhcpServerIPPoolRead(d, m)
+	return resourceNsxtDhcpServerIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpServerIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	serverID := d.Get("logical_dhcp_server_id").(string)
 	if id == "" || serverID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	pool, resp, err := nsxClient.ServicesApi.ReadDhcpIpPool(nsxClient.Context, serverID, id)
 	if err != nil {
-		return fmt.Errorf("Error during DhcpIPPool read: %v", err)
+		return diag.Errorf("Error during DhcpIPPool read: %v", err)
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		log.Printf("[DEBUG] DhcpIPPool %s not found", id)
@@ -154,7 +156,7 @@ func resourceNsxtDhcpServerIPPoolRead(d *schema.ResourceData, m interface{}) err
 	d.Set("revision", pool.Revision)
 	d.Set("display_name", pool.DisplayName)
 	d.Set("description", pool.Description)
-	setTagsInSchema(d, pool.Tags)
+	setTagsInSchema(d, pool.Tags, m)
 	d.Set("logical_dhcp_server_id", serverID)
 	d.Set("gateway_ip", pool.GatewayIp)
 	setIPRangesInSchema(d, pool.AllocationRanges)
@@ -165,11 +167,11 @@ func resourceNsxtDhcpServerIPPoolRead(d *schema.ResourceData, m interface{}) err
 	if pool.Options != nil && pool.Options.Option121 != nil {
 		err = setDhcpOptions121InSchema(d, pool.Options.Option121.StaticRoutes)
 		if err != nil {
-			return fmt.Errorf("Error during DhcpIPPool read option 121: %v", err)
+			return diag.Errorf("Error during DhcpIPPool read option 121: %v", err)
 		}
 		err = setDhcpGenericOptionsInSchema(d, pool.Options.Others)
 		if err != nil {
-			return fmt.Errorf("Error during DhcpIPPool read generic options: %v", err)
+			return diag.Errorf("Error during DhcpIPPool read generic options: %v", err)
 		}
 	} else {
 		var emptyDhcpOpt121 []map[string]interface{}
@@ -181,16 +183,16 @@ func resourceNsxtDhcpServerIPPoolRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtDhcpServerIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	serverID := d.Get("logical_dhcp_server_id").(string)
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -207,7 +209,7 @@ func resourceNsxtDhcpServerIPPoolUpdate(d *schema.ResourceData, m interface{}) e
 			StaticRoutes: opt121Routes,
 		}
 	}
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	pool := manager.DhcpIpPool{
 		DisplayName: displayName,
 		Description: description,
@@ -226,27 +228,27 @@ func resourceNsxtDhcpServerIPPoolUpdate(d *schema.ResourceData, m interface{}) e
 	_, resp, err := nsxClient.ServicesApi.UpdateDhcpIpPool(nsxClient.Context, serverID, id, pool)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during DhcpIPPool update: %v", err)
+		return diag.Errorf("Error during DhcpIPPool update: %v", err)
 	}
 
-	return resourceNsxtDhcpServerIPPoolRead(d, m)
+	return resourceNsxtDhcpServerIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpServerIPPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	serverID := d.Get("logical_dhcp_server_id").(string)
 	if id == "" || serverID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteDhcpIpPool(nsxClient.Context, serverID, id)
 	if err != nil {
-		return fmt.Errorf("Error during DhcpIPPool delete: %v", err)
+		return diag.Errorf("Error during DhcpIPPool delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtDhcpServerProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpServerProfileCreate,
-		Read:   resourceNsxtDhcpServerProfileRead,
-		Update: resourceNsxtDhcpServerProfileUpdate,
-		Delete: resourceNsxtDhcpServerProfileDelete,
+		CreateContext: resourceNsxtDhcpServerProfileCreate,
+		ReadContext:   resourceNsxtDhcpServerProfileRead,
+		UpdateContext: resourceNsxtDhcpServerProfileUpdate,
+		DeleteContext: resourceNsxtDhcpServerProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -54,15 +55,15 @@ func resourceNsxtDhcpServerProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtDhcpServerProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	edgeClusterID := d.Get("edge_cluster_id").(string)
 	edgeClusterMemberIndexes := intList2int64List(d.Get("edge_cluster_member_indexes").([]interface{}))
 	dhcpProfile := manager.DhcpProfile{
@@ -76,26 +77,26 @@ func resourceNsxtDhcpServerProfileCreate(d *schema.ResourceData, m interface{})
 	dhcpProfile, resp, err := nsxClient.ServicesApi.CreateDhcpProfile(nsxClient.Context, dhcpProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during DhcpProfile create: %v", err)
+		return diag.Errorf("Error during DhcpProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during DhcpProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during DhcpProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(dhcpProfile.Id)
 
-	return resourceNsxtDhcpServerProfileRead(d, m)
+	return resourceNsxtDhcpServerProfileRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpServerProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	dhcpProfile, resp, err := nsxClient.ServicesApi.ReadDhcpProfile(nsxClient.Context, id)
@@ -105,35 +106,35 @@ func resourceNsxtDhcpServerProfileRead(d *schema.ResourceData, m interface{}) er
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during DhcpProfile read: %v", err)
+		return diag.Errorf("Error during DhcpProfile read: %v", err)
 	}
 
 	d.Set("revision", dhcpProfile.Revision)
 	d.Set("description", dhcpProfile.Description)
 	d.Set("display_name", dhcpProfile.DisplayName)
-	setTagsInSchema(d, dhcpProfile.Tags)
+	setTagsInSchema(d, dhcpProfile.Tags, m)
 	d.Set("edge_cluster_id", dhcpProfile.EdgeClusterId)
 	d.Set("edge_cluster_member_indexes", dhcpProfile.EdgeClusterMemberIndexes)
 
 	return nil
 }
 
-func resourceNsxtDhcpServerProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	edgeClusterID := d.Get("edge_cluster_id").(string)
 	edgeClusterMemberIndexes := intList2int64List(d.Get("edge_cluster_member_indexes").([]interface{}))
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	dhcpProfile := manager.DhcpProfile{
 		DisplayName:              displayName,
@@ -147,26 +148,26 @@ func resourceNsxtDhcpServerProfileUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateDhcpProfile(nsxClient.Context, id, dhcpProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during DhcpProfile update: %v", err)
+		return diag.Errorf("Error during DhcpProfile update: %v", err)
 	}
 
-	return resourceNsxtDhcpServerProfileRead(d, m)
+	return resourceNsxtDhcpServerProfileRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpServerProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpServerProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteDhcpProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during DhcpProfile delete: %v", err)
+		return diag.Errorf("Error during DhcpProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
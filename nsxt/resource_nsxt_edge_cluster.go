@@ -4,14 +4,20 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/edge_clusters"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
 )
 
@@ -22,13 +28,17 @@ var failureDomainAllocationOptions = []string{
 
 func resourceNsxtEdgeCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEdgeClusterCreate,
-		Read:   resourceNsxtEdgeClusterRead,
-		Update: resourceNsxtEdgeClusterUpdate,
-		Delete: resourceNsxtEdgeClusterDelete,
+		CreateContext: resourceNsxtEdgeClusterCreate,
+		ReadContext:   resourceNsxtEdgeClusterRead,
+		UpdateContext: resourceNsxtEdgeClusterUpdate,
+		DeleteContext: resourceNsxtEdgeClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"revision":     getRevisionSchema(),
 			"description":  getDescriptionSchema(),
@@ -113,13 +123,13 @@ func resourceNsxtEdgeCluster() *schema.Resource {
 	}
 }
 
-func resourceNsxtEdgeClusterCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nsx.NewEdgeClustersClient(connector)
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	clusterProfileBindings := getClusterProfileBindingsFromSchema(d)
 	members := getEdgeClusterMembersFromSchema(d)
 	allocationRules := getAllocationRulesFromSchema(d)
@@ -136,11 +146,18 @@ func resourceNsxtEdgeClusterCreate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Creating Edge Cluster with name %s", displayName)
 	obj, err := client.Create(obj)
 	if err != nil {
-		return handleCreateError("Edge Cluster", displayName, err)
+		return diag.FromErr(handleCreateError("Edge Cluster", displayName, err))
 	}
 
 	d.SetId(*obj.Id)
-	return resourceNsxtEdgeClusterRead(d, m)
+
+	stateConf := getEdgeClusterStateConf(connector, *obj.Id, d.Timeout(schema.TimeoutCreate))
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to realize Edge Cluster %s: %v", *obj.Id, err)
+	}
+
+	return resourceNsxtEdgeClusterRead(ctx, d, m)
 }
 
 func getClusterProfileBindingsFromSchema(d *schema.ResourceData) []model.ClusterProfileTypeIdEntry {
@@ -197,23 +214,23 @@ func getAllocationRulesFromSchema(d *schema.ResourceData) []model.AllocationRule
 	return nil
 }
 
-func resourceNsxtEdgeClusterRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewEdgeClustersClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "EdgeCluster", id, err)
+		return diag.FromErr(handleReadError(d, "EdgeCluster", id, err))
 	}
 
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 
 	setClusterProfileBindingsInSchema(d, obj)
 
@@ -281,11 +298,11 @@ func setMemberListInSchema(d *schema.ResourceData, members []model.EdgeClusterMe
 	return d.Set("member", expresionList)
 }
 
-func resourceNsxtEdgeClusterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewEdgeClustersClient(connector)
@@ -293,7 +310,7 @@ func resourceNsxtEdgeClusterUpdate(d *schema.ResourceData, m interface{}) error
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	members := getEdgeClusterMembersFromSchema(d)
 	clusterProfileBindings := getClusterProfileBindingsFromSchema(d)
 	allocationRules := getAllocationRulesFromSchema(d)
@@ -310,25 +327,67 @@ func resourceNsxtEdgeClusterUpdate(d *schema.ResourceData, m interface{}) error
 
 	_, err := client.Update(id, obj)
 	if err != nil {
-		return handleUpdateError("EdgeCluster", id, err)
+		return diag.FromErr(handleUpdateError("EdgeCluster", id, err))
 	}
 
-	return resourceNsxtEdgeClusterRead(d, m)
+	stateConf := getEdgeClusterStateConf(connector, id, d.Timeout(schema.TimeoutUpdate))
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to realize Edge Cluster %s: %v", id, err)
+	}
+
+	return resourceNsxtEdgeClusterRead(ctx, d, m)
 }
 
-func resourceNsxtEdgeClusterDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewEdgeClustersClient(connector)
 
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("EdgeCluster", id, err)
+		return diag.FromErr(handleDeleteError("EdgeCluster", id, err))
 	}
 	return nil
 }
+
+func getEdgeClusterStateConf(connector client.Connector, id string, timeout time.Duration) *resource.StateChangeConf {
+	client := edge_clusters.NewStateClient(connector)
+	return &resource.StateChangeConf{
+		Pending: []string{
+			model.EdgeClusterState_STATE_PENDING,
+			model.EdgeClusterState_STATE_IN_PROGRESS,
+			model.EdgeClusterState_STATE_UNKNOWN,
+		},
+		Target: []string{
+			model.EdgeClusterState_STATE_SUCCESS,
+			model.EdgeClusterState_STATE_IN_SYNC,
+		},
+		Refresh: func() (interface{}, string, error) {
+			state, err := client.Get(id, nil, nil)
+			if err != nil {
+				return state, "", logAPIError("Error retrieving Edge Cluster state", err)
+			}
+			if state.State == nil {
+				return state, model.EdgeClusterState_STATE_PENDING, nil
+			}
+			log.Printf("[DEBUG] Edge Cluster %s state is %s", id, *state.State)
+			if *state.State == model.EdgeClusterState_STATE_FAILED || *state.State == model.EdgeClusterState_STATE_ERROR {
+				msg := *state.State
+				if state.FailureMessage != nil {
+					msg = *state.FailureMessage
+				}
+				return state, *state.State, fmt.Errorf("%s", msg)
+			}
+			return state, *state.State, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      1 * time.Second,
+	}
+}
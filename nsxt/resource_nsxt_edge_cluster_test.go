@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
 )
@@ -141,3 +143,23 @@ resource "nsxt_edge_cluster" "test" {
 }
 `, displayName)
 }
+
+func TestMockNsxEdgeCluster(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	resource := resourceNsxtEdgeCluster()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-edge-cluster",
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if d.Id() == "" {
+		t.Fatalf("Resource ID is not set after create")
+	}
+	if diags := resource.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+}
@@ -4,8 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -16,10 +17,10 @@ import (
 
 func resourceNsxtEdgeHighAvailabilityProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEdgeHighAvailabilityProfileCreate,
-		Read:   resourceNsxtEdgeHighAvailabilityProfileRead,
-		Update: resourceNsxtEdgeHighAvailabilityProfileUpdate,
-		Delete: resourceNsxtEdgeHighAvailabilityProfileDelete,
+		CreateContext: resourceNsxtEdgeHighAvailabilityProfileCreate,
+		ReadContext:   resourceNsxtEdgeHighAvailabilityProfileRead,
+		UpdateContext: resourceNsxtEdgeHighAvailabilityProfileUpdate,
+		DeleteContext: resourceNsxtEdgeHighAvailabilityProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -60,12 +61,12 @@ func resourceNsxtEdgeHighAvailabilityProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtEdgeHighAvailabilityProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeHighAvailabilityProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nsx.NewClusterProfilesClient(connector)
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	bfdAllowedHops := int64(d.Get("bfd_allowed_hops").(int))
 	bfdDeclareDeadMultiple := int64(d.Get("bfd_declare_dead_multiple").(int))
 	bfdProbeInterval := int64(d.Get("bfd_probe_interval").(int))
@@ -86,44 +87,44 @@ func resourceNsxtEdgeHighAvailabilityProfileCreate(d *schema.ResourceData, m int
 	converter := bindings.NewTypeConverter()
 	dataValue, errs := converter.ConvertToVapi(obj, model.EdgeHighAvailabilityProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 
 	structValue, err := client.Create(dataValue.(*data.StructValue))
 	if err != nil {
-		return handleCreateError("Edge High Availability Profile", displayName, err)
+		return diag.FromErr(handleCreateError("Edge High Availability Profile", displayName, err))
 	}
 	o, errs := converter.ConvertToGolang(structValue, model.EdgeHighAvailabilityProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 	obj = o.(model.EdgeHighAvailabilityProfile)
 
 	d.SetId(*obj.Id)
-	return resourceNsxtEdgeHighAvailabilityProfileRead(d, m)
+	return resourceNsxtEdgeHighAvailabilityProfileRead(ctx, d, m)
 }
 
-func resourceNsxtEdgeHighAvailabilityProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeHighAvailabilityProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 	client := nsx.NewClusterProfilesClient(connector)
 	structValue, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "Edge High Availability Profile", id, err)
+		return diag.FromErr(handleReadError(d, "Edge High Availability Profile", id, err))
 	}
 	converter := bindings.NewTypeConverter()
 	o, errs := converter.ConvertToGolang(structValue, model.EdgeHighAvailabilityProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 	obj := o.(model.EdgeHighAvailabilityProfile)
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 	d.Set("bfd_allowed_hops", obj.BfdAllowedHops)
 	d.Set("bfd_declare_dead_multiple", obj.BfdDeclareDeadMultiple)
 	d.Set("bfd_probe_interval", obj.BfdProbeInterval)
@@ -134,17 +135,17 @@ func resourceNsxtEdgeHighAvailabilityProfileRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtEdgeHighAvailabilityProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeHighAvailabilityProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 	client := nsx.NewClusterProfilesClient(connector)
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	bfdAllowedHops := int64(d.Get("bfd_allowed_hops").(int))
 	bfdDeclareDeadMultiple := int64(d.Get("bfd_declare_dead_multiple").(int))
 	bfdProbeInterval := int64(d.Get("bfd_probe_interval").(int))
@@ -166,30 +167,30 @@ func resourceNsxtEdgeHighAvailabilityProfileUpdate(d *schema.ResourceData, m int
 	converter := bindings.NewTypeConverter()
 	dataValue, errs := converter.ConvertToVapi(obj, model.EdgeHighAvailabilityProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 
 	_, err := client.Update(id, dataValue.(*data.StructValue))
 	if err != nil {
-		return handleUpdateError("Edge High Availability Profile", id, err)
+		return diag.FromErr(handleUpdateError("Edge High Availability Profile", id, err))
 	}
 
-	return resourceNsxtEdgeHighAvailabilityProfileRead(d, m)
+	return resourceNsxtEdgeHighAvailabilityProfileRead(ctx, d, m)
 }
 
-func resourceNsxtEdgeHighAvailabilityProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEdgeHighAvailabilityProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewClusterProfilesClient(connector)
 
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("Edge High Availability Profile", id, err)
+		return diag.FromErr(handleDeleteError("Edge High Availability Profile", id, err))
 	}
 	return nil
 }
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtEtherTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEtherTypeNsServiceCreate,
-		Read:   resourceNsxtEtherTypeNsServiceRead,
-		Update: resourceNsxtEtherTypeNsServiceUpdate,
-		Delete: resourceNsxtEtherTypeNsServiceDelete,
+		CreateContext: resourceNsxtEtherTypeNsServiceCreate,
+		ReadContext:   resourceNsxtEtherTypeNsServiceRead,
+		UpdateContext: resourceNsxtEtherTypeNsServiceUpdate,
+		DeleteContext: resourceNsxtEtherTypeNsServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -50,15 +51,15 @@ func resourceNsxtEtherTypeNsService() *schema.Resource {
 	}
 }
 
-func resourceNsxtEtherTypeNsServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEtherTypeNsServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	etherType := int64(d.Get("ether_type").(int))
 
 	nsService := manager.EtherTypeNsService{
@@ -76,25 +77,25 @@ func resourceNsxtEtherTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateEtherTypeNSService(nsxClient.Context, nsService)
 
 	if err != nil {
-		return fmt.Errorf("Error during NsService create: %v", err)
+		return diag.Errorf("Error during NsService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
-	return resourceNsxtEtherTypeNsServiceRead(d, m)
+	return resourceNsxtEtherTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtEtherTypeNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEtherTypeNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.ReadEtherTypeNSService(nsxClient.Context, id)
@@ -104,7 +105,7 @@ func resourceNsxtEtherTypeNsServiceRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsService read: %v", err)
+		return diag.Errorf("Error during NsService read: %v", err)
 	}
 
 	nsserviceElement := nsService.NsserviceElement
@@ -112,27 +113,27 @@ func resourceNsxtEtherTypeNsServiceRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", nsService.Revision)
 	d.Set("description", nsService.Description)
 	d.Set("display_name", nsService.DisplayName)
-	setTagsInSchema(d, nsService.Tags)
+	setTagsInSchema(d, nsService.Tags, m)
 	d.Set("default_service", nsService.DefaultService)
 	d.Set("ether_type", nsserviceElement.EtherType)
 
 	return nil
 }
 
-func resourceNsxtEtherTypeNsServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEtherTypeNsServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	etherType := int64(d.Get("ether_type").(int))
 
@@ -151,28 +152,28 @@ func resourceNsxtEtherTypeNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateEtherTypeNSService(nsxClient.Context, id, nsService)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsService update: %v %v", err, resp)
+		return diag.Errorf("Error during NsService update: %v %v", err, resp)
 	}
 
-	return resourceNsxtEtherTypeNsServiceRead(d, m)
+	return resourceNsxtEtherTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtEtherTypeNsServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtEtherTypeNsServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSService(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsService delete: %v", err)
+		return diag.Errorf("Error during NsService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
@@ -21,10 +22,10 @@ var edgeServices = []string{
 
 func resourceNsxtFailureDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtFailureDomainCreate,
-		Read:   resourceNsxtFailureDomainRead,
-		Update: resourceNsxtFailureDomainUpdate,
-		Delete: resourceNsxtFailureDomainDelete,
+		CreateContext: resourceNsxtFailureDomainCreate,
+		ReadContext:   resourceNsxtFailureDomainRead,
+		UpdateContext: resourceNsxtFailureDomainUpdate,
+		DeleteContext: resourceNsxtFailureDomainDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -45,23 +46,23 @@ func resourceNsxtFailureDomain() *schema.Resource {
 	}
 }
 
-func resourceNsxtFailureDomainRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFailureDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining FailureDomain ID")
+		return diag.Errorf("error obtaining FailureDomain ID")
 	}
 
 	client := nsx.NewFailureDomainsClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "FailureDomain", id, err)
+		return diag.FromErr(handleReadError(d, "FailureDomain", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 	d.Set("revision", obj.Revision)
 
 	preferPtr := obj.PreferredActiveEdgeServices
@@ -77,10 +78,10 @@ func resourceNsxtFailureDomainRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func failureDomainSchemaToModel(d *schema.ResourceData) model.FailureDomain {
+func failureDomainSchemaToModel(d *schema.ResourceData, m interface{}) model.FailureDomain {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 
 	obj := model.FailureDomain{
 		DisplayName: &displayName,
@@ -101,53 +102,53 @@ func failureDomainSchemaToModel(d *schema.ResourceData) model.FailureDomain {
 	return obj
 }
 
-func resourceNsxtFailureDomainCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFailureDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nsx.NewFailureDomainsClient(connector)
 
-	failureDomain := failureDomainSchemaToModel(d)
+	failureDomain := failureDomainSchemaToModel(d, m)
 	displayName := d.Get("display_name").(string)
 	log.Printf("[INFO] Creating Failure Domain %s", displayName)
 	obj, err := client.Create(failureDomain)
 	if err != nil {
-		return handleCreateError("Failure Domain", displayName, err)
+		return diag.FromErr(handleCreateError("Failure Domain", displayName, err))
 	}
 	d.SetId(*obj.Id)
 
-	return resourceNsxtFailureDomainRead(d, m)
+	return resourceNsxtFailureDomainRead(ctx, d, m)
 }
 
-func resourceNsxtFailureDomainUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFailureDomainUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining FailureDomain ID")
+		return diag.Errorf("error obtaining FailureDomain ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := nsx.NewFailureDomainsClient(connector)
 
-	failureDomain := failureDomainSchemaToModel(d)
+	failureDomain := failureDomainSchemaToModel(d, m)
 	revision := int64(d.Get("revision").(int))
 	failureDomain.Revision = &revision
 
 	_, err := client.Update(id, failureDomain)
 	if err != nil {
-		return handleUpdateError("FailureDomain", id, err)
+		return diag.FromErr(handleUpdateError("FailureDomain", id, err))
 	}
 
-	return resourceNsxtFailureDomainRead(d, m)
+	return resourceNsxtFailureDomainRead(ctx, d, m)
 }
 
-func resourceNsxtFailureDomainDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFailureDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining FailureDomain ID")
+		return diag.Errorf("error obtaining FailureDomain ID")
 	}
 	connector := getPolicyConnector(m)
 	client := nsx.NewFailureDomainsClient(connector)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("FailureDomain", id, err)
+		return diag.FromErr(handleDeleteError("FailureDomain", id, err))
 	}
 	return nil
 }
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -20,10 +21,10 @@ var firewallSectionTypeValues = []string{"LAYER2", "LAYER3"}
 
 func resourceNsxtFirewallSection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtFirewallSectionCreate,
-		Read:   resourceNsxtFirewallSectionRead,
-		Update: resourceNsxtFirewallSectionUpdate,
-		Delete: resourceNsxtFirewallSectionDelete,
+		CreateContext: resourceNsxtFirewallSectionCreate,
+		ReadContext:   resourceNsxtFirewallSectionRead,
+		UpdateContext: resourceNsxtFirewallSectionUpdate,
+		DeleteContext: resourceNsxtFirewallSectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -239,16 +240,16 @@ func getRulesFromSchema(d *schema.ResourceData) []manager.FirewallRule {
 	return ruleList
 }
 
-func resourceNsxtFirewallSectionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFirewallSectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	rules := getRulesFromSchema(d)
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	appliedTos := getResourceReferencesFromSchemaSet(d, "applied_to")
 	sectionType := d.Get("section_type").(string)
 	stateful := d.Get("stateful").(bool)
@@ -283,30 +284,30 @@ func resourceNsxtFirewallSectionCreate(d *schema.ResourceData, m interface{}) er
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error during FirewallSection create with rules: %v", err)
+		return diag.Errorf("Error during FirewallSection create with rules: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during FirewallSection create with rules: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during FirewallSection create with rules: %v", resp.StatusCode)
 	}
 
-	return resourceNsxtFirewallSectionRead(d, m)
+	return resourceNsxtFirewallSectionRead(ctx, d, m)
 }
 
-func resourceNsxtFirewallSectionRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFirewallSectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	firewallSection, resp, err := nsxClient.ServicesApi.GetSectionWithRulesListWithRules(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during FirewallSection %s read: %v", id, err)
+		return diag.Errorf("Error during FirewallSection %s read: %v", id, err)
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		log.Printf("[DEBUG] FirewallSection %s not found", id)
@@ -320,10 +321,10 @@ func resourceNsxtFirewallSectionRead(d *schema.ResourceData, m interface{}) erro
 	d.Set("is_default", firewallSection.IsDefault)
 	d.Set("section_type", firewallSection.SectionType)
 	d.Set("stateful", firewallSection.Stateful)
-	setTagsInSchema(d, firewallSection.Tags)
+	setTagsInSchema(d, firewallSection.Tags, m)
 	err = setRulesInSchema(d, firewallSection.Rules)
 	if err != nil {
-		return fmt.Errorf("Error during FirewallSection rules set in schema: %v", err)
+		return diag.Errorf("Error during FirewallSection rules set in schema: %v", err)
 	}
 
 	// Getting the applied tos will require another api call (for NSX 2.1 or less)
@@ -334,32 +335,32 @@ func resourceNsxtFirewallSectionRead(d *schema.ResourceData, m interface{}) erro
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during FirewallSection %s read: %v", id, err)
+		return diag.Errorf("Error during FirewallSection %s read: %v", id, err)
 	}
 	err = setResourceReferencesInSchema(d, firewallSection2.AppliedTos, "applied_to")
 	if err != nil {
-		return fmt.Errorf("Error during FirewallSection AppliedTos set in schema: %v", err)
+		return diag.Errorf("Error during FirewallSection AppliedTos set in schema: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtFirewallSectionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFirewallSectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	rules := getRulesFromSchema(d)
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	appliedTos := getResourceReferencesFromSchemaSet(d, "applied_to")
 	sectionType := d.Get("section_type").(string)
 	stateful := d.Get("stateful").(bool)
@@ -379,7 +380,7 @@ func resourceNsxtFirewallSectionUpdate(d *schema.ResourceData, m interface{}) er
 
 	var resp *http.Response
 	var err error
-	if len(rules) == 0 || nsxVersionLower("2.2.0") {
+	if len(rules) == 0 || nsxVersionLower(m, "2.2.0") {
 		// Due to an NSX bug, the empty update should also be called to update ToS & tags fields
 		section := *firewallSection.GetFirewallSection()
 		// Update the section ignoring the rules
@@ -389,15 +390,15 @@ func resourceNsxtFirewallSectionUpdate(d *schema.ResourceData, m interface{}) er
 			// Read the section, and delete all current rules from it
 			currSection, resp2, err2 := nsxClient.ServicesApi.GetSectionWithRulesListWithRules(nsxClient.Context, id)
 			if resp2.StatusCode == http.StatusNotFound {
-				return fmt.Errorf("FirewallSection %s not found during update empty action", id)
+				return diag.Errorf("FirewallSection %s not found during update empty action", id)
 			}
 			if err2 != nil {
-				return fmt.Errorf("Error during FirewallSection %s update empty: cannot read the section: %v", id, err2)
+				return diag.Errorf("Error during FirewallSection %s update empty: cannot read the section: %v", id, err2)
 			}
 			for _, rule := range currSection.Rules {
 				_, err3 := nsxClient.ServicesApi.DeleteRule(nsxClient.Context, id, rule.Id)
 				if err3 != nil {
-					return fmt.Errorf("Error during FirewallSection %s update: failed to delete rule %s due to %v", id, rule.Id, err3)
+					return diag.Errorf("Error during FirewallSection %s update: failed to delete rule %s due to %v", id, rule.Id, err3)
 				}
 			}
 		}
@@ -408,28 +409,28 @@ func resourceNsxtFirewallSectionUpdate(d *schema.ResourceData, m interface{}) er
 	}
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during FirewallSection %s update: %v", id, err)
+		return diag.Errorf("Error during FirewallSection %s update: %v", id, err)
 	}
 
-	return resourceNsxtFirewallSectionRead(d, m)
+	return resourceNsxtFirewallSectionRead(ctx, d, m)
 }
 
-func resourceNsxtFirewallSectionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtFirewallSectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id to delete")
+		return diag.Errorf("Error obtaining logical object id to delete")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["cascade"] = true
 	resp, err := nsxClient.ServicesApi.DeleteSection(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during FirewallSection %s delete: %v", id, err)
+		return diag.Errorf("Error during FirewallSection %s delete: %v", id, err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
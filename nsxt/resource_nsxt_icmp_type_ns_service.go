@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -17,10 +18,10 @@ var icmpProtocolValues = []string{"ICMPv4", "ICMPv6"}
 
 func resourceNsxtIcmpTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIcmpTypeNsServiceCreate,
-		Read:   resourceNsxtIcmpTypeNsServiceRead,
-		Update: resourceNsxtIcmpTypeNsServiceUpdate,
-		Delete: resourceNsxtIcmpTypeNsServiceDelete,
+		CreateContext: resourceNsxtIcmpTypeNsServiceCreate,
+		ReadContext:   resourceNsxtIcmpTypeNsServiceRead,
+		UpdateContext: resourceNsxtIcmpTypeNsServiceUpdate,
+		DeleteContext: resourceNsxtIcmpTypeNsServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -66,15 +67,15 @@ func resourceNsxtIcmpTypeNsService() *schema.Resource {
 	}
 }
 
-func resourceNsxtIcmpTypeNsServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	icmpCode := int64(d.Get("icmp_code").(int))
 	icmpType := int64(d.Get("icmp_type").(int))
 	protocol := d.Get("protocol").(string)
@@ -95,30 +96,30 @@ func resourceNsxtIcmpTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateIcmpTypeNSService(nsxClient.Context, nsService)
 	if resp != nil && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsService create: %v", err)
+		return diag.Errorf("Error during NsService create: %v", err)
 	}
 
 	d.SetId(nsService.Id)
-	return resourceNsxtIcmpTypeNsServiceRead(d, m)
+	return resourceNsxtIcmpTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIcmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.ReadIcmpTypeNSService(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during NsService read: %v", err)
+		return diag.Errorf("Error during NsService read: %v", err)
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		log.Printf("[DEBUG] NsService %s not found", id)
@@ -131,7 +132,7 @@ func resourceNsxtIcmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) er
 	d.Set("revision", nsService.Revision)
 	d.Set("description", nsService.Description)
 	d.Set("display_name", nsService.DisplayName)
-	setTagsInSchema(d, nsService.Tags)
+	setTagsInSchema(d, nsService.Tags, m)
 	d.Set("default_service", nsService.DefaultService)
 	d.Set("icmp_type", nsserviceElement.IcmpType)
 	d.Set("icmp_code", nsserviceElement.IcmpCode)
@@ -140,20 +141,20 @@ func resourceNsxtIcmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtIcmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	icmpCode := int64(d.Get("icmp_code").(int))
 	icmpType := int64(d.Get("icmp_type").(int))
 	protocol := d.Get("protocol").(string)
@@ -176,28 +177,28 @@ func resourceNsxtIcmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateIcmpTypeNSService(nsxClient.Context, id, nsService)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsService update: %v %v", err, resp)
+		return diag.Errorf("Error during NsService update: %v %v", err, resp)
 	}
 
-	return resourceNsxtIcmpTypeNsServiceRead(d, m)
+	return resourceNsxtIcmpTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIcmpTypeNsServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSService(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsService delete: %v", err)
+		return diag.Errorf("Error during NsService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
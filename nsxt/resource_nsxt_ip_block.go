@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPBlockCreate,
-		Read:   resourceNsxtIPBlockRead,
-		Update: resourceNsxtIPBlockUpdate,
-		Delete: resourceNsxtIPBlockDelete,
+		CreateContext: resourceNsxtIPBlockCreate,
+		ReadContext:   resourceNsxtIPBlockRead,
+		UpdateContext: resourceNsxtIPBlockUpdate,
+		DeleteContext: resourceNsxtIPBlockDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -46,15 +47,15 @@ func resourceNsxtIPBlock() *schema.Resource {
 	}
 }
 
-func resourceNsxtIPBlockCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPBlockCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	cidr := d.Get("cidr").(string)
 	ipBlock := manager.IpBlock{
 		Description: description,
@@ -66,26 +67,26 @@ func resourceNsxtIPBlockCreate(d *schema.ResourceData, m interface{}) error {
 	ipBlock, resp, err := nsxClient.PoolManagementApi.CreateIpBlock(nsxClient.Context, ipBlock)
 
 	if err != nil {
-		return fmt.Errorf("Error during IpBlock create: %v", err)
+		return diag.Errorf("Error during IpBlock create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during IpBlock create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IpBlock create: %v", resp.StatusCode)
 	}
 	d.SetId(ipBlock.Id)
 
-	return resourceNsxtIPBlockRead(d, m)
+	return resourceNsxtIPBlockRead(ctx, d, m)
 }
 
-func resourceNsxtIPBlockRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPBlockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	ipBlock, resp, err := nsxClient.PoolManagementApi.ReadIpBlock(nsxClient.Context, id)
@@ -95,33 +96,33 @@ func resourceNsxtIPBlockRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during IpBlock read: %v", err)
+		return diag.Errorf("Error during IpBlock read: %v", err)
 	}
 
 	d.Set("revision", ipBlock.Revision)
 	d.Set("description", ipBlock.Description)
 	d.Set("display_name", ipBlock.DisplayName)
-	setTagsInSchema(d, ipBlock.Tags)
+	setTagsInSchema(d, ipBlock.Tags, m)
 	d.Set("cidr", ipBlock.Cidr)
 
 	return nil
 }
 
-func resourceNsxtIPBlockUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPBlockUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	cidr := d.Get("cidr").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	ipBlock := manager.IpBlock{
 		DisplayName: displayName,
@@ -134,25 +135,25 @@ func resourceNsxtIPBlockUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.PoolManagementApi.UpdateIpBlock(nsxClient.Context, id, ipBlock)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during IpBlock update: %v", err)
+		return diag.Errorf("Error during IpBlock update: %v", err)
 	}
 
-	return resourceNsxtIPBlockRead(d, m)
+	return resourceNsxtIPBlockRead(ctx, d, m)
 }
 
-func resourceNsxtIPBlockDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPBlockDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 	resp, err := nsxClient.PoolManagementApi.DeleteIpBlock(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during IpBlock delete: %v", err)
+		return diag.Errorf("Error during IpBlock delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtIPBlockSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPBlockSubnetCreate,
-		Read:   resourceNsxtIPBlockSubnetRead,
+		CreateContext: resourceNsxtIPBlockSubnetCreate,
+		ReadContext:   resourceNsxtIPBlockSubnetRead,
 		// Update IP block subnet is not supported by the NSX
-		Delete: resourceNsxtIPBlockSubnetDelete,
+		DeleteContext: resourceNsxtIPBlockSubnetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -79,17 +80,17 @@ func resourceNsxtIPBlockSubnet() *schema.Resource {
 	}
 }
 
-func resourceNsxtIPBlockSubnetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPBlockSubnetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
 	blockID := d.Get("block_id").(string)
 	size := int64(d.Get("size").(int))
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipBlockSubnet := manager.IpBlockSubnet{
 		DisplayName: displayName,
 		Description: description,
@@ -101,15 +102,15 @@ func resourceNsxtIPBlockSubnetCreate(d *schema.ResourceData, m interface{}) erro
 	ipBlockSubnet, resp, err := nsxClient.PoolManagementApi.CreateIpBlockSubnet(nsxClient.Context, ipBlockSubnet)
 
 	if err != nil {
-		return fmt.Errorf("Error during IpBlockSubnet create: %v", err)
+		return diag.Errorf("Error during IpBlockSubnet create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during IpBlockSubnet create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IpBlockSubnet create: %v", resp.StatusCode)
 	}
 	d.SetId(ipBlockSubnet.Id)
 
-	return resourceNsxtIPBlockSubnetRead(d, m)
+	return resourceNsxtIPBlockSubnetRead(ctx, d, m)
 }
 
 func setAllocationRangesInSchema(d *schema.ResourceData, ranges []manager.IpPoolRange) error {
@@ -124,15 +125,15 @@ func setAllocationRangesInSchema(d *schema.ResourceData, ranges []manager.IpPool
 	return err
 }
 
-func resourceNsxtIPBlockSubnetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPBlockSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	ipBlockSubnet, resp, err := nsxClient.PoolManagementApi.ReadIpBlockSubnet(nsxClient.Context, id)
@@ -142,17 +143,17 @@ func resourceNsxtIPBlockSubnetRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during IpBlockSubnet read: %v", err)
+		return diag.Errorf("Error during IpBlockSubnet read: %v", err)
 	}
 
 	d.Set("display_name", ipBlockSubnet.DisplayName)
 	d.Set("description", ipBlockSubnet.Description)
 	d.Set("block_id", ipBlockSubnet.BlockId)
 	d.Set("size", ipBlockSubnet.Size)
-	setTagsInSchema(d, ipBlockSubnet.Tags)
+	setTagsInSchema(d, ipBlockSubnet.Tags, m)
 	err = setAllocationRangesInSchema(d, ipBlockSubnet.AllocationRanges)
 	if err != nil {
-		return fmt.Errorf("Error during IpBlockSubnet allocation ranges set in schema: %v", err)
+		return diag.Errorf("Error during IpBlockSubnet allocation ranges set in schema: %v", err)
 	}
 	d.Set("cidr", ipBlockSubnet.Cidr)
 	d.Set("revision", ipBlockSubnet.Revision)
@@ -160,20 +161,20 @@ func resourceNsxtIPBlockSubnetRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtIPBlockSubnetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPBlockSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.PoolManagementApi.DeleteIpBlockSubnet(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during IpBlockSubnet delete: %v", err)
+		return diag.Errorf("Error during IpBlockSubnet delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
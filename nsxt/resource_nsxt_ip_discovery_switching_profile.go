@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtIPDiscoverySwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPDiscoverySwitchingProfileCreate,
-		Read:   resourceNsxtIPDiscoverySwitchingProfileRead,
-		Update: resourceNsxtIPDiscoverySwitchingProfileUpdate,
-		Delete: resourceNsxtIPDiscoverySwitchingProfileDelete,
+		CreateContext: resourceNsxtIPDiscoverySwitchingProfileCreate,
+		ReadContext:   resourceNsxtIPDiscoverySwitchingProfileRead,
+		UpdateContext: resourceNsxtIPDiscoverySwitchingProfileUpdate,
+		DeleteContext: resourceNsxtIPDiscoverySwitchingProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -66,15 +67,15 @@ func resourceNsxtIPDiscoverySwitchingProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpSnoopingEnabled := d.Get("dhcp_snooping_enabled").(bool)
 	arpSnoopingEnabled := d.Get("arp_snooping_enabled").(bool)
 	arpBindingsLimit := d.Get("arp_bindings_limit").(int)
@@ -93,26 +94,26 @@ func resourceNsxtIPDiscoverySwitchingProfileCreate(d *schema.ResourceData, m int
 	switchingProfile, resp, err := nsxClient.LogicalSwitchingApi.CreateIpDiscoverySwitchingProfile(nsxClient.Context, switchingProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile create: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during IPDiscoverySwitchingProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IPDiscoverySwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(switchingProfile.Id)
 
-	return resourceNsxtIPDiscoverySwitchingProfileRead(d, m)
+	return resourceNsxtIPDiscoverySwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	switchingProfile, resp, err := nsxClient.LogicalSwitchingApi.GetIpDiscoverySwitchingProfile(nsxClient.Context, id)
@@ -122,7 +123,7 @@ func resourceNsxtIPDiscoverySwitchingProfileRead(d *schema.ResourceData, m inter
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile read: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile read: %v", err)
 	}
 
 	d.Set("revision", switchingProfile.Revision)
@@ -132,25 +133,25 @@ func resourceNsxtIPDiscoverySwitchingProfileRead(d *schema.ResourceData, m inter
 	d.Set("arp_snooping_enabled", switchingProfile.ArpSnoopingEnabled)
 	d.Set("arp_bindings_limit", switchingProfile.ArpBindingsLimit)
 	d.Set("vm_tools_enabled", switchingProfile.VmToolsEnabled)
-	setTagsInSchema(d, switchingProfile.Tags)
+	setTagsInSchema(d, switchingProfile.Tags, m)
 
 	return nil
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	dhcpSnoopingEnabled := d.Get("dhcp_snooping_enabled").(bool)
 	arpSnoopingEnabled := d.Get("arp_snooping_enabled").(bool)
@@ -171,26 +172,26 @@ func resourceNsxtIPDiscoverySwitchingProfileUpdate(d *schema.ResourceData, m int
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateIpDiscoverySwitchingProfile(nsxClient.Context, id, switchingProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile update: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile update: %v", err)
 	}
 
-	return resourceNsxtIPDiscoverySwitchingProfileRead(d, m)
+	return resourceNsxtIPDiscoverySwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteSwitchingProfile(nsxClient.Context, id, nil)
 	if err != nil {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile delete: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,21 +4,23 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPPoolCreate,
-		Read:   resourceNsxtIPPoolRead,
-		Update: resourceNsxtIPPoolUpdate,
-		Delete: resourceNsxtIPPoolDelete,
+		CreateContext: resourceNsxtIPPoolCreate,
+		ReadContext:   resourceNsxtIPPoolRead,
+		UpdateContext: resourceNsxtIPPoolUpdate,
+		DeleteContext: resourceNsxtIPPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -149,16 +151,16 @@ func setSubnetsInSchema(d *schema.ResourceData, subnets []manager.IpPoolSubnet)
 	return err
 }
 
-func resourceNsxtIPPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	displayName := d.Get("display_name").(string)
 	subnets := getSubnetsFromSchema(d)
 	description := d.Get("description").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipPool := manager.IpPool{
 		DisplayName: displayName,
 		Description: description,
@@ -169,26 +171,26 @@ func resourceNsxtIPPoolCreate(d *schema.ResourceData, m interface{}) error {
 	ipPool, resp, err := nsxClient.PoolManagementApi.CreateIpPool(nsxClient.Context, ipPool)
 
 	if err != nil {
-		return fmt.Errorf("Error during IpPool create: %v", err)
+		return diag.Errorf("Error during IpPool create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during IpPool create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IpPool create: %v", resp.StatusCode)
 	}
 	d.SetId(ipPool.Id)
 
-	return resourceNsxtIPPoolRead(d, m)
+	return resourceNsxtIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	ipPool, resp, err := nsxClient.PoolManagementApi.ReadIpPool(nsxClient.Context, id)
@@ -198,36 +200,36 @@ func resourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during IpPool read: %v", err)
+		return diag.Errorf("Error during IpPool read: %v", err)
 	}
 
 	d.Set("display_name", ipPool.DisplayName)
 	d.Set("description", ipPool.Description)
 	d.Set("revision", ipPool.Revision)
-	setTagsInSchema(d, ipPool.Tags)
+	setTagsInSchema(d, ipPool.Tags, m)
 	err = setSubnetsInSchema(d, ipPool.Subnets)
 	if err != nil {
-		return fmt.Errorf("Error during IpPool set in schema: %v", err)
+		return diag.Errorf("Error during IpPool set in schema: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	subnets := getSubnetsFromSchema(d)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	ipPool := manager.IpPool{
 		DisplayName: displayName,
@@ -240,27 +242,27 @@ func resourceNsxtIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.PoolManagementApi.UpdateIpPool(nsxClient.Context, id, ipPool)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during IpPool update: %v", err)
+		return diag.Errorf("Error during IpPool update: %v", err)
 	}
 
-	return resourceNsxtIPPoolRead(d, m)
+	return resourceNsxtIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtIPPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.PoolManagementApi.DeleteIpPool(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during IpPool delete: %v", err)
+		return diag.Errorf("Error during IpPool delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
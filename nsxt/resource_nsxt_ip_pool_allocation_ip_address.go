@@ -4,20 +4,22 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtIPPoolAllocationIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPPoolAllocationIPAddressCreate,
-		Read:   resourceNsxtIPPoolAllocationIPAddressRead,
-		Delete: resourceNsxtIPPoolAllocationIPAddressDelete,
+		CreateContext: resourceNsxtIPPoolAllocationIPAddressCreate,
+		ReadContext:   resourceNsxtIPPoolAllocationIPAddressRead,
+		DeleteContext: resourceNsxtIPPoolAllocationIPAddressDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtIPPoolAllocationIPAddressImport,
 		},
@@ -40,10 +42,10 @@ func resourceNsxtIPPoolAllocationIPAddress() *schema.Resource {
 	}
 }
 
-func resourceNsxtIPPoolAllocationIPAddressCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolAllocationIPAddressCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 	poolID := d.Get("ip_pool_id").(string)
 	allocationID := d.Get("allocation_id").(string)
@@ -54,29 +56,29 @@ func resourceNsxtIPPoolAllocationIPAddressCreate(d *schema.ResourceData, m inter
 	allocationIPAddress, resp, err := nsxClient.PoolManagementApi.AllocateOrReleaseFromIpPool(nsxClient.Context, poolID, allocationIPAddress, "ALLOCATE")
 
 	if err != nil {
-		return fmt.Errorf("Error during IPPoolAllocationIPAddress create: %v", err)
+		return diag.Errorf("Error during IPPoolAllocationIPAddress create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during IPPoolAllocationIPAddress create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IPPoolAllocationIPAddress create: %v", resp.StatusCode)
 	}
 	d.SetId(allocationIPAddress.AllocationId)
 
-	return resourceNsxtIPPoolAllocationIPAddressRead(d, m)
+	return resourceNsxtIPPoolAllocationIPAddressRead(ctx, d, m)
 }
 
-func resourceNsxtIPPoolAllocationIPAddressRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolAllocationIPAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 	poolID := d.Get("ip_pool_id").(string)
 	if poolID == "" {
-		return fmt.Errorf("Error obtaining pool id")
+		return diag.Errorf("Error obtaining pool id")
 	}
 
 	resultList, resp, err := nsxClient.PoolManagementApi.ListIpPoolAllocations(nsxClient.Context, poolID)
@@ -87,10 +89,10 @@ func resourceNsxtIPPoolAllocationIPAddressRead(d *schema.ResourceData, m interfa
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error during IPPoolAllocationIPAddress read: %v", err)
+		return diag.Errorf("Error during IPPoolAllocationIPAddress read: %v", err)
 	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during IPPoolAllocationIPAddress read: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IPPoolAllocationIPAddress read: %v", resp.StatusCode)
 	}
 
 	for _, address := range resultList.Results {
@@ -106,18 +108,18 @@ func resourceNsxtIPPoolAllocationIPAddressRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func resourceNsxtIPPoolAllocationIPAddressDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolAllocationIPAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 	poolID := d.Get("ip_pool_id").(string)
 	if id == "" {
-		return fmt.Errorf("Error obtaining pool id")
+		return diag.Errorf("Error obtaining pool id")
 	}
 
 	allocationIPAddress := manager.AllocationIpAddress{
@@ -125,11 +127,11 @@ func resourceNsxtIPPoolAllocationIPAddressDelete(d *schema.ResourceData, m inter
 	}
 	_, resp, err := nsxClient.PoolManagementApi.AllocateOrReleaseFromIpPool(nsxClient.Context, poolID, allocationIPAddress, "RELEASE")
 	if resp != nil && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Error during IPPoolAllocationIPAddress delete: status=%s", resp.Status)
+		return diag.Errorf("Error during IPPoolAllocationIPAddress delete: status=%s", resp.Status)
 	}
 	if resp == nil && err != nil {
 		// AllocateOrReleaseFromIpPool always returns an error EOF for action RELEASE, this is ignored if resp is set
-		return fmt.Errorf("Error during IPPoolAllocationIPAddress delete: %v", err)
+		return diag.Errorf("Error during IPPoolAllocationIPAddress delete: %v", err)
 	}
 
 	return nil
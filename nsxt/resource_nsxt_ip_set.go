@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtIPSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPSetCreate,
-		Read:   resourceNsxtIPSetRead,
-		Update: resourceNsxtIPSetUpdate,
-		Delete: resourceNsxtIPSetDelete,
+		CreateContext: resourceNsxtIPSetCreate,
+		ReadContext:   resourceNsxtIPSetRead,
+		UpdateContext: resourceNsxtIPSetUpdate,
+		DeleteContext: resourceNsxtIPSetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -49,15 +50,15 @@ func resourceNsxtIPSet() *schema.Resource {
 	}
 }
 
-func resourceNsxtIPSetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipAddresses := getStringListFromSchemaSet(d, "ip_addresses")
 	ipSet := manager.IpSet{
 		Description: description,
@@ -69,26 +70,26 @@ func resourceNsxtIPSetCreate(d *schema.ResourceData, m interface{}) error {
 	ipSet, resp, err := nsxClient.GroupingObjectsApi.CreateIPSet(nsxClient.Context, ipSet)
 
 	if err != nil {
-		return fmt.Errorf("Error during IpSet create: %v", err)
+		return diag.Errorf("Error during IpSet create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during IpSet create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IpSet create: %v", resp.StatusCode)
 	}
 	d.SetId(ipSet.Id)
 
-	return resourceNsxtIPSetRead(d, m)
+	return resourceNsxtIPSetRead(ctx, d, m)
 }
 
-func resourceNsxtIPSetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	ipSet, resp, err := nsxClient.GroupingObjectsApi.ReadIPSet(nsxClient.Context, id)
@@ -98,33 +99,33 @@ func resourceNsxtIPSetRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during IpSet read: %v", err)
+		return diag.Errorf("Error during IpSet read: %v", err)
 	}
 
 	d.Set("revision", ipSet.Revision)
 	d.Set("description", ipSet.Description)
 	d.Set("display_name", ipSet.DisplayName)
-	setTagsInSchema(d, ipSet.Tags)
+	setTagsInSchema(d, ipSet.Tags, m)
 	d.Set("ip_addresses", ipSet.IpAddresses)
 
 	return nil
 }
 
-func resourceNsxtIPSetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ipAddresses := interface2StringList(d.Get("ip_addresses").(*schema.Set).List())
 	ipSet := manager.IpSet{
 		Revision:    revision,
@@ -137,28 +138,28 @@ func resourceNsxtIPSetUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateIPSet(nsxClient.Context, id, ipSet)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during IpSet update: %v", err)
+		return diag.Errorf("Error during IpSet update: %v", err)
 	}
 
-	return resourceNsxtIPSetRead(d, m)
+	return resourceNsxtIPSetRead(ctx, d, m)
 }
 
-func resourceNsxtIPSetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteIPSet(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during IpSet delete: %v", err)
+		return diag.Errorf("Error during IpSet delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
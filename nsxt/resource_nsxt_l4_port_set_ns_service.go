@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -17,10 +18,10 @@ var protocolValues = []string{"TCP", "UDP"}
 
 func resourceNsxtL4PortSetNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtL4PortSetNsServiceCreate,
-		Read:   resourceNsxtL4PortSetNsServiceRead,
-		Update: resourceNsxtL4PortSetNsServiceUpdate,
-		Delete: resourceNsxtL4PortSetNsServiceDelete,
+		CreateContext: resourceNsxtL4PortSetNsServiceCreate,
+		ReadContext:   resourceNsxtL4PortSetNsServiceRead,
+		UpdateContext: resourceNsxtL4PortSetNsServiceUpdate,
+		DeleteContext: resourceNsxtL4PortSetNsServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -72,15 +73,15 @@ func resourceNsxtL4PortSetNsService() *schema.Resource {
 	}
 }
 
-func resourceNsxtL4PortSetNsServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtL4PortSetNsServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	l4Protocol := d.Get("protocol").(string)
 	sourcePorts := getStringListFromSchemaSet(d, "source_ports")
 	destinationPorts := getStringListFromSchemaSet(d, "destination_ports")
@@ -102,25 +103,25 @@ func resourceNsxtL4PortSetNsServiceCreate(d *schema.ResourceData, m interface{})
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateL4PortSetNSService(nsxClient.Context, nsService)
 
 	if err != nil {
-		return fmt.Errorf("Error during NsService create: %v", err)
+		return diag.Errorf("Error during NsService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
-	return resourceNsxtL4PortSetNsServiceRead(d, m)
+	return resourceNsxtL4PortSetNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtL4PortSetNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtL4PortSetNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.ReadL4PortSetNSService(nsxClient.Context, id)
@@ -130,7 +131,7 @@ func resourceNsxtL4PortSetNsServiceRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsService read: %v", err)
+		return diag.Errorf("Error during NsService read: %v", err)
 	}
 
 	nsserviceElement := nsService.NsserviceElement
@@ -138,7 +139,7 @@ func resourceNsxtL4PortSetNsServiceRead(d *schema.ResourceData, m interface{}) e
 	d.Set("revision", nsService.Revision)
 	d.Set("description", nsService.Description)
 	d.Set("display_name", nsService.DisplayName)
-	setTagsInSchema(d, nsService.Tags)
+	setTagsInSchema(d, nsService.Tags, m)
 	d.Set("default_service", nsService.DefaultService)
 	d.Set("protocol", nsserviceElement.L4Protocol)
 	d.Set("destination_ports", nsserviceElement.DestinationPorts)
@@ -147,20 +148,20 @@ func resourceNsxtL4PortSetNsServiceRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtL4PortSetNsServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtL4PortSetNsServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	l4Protocol := d.Get("protocol").(string)
 	sourcePorts := getStringListFromSchemaSet(d, "source_ports")
 	destinationPorts := getStringListFromSchemaSet(d, "destination_ports")
@@ -183,28 +184,28 @@ func resourceNsxtL4PortSetNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateL4PortSetNSService(nsxClient.Context, id, nsService)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsService update: %v %v", err, resp)
+		return diag.Errorf("Error during NsService update: %v %v", err, resp)
 	}
 
-	return resourceNsxtL4PortSetNsServiceRead(d, m)
+	return resourceNsxtL4PortSetNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtL4PortSetNsServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtL4PortSetNsServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSService(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsService delete: %v", err)
+		return diag.Errorf("Error during NsService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtLbFastTCPApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbFastTCPApplicationProfileCreate,
-		Read:   resourceNsxtLbFastTCPApplicationProfileRead,
-		Update: resourceNsxtLbFastTCPApplicationProfileUpdate,
-		Delete: resourceNsxtLbFastTCPApplicationProfileDelete,
+		CreateContext: resourceNsxtLbFastTCPApplicationProfileCreate,
+		ReadContext:   resourceNsxtLbFastTCPApplicationProfileRead,
+		UpdateContext: resourceNsxtLbFastTCPApplicationProfileUpdate,
+		DeleteContext: resourceNsxtLbFastTCPApplicationProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -61,15 +62,15 @@ func resourceNsxtLbFastTCPApplicationProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbFastTCPApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	closeTimeout := int64(d.Get("close_timeout").(int))
 	haFlowMirroringEnabled := d.Get("ha_flow_mirroring").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -85,26 +86,26 @@ func resourceNsxtLbFastTCPApplicationProfileCreate(d *schema.ResourceData, m int
 	lbFastTCPProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerFastTcpProfile(nsxClient.Context, lbFastTCPProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbFastTcpProfile create: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbFastTcpProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbFastTcpProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbFastTCPProfile.Id)
 
-	return resourceNsxtLbFastTCPApplicationProfileRead(d, m)
+	return resourceNsxtLbFastTCPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbFastTCPApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbFastTCPProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerFastTcpProfile(nsxClient.Context, id)
@@ -114,13 +115,13 @@ func resourceNsxtLbFastTCPApplicationProfileRead(d *schema.ResourceData, m inter
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbFastTcpProfile read: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile read: %v", err)
 	}
 
 	d.Set("revision", lbFastTCPProfile.Revision)
 	d.Set("description", lbFastTCPProfile.Description)
 	d.Set("display_name", lbFastTCPProfile.DisplayName)
-	setTagsInSchema(d, lbFastTCPProfile.Tags)
+	setTagsInSchema(d, lbFastTCPProfile.Tags, m)
 	d.Set("close_timeout", lbFastTCPProfile.CloseTimeout)
 	d.Set("ha_flow_mirroring", lbFastTCPProfile.HaFlowMirroringEnabled)
 	d.Set("idle_timeout", lbFastTCPProfile.IdleTimeout)
@@ -128,21 +129,21 @@ func resourceNsxtLbFastTCPApplicationProfileRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtLbFastTCPApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	closeTimeout := int64(d.Get("close_timeout").(int))
 	haFlowMirroringEnabled := d.Get("ha_flow_mirroring").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -159,26 +160,26 @@ func resourceNsxtLbFastTCPApplicationProfileUpdate(d *schema.ResourceData, m int
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerFastTcpProfile(nsxClient.Context, id, lbFastTCPProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbFastTcpProfile update: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile update: %v", err)
 	}
 
-	return resourceNsxtLbFastTCPApplicationProfileRead(d, m)
+	return resourceNsxtLbFastTCPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbFastTCPApplicationProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerApplicationProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbFastTcpProfile delete: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtLbHTTPForwardingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPForwardingRuleCreate,
-		Read:   resourceNsxtLbHTTPForwardingRuleRead,
-		Update: resourceNsxtLbHTTPForwardingRuleUpdate,
-		Delete: resourceNsxtLbHTTPRuleDelete,
+		CreateContext: resourceNsxtLbHTTPForwardingRuleCreate,
+		ReadContext:   resourceNsxtLbHTTPForwardingRuleRead,
+		UpdateContext: resourceNsxtLbHTTPForwardingRuleUpdate,
+		DeleteContext: resourceNsxtLbHTTPRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -393,15 +394,15 @@ func setLbRuleForwardingActionsInSchema(d *schema.ResourceData, actions []loadba
 	return nil
 }
 
-func resourceNsxtLbHTTPForwardingRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPForwardingRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPForwardingConditionsFromSchema(d)
 	actions := getLbRuleForwardingActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -420,26 +421,26 @@ func resourceNsxtLbHTTPForwardingRuleCreate(d *schema.ResourceData, m interface{
 	lbRule, resp, err := nsxClient.ServicesApi.CreateLoadBalancerRule(nsxClient.Context, lbRule)
 
 	if err != nil {
-		return fmt.Errorf("Error during LoadBalancerRule create: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
 	}
 	d.SetId(lbRule.Id)
 
-	return resourceNsxtLbHTTPForwardingRuleRead(d, m)
+	return resourceNsxtLbHTTPForwardingRuleRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPForwardingRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPForwardingRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbRule, resp, err := nsxClient.ServicesApi.ReadLoadBalancerRule(nsxClient.Context, id)
@@ -449,13 +450,13 @@ func resourceNsxtLbHTTPForwardingRuleRead(d *schema.ResourceData, m interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LoadBalancerRule read: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule read: %v", err)
 	}
 
 	d.Set("revision", lbRule.Revision)
 	d.Set("description", lbRule.Description)
 	d.Set("display_name", lbRule.DisplayName)
-	setTagsInSchema(d, lbRule.Tags)
+	setTagsInSchema(d, lbRule.Tags, m)
 	setLbRuleHTTPForwardingConditionsInSchema(d, lbRule.MatchConditions)
 	d.Set("match_strategy", lbRule.MatchStrategy)
 	err = setLbRuleForwardingActionsInSchema(d, lbRule.Actions)
@@ -469,21 +470,21 @@ func resourceNsxtLbHTTPForwardingRuleRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNsxtLbHTTPForwardingRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPForwardingRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPForwardingConditionsFromSchema(d)
 	actions := getLbRuleForwardingActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -503,8 +504,8 @@ func resourceNsxtLbHTTPForwardingRuleUpdate(d *schema.ResourceData, m interface{
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerRule(nsxClient.Context, id, lbRule)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LoadBalancerRule update: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPForwardingRuleRead(d, m)
+	return resourceNsxtLbHTTPForwardingRuleRead(ctx, d, m)
 }
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtLbHTTPResponseRewriteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPResponseRewriteRuleCreate,
-		Read:   resourceNsxtLbHTTPResponseRewriteRuleRead,
-		Update: resourceNsxtLbHTTPResponseRewriteRuleUpdate,
-		Delete: resourceNsxtLbHTTPRuleDelete,
+		CreateContext: resourceNsxtLbHTTPResponseRewriteRuleCreate,
+		ReadContext:   resourceNsxtLbHTTPResponseRewriteRuleRead,
+		UpdateContext: resourceNsxtLbHTTPResponseRewriteRuleUpdate,
+		DeleteContext: resourceNsxtLbHTTPRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -310,15 +311,15 @@ func setLbRuleResponseRewriteActionsInSchema(d *schema.ResourceData, actions []l
 	return nil
 }
 
-func resourceNsxtLbHTTPResponseRewriteRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPResponseRewriteRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPResponseConditionsFromSchema(d)
 	actions := getLbRuleResponseRewriteActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -337,26 +338,26 @@ func resourceNsxtLbHTTPResponseRewriteRuleCreate(d *schema.ResourceData, m inter
 	lbRule, resp, err := nsxClient.ServicesApi.CreateLoadBalancerRule(nsxClient.Context, lbRule)
 
 	if err != nil {
-		return fmt.Errorf("Error during LoadBalancerRule create: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
 	}
 	d.SetId(lbRule.Id)
 
-	return resourceNsxtLbHTTPResponseRewriteRuleRead(d, m)
+	return resourceNsxtLbHTTPResponseRewriteRuleRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPResponseRewriteRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPResponseRewriteRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbRule, resp, err := nsxClient.ServicesApi.ReadLoadBalancerRule(nsxClient.Context, id)
@@ -366,13 +367,13 @@ func resourceNsxtLbHTTPResponseRewriteRuleRead(d *schema.ResourceData, m interfa
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LoadBalancerRule read: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule read: %v", err)
 	}
 
 	d.Set("revision", lbRule.Revision)
 	d.Set("description", lbRule.Description)
 	d.Set("display_name", lbRule.DisplayName)
-	setTagsInSchema(d, lbRule.Tags)
+	setTagsInSchema(d, lbRule.Tags, m)
 	setLbRuleHTTPResponseConditionsInSchema(d, lbRule.MatchConditions)
 	d.Set("match_strategy", lbRule.MatchStrategy)
 	err = setLbRuleResponseRewriteActionsInSchema(d, lbRule.Actions)
@@ -385,21 +386,21 @@ func resourceNsxtLbHTTPResponseRewriteRuleRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func resourceNsxtLbHTTPResponseRewriteRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPResponseRewriteRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	matchConditions := getLbRuleHTTPResponseConditionsFromSchema(d)
 	actions := getLbRuleResponseRewriteActionsFromSchema(d)
 	matchStrategy := d.Get("match_strategy").(string)
@@ -419,8 +420,8 @@ func resourceNsxtLbHTTPResponseRewriteRuleUpdate(d *schema.ResourceData, m inter
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerRule(nsxClient.Context, id, lbRule)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LoadBalancerRule update: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPResponseRewriteRuleRead(d, m)
+	return resourceNsxtLbHTTPResponseRewriteRuleRead(ctx, d, m)
 }
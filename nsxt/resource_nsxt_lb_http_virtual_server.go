@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbHTTPVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPVirtualServerCreate,
-		Read:   resourceNsxtLbHTTPVirtualServerRead,
-		Update: resourceNsxtLbHTTPVirtualServerUpdate,
-		Delete: resourceNsxtLbHTTPVirtualServerDelete,
+		CreateContext: resourceNsxtLbHTTPVirtualServerCreate,
+		ReadContext:   resourceNsxtLbHTTPVirtualServerRead,
+		UpdateContext: resourceNsxtLbHTTPVirtualServerUpdate,
+		DeleteContext: resourceNsxtLbHTTPVirtualServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -268,16 +269,16 @@ func setServerSSLBindingInSchema(d *schema.ResourceData, binding *loadbalancer.S
 	}
 }
 
-func resourceNsxtLbHTTPVirtualServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPVirtualServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var defaultPoolMemberPorts []string
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	clientSslProfileBinding := getClientSSLBindingFromSchema(d)
@@ -319,26 +320,26 @@ func resourceNsxtLbHTTPVirtualServerCreate(d *schema.ResourceData, m interface{}
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.CreateLoadBalancerVirtualServer(nsxClient.Context, lbVirtualServer)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer create: %v", err)
+		return diag.Errorf("Error during LbVirtualServer create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
 	}
 	d.SetId(lbVirtualServer.Id)
 
-	return resourceNsxtLbHTTPVirtualServerRead(d, m)
+	return resourceNsxtLbHTTPVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPVirtualServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPVirtualServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.ReadLoadBalancerVirtualServer(nsxClient.Context, id)
@@ -348,13 +349,13 @@ func resourceNsxtLbHTTPVirtualServerRead(d *schema.ResourceData, m interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer read: %v", err)
+		return diag.Errorf("Error during LbVirtualServer read: %v", err)
 	}
 
 	d.Set("revision", lbVirtualServer.Revision)
 	d.Set("description", lbVirtualServer.Description)
 	d.Set("display_name", lbVirtualServer.DisplayName)
-	setTagsInSchema(d, lbVirtualServer.Tags)
+	setTagsInSchema(d, lbVirtualServer.Tags, m)
 	d.Set("access_log_enabled", lbVirtualServer.AccessLogEnabled)
 	d.Set("application_profile_id", lbVirtualServer.ApplicationProfileId)
 	setClientSSLBindingInSchema(d, lbVirtualServer.ClientSslProfileBinding)
@@ -375,22 +376,22 @@ func resourceNsxtLbHTTPVirtualServerRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNsxtLbHTTPVirtualServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	var defaultPoolMemberPorts []string
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	clientSslProfileBinding := getClientSSLBindingFromSchema(d)
@@ -433,27 +434,27 @@ func resourceNsxtLbHTTPVirtualServerUpdate(d *schema.ResourceData, m interface{}
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerVirtualServer(nsxClient.Context, id, lbVirtualServer)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbVirtualServer update: %v", err)
+		return diag.Errorf("Error during LbVirtualServer update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPVirtualServerRead(d, m)
+	return resourceNsxtLbHTTPVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPVirtualServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPVirtualServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerVirtualServer(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer delete: %v", err)
+		return diag.Errorf("Error during LbVirtualServer delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtLbHTTPSMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPSMonitorCreate,
-		Read:   resourceNsxtLbHTTPSMonitorRead,
-		Update: resourceNsxtLbHTTPSMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbHTTPSMonitorCreate,
+		ReadContext:   resourceNsxtLbHTTPSMonitorRead,
+		UpdateContext: resourceNsxtLbHTTPSMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -71,15 +72,15 @@ func resourceNsxtLbHTTPSMonitor() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbHTTPSMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPSMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -129,26 +130,26 @@ func resourceNsxtLbHTTPSMonitorCreate(d *schema.ResourceData, m interface{}) err
 	lbHTTPSMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerHttpsMonitor(nsxClient.Context, lbHTTPSMonitor)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbHttpsMonitor create: %v", err)
+		return diag.Errorf("Error during LbHttpsMonitor create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbHttpsMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbHttpsMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbHTTPSMonitor.Id)
 
-	return resourceNsxtLbHTTPSMonitorRead(d, m)
+	return resourceNsxtLbHTTPSMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPSMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPSMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbHTTPSMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerHttpsMonitor(nsxClient.Context, id)
@@ -158,13 +159,13 @@ func resourceNsxtLbHTTPSMonitorRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbHttpsMonitor read: %v", err)
+		return diag.Errorf("Error during LbHttpsMonitor read: %v", err)
 	}
 
 	d.Set("revision", lbHTTPSMonitor.Revision)
 	d.Set("description", lbHTTPSMonitor.Description)
 	d.Set("display_name", lbHTTPSMonitor.DisplayName)
-	setTagsInSchema(d, lbHTTPSMonitor.Tags)
+	setTagsInSchema(d, lbHTTPSMonitor.Tags, m)
 	d.Set("fall_count", lbHTTPSMonitor.FallCount)
 	d.Set("interval", lbHTTPSMonitor.Interval)
 	d.Set("monitor_port", lbHTTPSMonitor.MonitorPort)
@@ -189,21 +190,21 @@ func resourceNsxtLbHTTPSMonitorRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtLbHTTPSMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPSMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -254,8 +255,8 @@ func resourceNsxtLbHTTPSMonitorUpdate(d *schema.ResourceData, m interface{}) err
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerHttpsMonitor(nsxClient.Context, id, lbHTTPSMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbHttpsMonitor update: %v", err)
+		return diag.Errorf("Error during LbHttpsMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPSMonitorRead(d, m)
+	return resourceNsxtLbHTTPSMonitorRead(ctx, d, m)
 }
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/common"
@@ -21,10 +22,10 @@ var ipRevisionFilterTypeValues = []string{"IPV4", "IPV6"}
 
 func resourceNsxtLbPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbPoolCreate,
-		Read:   resourceNsxtLbPoolRead,
-		Update: resourceNsxtLbPoolUpdate,
-		Delete: resourceNsxtLbPoolDelete,
+		CreateContext: resourceNsxtLbPoolCreate,
+		ReadContext:   resourceNsxtLbPoolRead,
+		UpdateContext: resourceNsxtLbPoolUpdate,
+		DeleteContext: resourceNsxtLbPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -349,15 +350,15 @@ func getPoolMemberGroupFromSchema(d *schema.ResourceData) *loadbalancer.PoolMemb
 	return nil
 }
 
-func resourceNsxtLbPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	activeMonitorIds := getActiveMonitorIdsFromSchema(d)
 	passiveMonitorID := d.Get("passive_monitor_id").(string)
 	algorithm := d.Get("algorithm").(string)
@@ -385,26 +386,26 @@ func resourceNsxtLbPoolCreate(d *schema.ResourceData, m interface{}) error {
 	lbPool, resp, err := nsxClient.ServicesApi.CreateLoadBalancerPool(nsxClient.Context, lbPool)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbPool create: %v", err)
+		return diag.Errorf("Error during LbPool create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbPool create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbPool create: %v", resp.StatusCode)
 	}
 	d.SetId(lbPool.Id)
 
-	return resourceNsxtLbPoolRead(d, m)
+	return resourceNsxtLbPoolRead(ctx, d, m)
 }
 
-func resourceNsxtLbPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbPool, resp, err := nsxClient.ServicesApi.ReadLoadBalancerPool(nsxClient.Context, id)
@@ -414,13 +415,13 @@ func resourceNsxtLbPoolRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbPool read: %v", err)
+		return diag.Errorf("Error during LbPool read: %v", err)
 	}
 
 	d.Set("revision", lbPool.Revision)
 	d.Set("description", lbPool.Description)
 	d.Set("display_name", lbPool.DisplayName)
-	setTagsInSchema(d, lbPool.Tags)
+	setTagsInSchema(d, lbPool.Tags, m)
 	if lbPool.ActiveMonitorIds != nil && len(lbPool.ActiveMonitorIds) > 0 {
 		d.Set("active_monitor_id", lbPool.ActiveMonitorIds[0])
 	} else {
@@ -430,16 +431,16 @@ func resourceNsxtLbPoolRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("algorithm", lbPool.Algorithm)
 	err = setPoolMembersInSchema(d, lbPool.Members)
 	if err != nil {
-		return fmt.Errorf("Error during LB Pool members set in schema: %v", err)
+		return diag.Errorf("Error during LB Pool members set in schema: %v", err)
 	}
 	err = setPoolGroupMemberInSchema(d, lbPool.MemberGroup)
 	if err != nil {
-		return fmt.Errorf("Error during LB Pool group member set in schema: %v", err)
+		return diag.Errorf("Error during LB Pool group member set in schema: %v", err)
 	}
 	d.Set("min_active_members", lbPool.MinActiveMembers)
 	err = setSnatTranslationInSchema(d, lbPool.SnatTranslation)
 	if err != nil {
-		return fmt.Errorf("Error during LB Pool SNAT translation set in schema: %v", err)
+		return diag.Errorf("Error during LB Pool SNAT translation set in schema: %v", err)
 	}
 	d.Set("tcp_multiplexing_enabled", lbPool.TcpMultiplexingEnabled)
 	d.Set("tcp_multiplexing_number", lbPool.TcpMultiplexingNumber)
@@ -447,21 +448,21 @@ func resourceNsxtLbPoolRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtLbPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	activeMonitorIds := getActiveMonitorIdsFromSchema(d)
 	passiveMonitorID := d.Get("passive_monitor_id").(string)
 	algorithm := d.Get("algorithm").(string)
@@ -490,26 +491,26 @@ func resourceNsxtLbPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerPool(nsxClient.Context, id, lbPool)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbPool update: %v", err)
+		return diag.Errorf("Error during LbPool update: %v", err)
 	}
 
-	return resourceNsxtLbPoolRead(d, m)
+	return resourceNsxtLbPoolRead(ctx, d, m)
 }
 
-func resourceNsxtLbPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerPool(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbPool delete: %v", err)
+		return diag.Errorf("Error during LbPool delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
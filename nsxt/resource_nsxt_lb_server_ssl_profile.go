@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbServerSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbServerSslProfileCreate,
-		Read:   resourceNsxtLbServerSslProfileRead,
-		Update: resourceNsxtLbServerSslProfileUpdate,
-		Delete: resourceNsxtLbServerSslProfileDelete,
+		CreateContext: resourceNsxtLbServerSslProfileCreate,
+		ReadContext:   resourceNsxtLbServerSslProfileRead,
+		UpdateContext: resourceNsxtLbServerSslProfileUpdate,
+		DeleteContext: resourceNsxtLbServerSslProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -49,15 +50,15 @@ func resourceNsxtLbServerSslProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbServerSslProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	protocols := getStringListFromSchemaSet(d, "protocols")
 	sessionCacheEnabled := d.Get("session_cache_enabled").(bool)
@@ -73,26 +74,26 @@ func resourceNsxtLbServerSslProfileCreate(d *schema.ResourceData, m interface{})
 	lbServerSslProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerServerSslProfile(nsxClient.Context, lbServerSslProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbServerSslProfile create: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbServerSslProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbServerSslProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbServerSslProfile.Id)
 
-	return resourceNsxtLbServerSslProfileRead(d, m)
+	return resourceNsxtLbServerSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbServerSslProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbServerSslProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerServerSslProfile(nsxClient.Context, id)
@@ -102,13 +103,13 @@ func resourceNsxtLbServerSslProfileRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbServerSslProfile read: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile read: %v", err)
 	}
 
 	d.Set("revision", lbServerSslProfile.Revision)
 	d.Set("description", lbServerSslProfile.Description)
 	d.Set("display_name", lbServerSslProfile.DisplayName)
-	setTagsInSchema(d, lbServerSslProfile.Tags)
+	setTagsInSchema(d, lbServerSslProfile.Tags, m)
 	d.Set("ciphers", lbServerSslProfile.Ciphers)
 	d.Set("is_secure", lbServerSslProfile.IsSecure)
 	d.Set("protocols", lbServerSslProfile.Protocols)
@@ -117,21 +118,21 @@ func resourceNsxtLbServerSslProfileRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtLbServerSslProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	protocols := getStringListFromSchemaSet(d, "protocols")
 	sessionCacheEnabled := d.Get("session_cache_enabled").(bool)
@@ -148,26 +149,26 @@ func resourceNsxtLbServerSslProfileUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerServerSslProfile(nsxClient.Context, id, lbServerSslProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbServerSslProfile update: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile update: %v", err)
 	}
 
-	return resourceNsxtLbServerSslProfileRead(d, m)
+	return resourceNsxtLbServerSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbServerSslProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerServerSslProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbServerSslProfile delete: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
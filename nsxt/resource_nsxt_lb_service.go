@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -18,10 +19,10 @@ var lbServiceSizes = []string{"SMALL", "MEDIUM", "LARGE"}
 
 func resourceNsxtLbService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbServiceCreate,
-		Read:   resourceNsxtLbServiceRead,
-		Update: resourceNsxtLbServiceUpdate,
-		Delete: resourceNsxtLbServiceDelete,
+		CreateContext: resourceNsxtLbServiceCreate,
+		ReadContext:   resourceNsxtLbServiceRead,
+		UpdateContext: resourceNsxtLbServiceUpdate,
+		DeleteContext: resourceNsxtLbServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -80,15 +81,15 @@ func resourceNsxtLbService() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
@@ -109,26 +110,26 @@ func resourceNsxtLbServiceCreate(d *schema.ResourceData, m interface{}) error {
 	lbService, resp, err := nsxClient.ServicesApi.CreateLoadBalancerService(nsxClient.Context, lbService)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbService create: %v", err)
+		return diag.Errorf("Error during LbService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbService create: %v", resp.StatusCode)
 	}
 	d.SetId(lbService.Id)
 
-	return resourceNsxtLbServiceRead(d, m)
+	return resourceNsxtLbServiceRead(ctx, d, m)
 }
 
-func resourceNsxtLbServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbService, resp, err := nsxClient.ServicesApi.ReadLoadBalancerService(nsxClient.Context, id)
@@ -138,16 +139,16 @@ func resourceNsxtLbServiceRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbService read: %v", err)
+		return diag.Errorf("Error during LbService read: %v", err)
 	}
 
 	d.Set("revision", lbService.Revision)
 	d.Set("description", lbService.Description)
 	d.Set("display_name", lbService.DisplayName)
-	setTagsInSchema(d, lbService.Tags)
+	setTagsInSchema(d, lbService.Tags, m)
 	if lbService.Attachment != nil {
 		if lbService.Attachment.TargetType != "LogicalRouter" {
-			return fmt.Errorf("Error during LbService attachment read: attachment type %s is not supported", lbService.Attachment.TargetType)
+			return diag.Errorf("Error during LbService attachment read: attachment type %s is not supported", lbService.Attachment.TargetType)
 		}
 		d.Set("logical_router_id", lbService.Attachment.TargetId)
 	}
@@ -159,21 +160,21 @@ func resourceNsxtLbServiceRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtLbServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
@@ -194,26 +195,26 @@ func resourceNsxtLbServiceUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerService(nsxClient.Context, id, lbService)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbService update: %v", err)
+		return diag.Errorf("Error during LbService update: %v", err)
 	}
 
-	return resourceNsxtLbServiceRead(d, m)
+	return resourceNsxtLbServiceRead(ctx, d, m)
 }
 
-func resourceNsxtLbServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerService(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbService delete: %v", err)
+		return diag.Errorf("Error during LbService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbSourceIPPersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbSourceIPPersistenceProfileCreate,
-		Read:   resourceNsxtLbSourceIPPersistenceProfileRead,
-		Update: resourceNsxtLbSourceIPPersistenceProfileUpdate,
-		Delete: resourceNsxtLbSourceIPPersistenceProfileDelete,
+		CreateContext: resourceNsxtLbSourceIPPersistenceProfileCreate,
+		ReadContext:   resourceNsxtLbSourceIPPersistenceProfileRead,
+		UpdateContext: resourceNsxtLbSourceIPPersistenceProfileUpdate,
+		DeleteContext: resourceNsxtLbSourceIPPersistenceProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -63,15 +64,15 @@ func resourceNsxtLbSourceIPPersistenceProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbSourceIPPersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbSourceIPPersistenceProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	persistenceShared := d.Get("persistence_shared").(bool)
 	haPersistenceMirroring := d.Get("ha_persistence_mirroring").(bool)
 	purgeFlag := d.Get("purge_when_full").(bool)
@@ -93,26 +94,26 @@ func resourceNsxtLbSourceIPPersistenceProfileCreate(d *schema.ResourceData, m in
 	lbSourceIPPersistenceProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerSourceIpPersistenceProfile(nsxClient.Context, lbSourceIPPersistenceProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbSourceIPPersistenceProfile create: %v", err)
+		return diag.Errorf("Error during LbSourceIPPersistenceProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbSourceIPPersistenceProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbSourceIPPersistenceProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbSourceIPPersistenceProfile.Id)
 
-	return resourceNsxtLbSourceIPPersistenceProfileRead(d, m)
+	return resourceNsxtLbSourceIPPersistenceProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbSourceIPPersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbSourceIPPersistenceProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbSourceIPPersistenceProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerSourceIpPersistenceProfile(nsxClient.Context, id)
@@ -122,13 +123,13 @@ func resourceNsxtLbSourceIPPersistenceProfileRead(d *schema.ResourceData, m inte
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbSourceIPPersistenceProfile read: %v", err)
+		return diag.Errorf("Error during LbSourceIPPersistenceProfile read: %v", err)
 	}
 
 	d.Set("revision", lbSourceIPPersistenceProfile.Revision)
 	d.Set("description", lbSourceIPPersistenceProfile.Description)
 	d.Set("display_name", lbSourceIPPersistenceProfile.DisplayName)
-	setTagsInSchema(d, lbSourceIPPersistenceProfile.Tags)
+	setTagsInSchema(d, lbSourceIPPersistenceProfile.Tags, m)
 	d.Set("persistence_shared", lbSourceIPPersistenceProfile.PersistenceShared)
 	d.Set("ha_persistence_mirroring", lbSourceIPPersistenceProfile.HaPersistenceMirroringEnabled)
 	if lbSourceIPPersistenceProfile.Purge == "FULL" {
@@ -141,21 +142,21 @@ func resourceNsxtLbSourceIPPersistenceProfileRead(d *schema.ResourceData, m inte
 	return nil
 }
 
-func resourceNsxtLbSourceIPPersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbSourceIPPersistenceProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	persistenceShared := d.Get("persistence_shared").(bool)
 	haPersistenceMirroring := d.Get("ha_persistence_mirroring").(bool)
 	purgeFlag := d.Get("purge_when_full").(bool)
@@ -178,26 +179,26 @@ func resourceNsxtLbSourceIPPersistenceProfileUpdate(d *schema.ResourceData, m in
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerSourceIpPersistenceProfile(nsxClient.Context, id, lbSourceIPPersistenceProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbSourceIPPersistenceProfile update: %v", err)
+		return diag.Errorf("Error during LbSourceIPPersistenceProfile update: %v", err)
 	}
 
-	return resourceNsxtLbSourceIPPersistenceProfileRead(d, m)
+	return resourceNsxtLbSourceIPPersistenceProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbSourceIPPersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbSourceIPPersistenceProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerPersistenceProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbSourceIPPersistenceProfile delete: %v", err)
+		return diag.Errorf("Error during LbSourceIPPersistenceProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbTCPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbTCPMonitorCreate,
-		Read:   resourceNsxtLbTCPMonitorRead,
-		Update: resourceNsxtLbTCPMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbTCPMonitorCreate,
+		ReadContext:   resourceNsxtLbTCPMonitorRead,
+		UpdateContext: resourceNsxtLbTCPMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -26,15 +27,15 @@ func resourceNsxtLbTCPMonitor() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbTCPMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -58,26 +59,26 @@ func resourceNsxtLbTCPMonitorCreate(d *schema.ResourceData, m interface{}) error
 	lbTCPMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerTcpMonitor(nsxClient.Context, lbTCPMonitor)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor create: %v", err)
+		return diag.Errorf("Error during LbMonitor create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbTCPMonitor.Id)
 
-	return resourceNsxtLbTCPMonitorRead(d, m)
+	return resourceNsxtLbTCPMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbTCPMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbTCPMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerTcpMonitor(nsxClient.Context, id)
@@ -87,13 +88,13 @@ func resourceNsxtLbTCPMonitorRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor read: %v", err)
+		return diag.Errorf("Error during LbMonitor read: %v", err)
 	}
 
 	d.Set("revision", lbTCPMonitor.Revision)
 	d.Set("description", lbTCPMonitor.Description)
 	d.Set("display_name", lbTCPMonitor.DisplayName)
-	setTagsInSchema(d, lbTCPMonitor.Tags)
+	setTagsInSchema(d, lbTCPMonitor.Tags, m)
 	d.Set("fall_count", lbTCPMonitor.FallCount)
 	d.Set("interval", lbTCPMonitor.Interval)
 	d.Set("monitor_port", lbTCPMonitor.MonitorPort)
@@ -105,21 +106,21 @@ func resourceNsxtLbTCPMonitorRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtLbTCPMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -144,8 +145,8 @@ func resourceNsxtLbTCPMonitorUpdate(d *schema.ResourceData, m interface{}) error
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerTcpMonitor(nsxClient.Context, id, lbTCPMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbMonitor update: %v", err)
+		return diag.Errorf("Error during LbMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbTCPMonitorRead(d, m)
+	return resourceNsxtLbTCPMonitorRead(ctx, d, m)
 }
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbTCPVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbTCPVirtualServerCreate,
-		Read:   resourceNsxtLbTCPVirtualServerRead,
-		Update: resourceNsxtLbTCPVirtualServerUpdate,
-		Delete: resourceNsxtLbTCPVirtualServerDelete,
+		CreateContext: resourceNsxtLbTCPVirtualServerCreate,
+		ReadContext:   resourceNsxtLbTCPVirtualServerRead,
+		UpdateContext: resourceNsxtLbTCPVirtualServerUpdate,
+		DeleteContext: resourceNsxtLbTCPVirtualServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -107,15 +108,15 @@ func resourceNsxtLbTCPVirtualServer() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbTCPVirtualServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPVirtualServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	defaultPoolMemberPorts := interface2StringList(d.Get("default_pool_member_ports").([]interface{}))
@@ -148,26 +149,26 @@ func resourceNsxtLbTCPVirtualServerCreate(d *schema.ResourceData, m interface{})
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.CreateLoadBalancerVirtualServer(nsxClient.Context, lbVirtualServer)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer create: %v", err)
+		return diag.Errorf("Error during LbVirtualServer create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
 	}
 	d.SetId(lbVirtualServer.Id)
 
-	return resourceNsxtLbTCPVirtualServerRead(d, m)
+	return resourceNsxtLbTCPVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtLbTCPVirtualServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPVirtualServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.ReadLoadBalancerVirtualServer(nsxClient.Context, id)
@@ -177,13 +178,13 @@ func resourceNsxtLbTCPVirtualServerRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer read: %v", err)
+		return diag.Errorf("Error during LbVirtualServer read: %v", err)
 	}
 
 	d.Set("revision", lbVirtualServer.Revision)
 	d.Set("description", lbVirtualServer.Description)
 	d.Set("display_name", lbVirtualServer.DisplayName)
-	setTagsInSchema(d, lbVirtualServer.Tags)
+	setTagsInSchema(d, lbVirtualServer.Tags, m)
 	d.Set("access_log_enabled", lbVirtualServer.AccessLogEnabled)
 	d.Set("application_profile_id", lbVirtualServer.ApplicationProfileId)
 	d.Set("default_pool_member_ports", lbVirtualServer.DefaultPoolMemberPorts)
@@ -199,21 +200,21 @@ func resourceNsxtLbTCPVirtualServerRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtLbTCPVirtualServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	defaultPoolMemberPorts := interface2StringList(d.Get("default_pool_member_ports").([]interface{}))
@@ -247,27 +248,27 @@ func resourceNsxtLbTCPVirtualServerUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerVirtualServer(nsxClient.Context, id, lbVirtualServer)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbVirtualServer update: %v", err)
+		return diag.Errorf("Error during LbVirtualServer update: %v", err)
 	}
 
-	return resourceNsxtLbTCPVirtualServerRead(d, m)
+	return resourceNsxtLbTCPVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtLbTCPVirtualServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPVirtualServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerVirtualServer(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer delete: %v", err)
+		return diag.Errorf("Error during LbVirtualServer delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbUDPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbUDPMonitorCreate,
-		Read:   resourceNsxtLbUDPMonitorRead,
-		Update: resourceNsxtLbUDPMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbUDPMonitorCreate,
+		ReadContext:   resourceNsxtLbUDPMonitorRead,
+		UpdateContext: resourceNsxtLbUDPMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -26,15 +27,15 @@ func resourceNsxtLbUDPMonitor() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbUDPMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -58,26 +59,26 @@ func resourceNsxtLbUDPMonitorCreate(d *schema.ResourceData, m interface{}) error
 	lbUDPMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerUdpMonitor(nsxClient.Context, lbUDPMonitor)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor create: %v", err)
+		return diag.Errorf("Error during LbMonitor create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbUDPMonitor.Id)
 
-	return resourceNsxtLbUDPMonitorRead(d, m)
+	return resourceNsxtLbUDPMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbUDPMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbUDPMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerUdpMonitor(nsxClient.Context, id)
@@ -87,13 +88,13 @@ func resourceNsxtLbUDPMonitorRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor read: %v", err)
+		return diag.Errorf("Error during LbMonitor read: %v", err)
 	}
 
 	d.Set("revision", lbUDPMonitor.Revision)
 	d.Set("description", lbUDPMonitor.Description)
 	d.Set("display_name", lbUDPMonitor.DisplayName)
-	setTagsInSchema(d, lbUDPMonitor.Tags)
+	setTagsInSchema(d, lbUDPMonitor.Tags, m)
 	d.Set("fall_count", lbUDPMonitor.FallCount)
 	d.Set("interval", lbUDPMonitor.Interval)
 	d.Set("monitor_port", lbUDPMonitor.MonitorPort)
@@ -105,21 +106,21 @@ func resourceNsxtLbUDPMonitorRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtLbUDPMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	fallCount := int64(d.Get("fall_count").(int))
 	interval := int64(d.Get("interval").(int))
 	monitorPort := d.Get("monitor_port").(string)
@@ -144,8 +145,8 @@ func resourceNsxtLbUDPMonitorUpdate(d *schema.ResourceData, m interface{}) error
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerUdpMonitor(nsxClient.Context, id, lbUDPMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbMonitor update: %v", err)
+		return diag.Errorf("Error during LbMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbUDPMonitorRead(d, m)
+	return resourceNsxtLbUDPMonitorRead(ctx, d, m)
 }
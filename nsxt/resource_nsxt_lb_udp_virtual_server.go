@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbUDPVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbUDPVirtualServerCreate,
-		Read:   resourceNsxtLbUDPVirtualServerRead,
-		Update: resourceNsxtLbUDPVirtualServerUpdate,
-		Delete: resourceNsxtLbUDPVirtualServerDelete,
+		CreateContext: resourceNsxtLbUDPVirtualServerCreate,
+		ReadContext:   resourceNsxtLbUDPVirtualServerRead,
+		UpdateContext: resourceNsxtLbUDPVirtualServerUpdate,
+		DeleteContext: resourceNsxtLbUDPVirtualServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -107,15 +108,15 @@ func resourceNsxtLbUDPVirtualServer() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbUDPVirtualServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	defaultPoolMemberPorts := interface2StringList(d.Get("default_pool_member_ports").([]interface{}))
@@ -148,26 +149,26 @@ func resourceNsxtLbUDPVirtualServerCreate(d *schema.ResourceData, m interface{})
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.CreateLoadBalancerVirtualServer(nsxClient.Context, lbVirtualServer)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer create: %v", err)
+		return diag.Errorf("Error during LbVirtualServer create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
 	}
 	d.SetId(lbVirtualServer.Id)
 
-	return resourceNsxtLbUDPVirtualServerRead(d, m)
+	return resourceNsxtLbUDPVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtLbUDPVirtualServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.ReadLoadBalancerVirtualServer(nsxClient.Context, id)
@@ -177,13 +178,13 @@ func resourceNsxtLbUDPVirtualServerRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer read: %v", err)
+		return diag.Errorf("Error during LbVirtualServer read: %v", err)
 	}
 
 	d.Set("revision", lbVirtualServer.Revision)
 	d.Set("description", lbVirtualServer.Description)
 	d.Set("display_name", lbVirtualServer.DisplayName)
-	setTagsInSchema(d, lbVirtualServer.Tags)
+	setTagsInSchema(d, lbVirtualServer.Tags, m)
 	d.Set("access_log_enabled", lbVirtualServer.AccessLogEnabled)
 	d.Set("application_profile_id", lbVirtualServer.ApplicationProfileId)
 	d.Set("default_pool_member_ports", lbVirtualServer.DefaultPoolMemberPorts)
@@ -199,21 +200,21 @@ func resourceNsxtLbUDPVirtualServerRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtLbUDPVirtualServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfileID := d.Get("application_profile_id").(string)
 	defaultPoolMemberPorts := interface2StringList(d.Get("default_pool_member_ports").([]interface{}))
@@ -247,28 +248,28 @@ func resourceNsxtLbUDPVirtualServerUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerVirtualServer(nsxClient.Context, id, lbVirtualServer)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbVirtualServer update: %v", err)
+		return diag.Errorf("Error during LbVirtualServer update: %v", err)
 	}
 
-	return resourceNsxtLbUDPVirtualServerRead(d, m)
+	return resourceNsxtLbUDPVirtualServerRead(ctx, d, m)
 }
 
 // TODO: move this and other common VS code to utils
-func resourceNsxtLbUDPVirtualServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerVirtualServer(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer delete: %v", err)
+		return diag.Errorf("Error during LbVirtualServer delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
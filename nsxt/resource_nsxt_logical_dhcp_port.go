@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
@@ -16,10 +17,10 @@ var dhcpType = "DHCP_SERVICE"
 
 func resourceNsxtLogicalDhcpPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalDhcpPortCreate,
-		Read:   resourceNsxtLogicalDhcpPortRead,
-		Update: resourceNsxtLogicalDhcpPortUpdate,
-		Delete: resourceNsxtLogicalDhcpPortDelete,
+		CreateContext: resourceNsxtLogicalDhcpPortCreate,
+		ReadContext:   resourceNsxtLogicalDhcpPortRead,
+		UpdateContext: resourceNsxtLogicalDhcpPortUpdate,
+		DeleteContext: resourceNsxtLogicalDhcpPortDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -54,17 +55,17 @@ func resourceNsxtLogicalDhcpPort() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalDhcpPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	name := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	lsID := d.Get("logical_switch_id").(string)
 	adminState := d.Get("admin_state").(string)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 	dhcpServerID := d.Get("dhcp_server_id").(string)
 	attachment := manager.LogicalPortAttachment{
 		AttachmentType: dhcpType,
@@ -82,27 +83,27 @@ func resourceNsxtLogicalDhcpPortCreate(d *schema.ResourceData, m interface{}) er
 	lp, resp, err := nsxClient.LogicalSwitchingApi.CreateLogicalPort(nsxClient.Context, lp)
 
 	if err != nil {
-		return fmt.Errorf("Error while creating logical DHCP port %s: %v", name, err)
+		return diag.Errorf("Error while creating logical DHCP port %s: %v", name, err)
 	}
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during Logical DHCP port create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during Logical DHCP port create: %v", resp.StatusCode)
 	}
 
 	resourceID := lp.Id
 	d.SetId(resourceID)
 
-	return resourceNsxtLogicalDhcpPortRead(d, m)
+	return resourceNsxtLogicalDhcpPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalDhcpPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical DHCP port ID from state during read")
+		return diag.Errorf("Error obtaining logical DHCP port ID from state during read")
 	}
 	LogicalDhcpPort, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalPort(nsxClient.Context, id)
 	if resp != nil && resp.StatusCode == http.StatusNotFound {
@@ -111,11 +112,11 @@ func resourceNsxtLogicalDhcpPortRead(d *schema.ResourceData, m interface{}) erro
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error while reading logical DHCP port %s: %v", id, err)
+		return diag.Errorf("Error while reading logical DHCP port %s: %v", id, err)
 	}
 
 	if LogicalDhcpPort.Attachment == nil || LogicalDhcpPort.Attachment.AttachmentType != dhcpType {
-		return fmt.Errorf("Error reading DHCP port %s: This not a DHCP port", id)
+		return diag.Errorf("Error reading DHCP port %s: This not a DHCP port", id)
 	}
 
 	d.Set("revision", LogicalDhcpPort.Revision)
@@ -124,15 +125,15 @@ func resourceNsxtLogicalDhcpPortRead(d *schema.ResourceData, m interface{}) erro
 	d.Set("logical_switch_id", LogicalDhcpPort.LogicalSwitchId)
 	d.Set("admin_state", LogicalDhcpPort.AdminState)
 	d.Set("dhcp_server_id", LogicalDhcpPort.Attachment.Id)
-	setTagsInSchema(d, LogicalDhcpPort.Tags)
+	setTagsInSchema(d, LogicalDhcpPort.Tags, m)
 
 	return nil
 }
 
-func resourceNsxtLogicalDhcpPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
@@ -140,7 +141,7 @@ func resourceNsxtLogicalDhcpPortUpdate(d *schema.ResourceData, m interface{}) er
 	description := d.Get("description").(string)
 	adminState := d.Get("admin_state").(string)
 	lsID := d.Get("logical_switch_id").(string)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	dhcpServerID := d.Get("dhcp_server_id").(string)
 	attachment := manager.LogicalPortAttachment{
@@ -159,27 +160,27 @@ func resourceNsxtLogicalDhcpPortUpdate(d *schema.ResourceData, m interface{}) er
 
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateLogicalPort(nsxClient.Context, id, lp)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error while updating logical DHCP port %s: %v", id, err)
+		return diag.Errorf("Error while updating logical DHCP port %s: %v", id, err)
 	}
-	return resourceNsxtLogicalDhcpPortRead(d, m)
+	return resourceNsxtLogicalDhcpPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalDhcpPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	lpID := d.Id()
 	if lpID == "" {
-		return fmt.Errorf("Error obtaining logical DHCP port ID from state during delete")
+		return diag.Errorf("Error obtaining logical DHCP port ID from state during delete")
 	}
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["detach"] = true
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteLogicalPort(nsxClient.Context, lpID, localVarOptionals)
 
 	if err != nil {
-		return fmt.Errorf("Error while deleting logical DHCP port %s: %v", lpID, err)
+		return diag.Errorf("Error while deleting logical DHCP port %s: %v", lpID, err)
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		log.Printf("[DEBUG] Logical DHCP port %s was not found", lpID)
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtLogicalDhcpServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalDhcpServerCreate,
-		Read:   resourceNsxtLogicalDhcpServerRead,
-		Update: resourceNsxtLogicalDhcpServerUpdate,
-		Delete: resourceNsxtLogicalDhcpServerDelete,
+		CreateContext: resourceNsxtLogicalDhcpServerCreate,
+		ReadContext:   resourceNsxtLogicalDhcpServerRead,
+		UpdateContext: resourceNsxtLogicalDhcpServerUpdate,
+		DeleteContext: resourceNsxtLogicalDhcpServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -183,10 +184,10 @@ func setDhcpGenericOptionsInSchema(d *schema.ResourceData, opts []manager.Generi
 	return err
 }
 
-func resourceNsxtLogicalDhcpServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -209,7 +210,7 @@ func resourceNsxtLogicalDhcpServerCreate(d *schema.ResourceData, m interface{})
 			Others:    getDhcpGenericOptions(d),
 		},
 	}
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalDhcpServer := manager.LogicalDhcpServer{
 		DisplayName:    displayName,
 		Description:    description,
@@ -221,26 +222,26 @@ func resourceNsxtLogicalDhcpServerCreate(d *schema.ResourceData, m interface{})
 	logicalDhcpServer, resp, err := nsxClient.ServicesApi.CreateDhcpServer(nsxClient.Context, logicalDhcpServer)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalDhcpServer create: %v", err)
+		return diag.Errorf("Error during LogicalDhcpServer create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalDhcpServer create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalDhcpServer create: %v", resp.StatusCode)
 	}
 	d.SetId(logicalDhcpServer.Id)
 
-	return resourceNsxtLogicalDhcpServerRead(d, m)
+	return resourceNsxtLogicalDhcpServerRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalDhcpServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalDhcpServer, resp, err := nsxClient.ServicesApi.ReadDhcpServer(nsxClient.Context, id)
@@ -250,13 +251,13 @@ func resourceNsxtLogicalDhcpServerRead(d *schema.ResourceData, m interface{}) er
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalDhcpServer read: %v", err)
+		return diag.Errorf("Error during LogicalDhcpServer read: %v", err)
 	}
 
 	d.Set("revision", logicalDhcpServer.Revision)
 	d.Set("description", logicalDhcpServer.Description)
 	d.Set("display_name", logicalDhcpServer.DisplayName)
-	setTagsInSchema(d, logicalDhcpServer.Tags)
+	setTagsInSchema(d, logicalDhcpServer.Tags, m)
 	d.Set("attached_logical_port_id", logicalDhcpServer.AttachedLogicalPortId)
 	d.Set("dhcp_profile_id", logicalDhcpServer.DhcpProfileId)
 	d.Set("dhcp_server_ip", logicalDhcpServer.Ipv4DhcpServer.DhcpServerIp)
@@ -266,7 +267,7 @@ func resourceNsxtLogicalDhcpServerRead(d *schema.ResourceData, m interface{}) er
 	if logicalDhcpServer.Ipv4DhcpServer.Options != nil && logicalDhcpServer.Ipv4DhcpServer.Options.Option121 != nil {
 		err = setDhcpOptions121InSchema(d, logicalDhcpServer.Ipv4DhcpServer.Options.Option121.StaticRoutes)
 		if err != nil {
-			return fmt.Errorf("Error during LogicalDhcpServer read option 121: %v", err)
+			return diag.Errorf("Error during LogicalDhcpServer read option 121: %v", err)
 		}
 	} else {
 		var emptyDhcpOpt121 []map[string]interface{}
@@ -275,7 +276,7 @@ func resourceNsxtLogicalDhcpServerRead(d *schema.ResourceData, m interface{}) er
 	if logicalDhcpServer.Ipv4DhcpServer.Options != nil {
 		err = setDhcpGenericOptionsInSchema(d, logicalDhcpServer.Ipv4DhcpServer.Options.Others)
 		if err != nil {
-			return fmt.Errorf("Error during LogicalDhcpServer read generic options: %v", err)
+			return diag.Errorf("Error during LogicalDhcpServer read generic options: %v", err)
 		}
 	} else {
 		var emptyDhcpGenOpt []map[string]interface{}
@@ -285,20 +286,20 @@ func resourceNsxtLogicalDhcpServerRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtLogicalDhcpServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	dhcpProfileID := d.Get("dhcp_profile_id").(string)
 	revision := int64(d.Get("revision").(int))
 	opt121Routes := getDhcpOptions121(d)
@@ -330,26 +331,26 @@ func resourceNsxtLogicalDhcpServerUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateDhcpServer(nsxClient.Context, id, logicalDhcpServer)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalDhcpServer update: %v", err)
+		return diag.Errorf("Error during LogicalDhcpServer update: %v", err)
 	}
 
-	return resourceNsxtLogicalDhcpServerRead(d, m)
+	return resourceNsxtLogicalDhcpServerRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalDhcpServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalDhcpServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteDhcpServer(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalDhcpServer delete: %v", err)
+		return diag.Errorf("Error during LogicalDhcpServer delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
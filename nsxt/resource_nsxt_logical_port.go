@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtLogicalPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalPortCreate,
-		Read:   resourceNsxtLogicalPortRead,
-		Update: resourceNsxtLogicalPortUpdate,
-		Delete: resourceNsxtLogicalPortDelete,
+		CreateContext: resourceNsxtLogicalPortCreate,
+		ReadContext:   resourceNsxtLogicalPortRead,
+		UpdateContext: resourceNsxtLogicalPortUpdate,
+		DeleteContext: resourceNsxtLogicalPortDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -48,10 +49,10 @@ func resourceNsxtLogicalPort() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	name := d.Get("display_name").(string)
@@ -59,7 +60,7 @@ func resourceNsxtLogicalPortCreate(d *schema.ResourceData, m interface{}) error
 	lsID := d.Get("logical_switch_id").(string)
 	adminState := d.Get("admin_state").(string)
 	profilesList := getSwitchingProfileIdsFromSchema(d)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 
 	lp := manager.LogicalPort{
 		DisplayName:         name,
@@ -72,27 +73,27 @@ func resourceNsxtLogicalPortCreate(d *schema.ResourceData, m interface{}) error
 	lp, resp, err := nsxClient.LogicalSwitchingApi.CreateLogicalPort(nsxClient.Context, lp)
 
 	if err != nil {
-		return fmt.Errorf("Error while creating logical port %s: %v", lp.DisplayName, err)
+		return diag.Errorf("Error while creating logical port %s: %v", lp.DisplayName, err)
 	}
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during Logical port create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during Logical port create: %v", resp.StatusCode)
 	}
 
 	resourceID := lp.Id
 	d.SetId(resourceID)
 
-	return resourceNsxtLogicalPortRead(d, m)
+	return resourceNsxtLogicalPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical port ID from state during read")
+		return diag.Errorf("Error obtaining logical port ID from state during read")
 	}
 	logicalPort, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalPort(nsxClient.Context, id)
 	if resp != nil && resp.StatusCode == http.StatusNotFound {
@@ -101,7 +102,7 @@ func resourceNsxtLogicalPortRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error while reading logical port %s: %v", id, err)
+		return diag.Errorf("Error while reading logical port %s: %v", id, err)
 	}
 
 	d.Set("revision", logicalPort.Revision)
@@ -111,17 +112,17 @@ func resourceNsxtLogicalPortRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("admin_state", logicalPort.AdminState)
 	err = setSwitchingProfileIdsInSchema(d, nsxClient, logicalPort.SwitchingProfileIds)
 	if err != nil {
-		return fmt.Errorf("Error during logical port switching profiles set in schema: %v", err)
+		return diag.Errorf("Error during logical port switching profiles set in schema: %v", err)
 	}
-	setTagsInSchema(d, logicalPort.Tags)
+	setTagsInSchema(d, logicalPort.Tags, m)
 
 	return nil
 }
 
-func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
@@ -129,7 +130,7 @@ func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error
 	description := d.Get("description").(string)
 	adminState := d.Get("admin_state").(string)
 	profilesList := getSwitchingProfileIdsFromSchema(d)
-	tagList := getTagsFromSchema(d)
+	tagList := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 
 	// Some of the port attributes (attachment) are not exposed to terraform.
@@ -140,10 +141,10 @@ func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error
 
 	lp, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalPort(nsxClient.Context, id)
 	if resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Logical port %s was not found", id)
+		return diag.Errorf("Logical port %s was not found", id)
 	}
 	if err != nil {
-		return fmt.Errorf("Error while reading logical port %s: %v", id, err)
+		return diag.Errorf("Error while reading logical port %s: %v", id, err)
 	}
 
 	lp.DisplayName = name
@@ -155,20 +156,20 @@ func resourceNsxtLogicalPortUpdate(d *schema.ResourceData, m interface{}) error
 
 	lp, resp, err = nsxClient.LogicalSwitchingApi.UpdateLogicalPort(nsxClient.Context, id, lp)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error while updating logical port %s: %v", id, err)
+		return diag.Errorf("Error while updating logical port %s: %v", id, err)
 	}
-	return resourceNsxtLogicalPortRead(d, m)
+	return resourceNsxtLogicalPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	lpID := d.Id()
 	if lpID == "" {
-		return fmt.Errorf("Error obtaining logical port ID from state during delete")
+		return diag.Errorf("Error obtaining logical port ID from state during delete")
 	}
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["detach"] = true
@@ -176,7 +177,7 @@ func resourceNsxtLogicalPortDelete(d *schema.ResourceData, m interface{}) error
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteLogicalPort(nsxClient.Context, lpID, localVarOptionals)
 
 	if err != nil {
-		return fmt.Errorf("Error while deleting logical port %s: %v", lpID, err)
+		return diag.Errorf("Error while deleting logical port %s: %v", lpID, err)
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		log.Printf("[DEBUG] Logical port %s was not found", lpID)
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtLogicalRouterCentralizedServicePort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterCentralizedServicePortCreate,
-		Read:   resourceNsxtLogicalRouterCentralizedServicePortRead,
-		Update: resourceNsxtLogicalRouterCentralizedServicePortUpdate,
-		Delete: resourceNsxtLogicalRouterCentralizedServicePortDelete,
+		CreateContext: resourceNsxtLogicalRouterCentralizedServicePortCreate,
+		ReadContext:   resourceNsxtLogicalRouterCentralizedServicePortRead,
+		UpdateContext: resourceNsxtLogicalRouterCentralizedServicePortUpdate,
+		DeleteContext: resourceNsxtLogicalRouterCentralizedServicePortDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -66,15 +67,15 @@ func resourceNsxtLogicalRouterCentralizedServicePort() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalRouterCentralizedServicePortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterCentralizedServicePortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
 	subnets := getIPSubnetsFromCidr(d.Get("ip_address").(string))
@@ -92,26 +93,26 @@ func resourceNsxtLogicalRouterCentralizedServicePortCreate(d *schema.ResourceDat
 	LogicalRouterCentralizedServicePort, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateLogicalRouterCentralizedServicePort(nsxClient.Context, LogicalRouterCentralizedServicePort)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterCentralizedServicePort create: %v", err)
+		return diag.Errorf("Error during LogicalRouterCentralizedServicePort create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalRouterCentralizedServicePort create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalRouterCentralizedServicePort create: %v", resp.StatusCode)
 	}
 	d.SetId(LogicalRouterCentralizedServicePort.Id)
 
-	return resourceNsxtLogicalRouterCentralizedServicePortRead(d, m)
+	return resourceNsxtLogicalRouterCentralizedServicePortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterCentralizedServicePortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterCentralizedServicePortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router centralized port id while reading")
+		return diag.Errorf("Error obtaining logical router centralized port id while reading")
 	}
 
 	LogicalRouterCentralizedServicePort, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouterCentralizedServicePort(nsxClient.Context, id)
@@ -121,13 +122,13 @@ func resourceNsxtLogicalRouterCentralizedServicePortRead(d *schema.ResourceData,
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterCentralizedServicePort read: %v", err)
+		return diag.Errorf("Error during LogicalRouterCentralizedServicePort read: %v", err)
 	}
 
 	d.Set("revision", LogicalRouterCentralizedServicePort.Revision)
 	d.Set("description", LogicalRouterCentralizedServicePort.Description)
 	d.Set("display_name", LogicalRouterCentralizedServicePort.DisplayName)
-	setTagsInSchema(d, LogicalRouterCentralizedServicePort.Tags)
+	setTagsInSchema(d, LogicalRouterCentralizedServicePort.Tags, m)
 	d.Set("logical_router_id", LogicalRouterCentralizedServicePort.LogicalRouterId)
 	d.Set("linked_logical_switch_port_id", LogicalRouterCentralizedServicePort.LinkedLogicalSwitchPortId.TargetId)
 	setIPSubnetsInSchema(d, LogicalRouterCentralizedServicePort.Subnets)
@@ -136,21 +137,21 @@ func resourceNsxtLogicalRouterCentralizedServicePortRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceNsxtLogicalRouterCentralizedServicePortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterCentralizedServicePortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router centralized port id while updating")
+		return diag.Errorf("Error obtaining logical router centralized port id while updating")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
 	subnets := getIPSubnetsFromCidr(d.Get("ip_address").(string))
@@ -170,27 +171,27 @@ func resourceNsxtLogicalRouterCentralizedServicePortUpdate(d *schema.ResourceDat
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateLogicalRouterCentralizedServicePort(nsxClient.Context, id, LogicalRouterCentralizedServicePort)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalRouterCentralizedServicePort update: %v", err)
+		return diag.Errorf("Error during LogicalRouterCentralizedServicePort update: %v", err)
 	}
 
-	return resourceNsxtLogicalRouterCentralizedServicePortRead(d, m)
+	return resourceNsxtLogicalRouterCentralizedServicePortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterCentralizedServicePortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterCentralizedServicePortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router centralized port id while deleting")
+		return diag.Errorf("Error obtaining logical router centralized port id while deleting")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouterPort(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterCentralizedServicePort delete: %v", err)
+		return diag.Errorf("Error during LogicalRouterCentralizedServicePort delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
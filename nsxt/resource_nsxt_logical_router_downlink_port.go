@@ -4,12 +4,14 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -19,10 +21,10 @@ var logicalRouterPortUrpfModeValues = []string{"NONE", "STRICT"}
 
 func resourceNsxtLogicalRouterDownLinkPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterDownLinkPortCreate,
-		Read:   resourceNsxtLogicalRouterDownLinkPortRead,
-		Update: resourceNsxtLogicalRouterDownLinkPortUpdate,
-		Delete: resourceNsxtLogicalRouterDownLinkPortDelete,
+		CreateContext: resourceNsxtLogicalRouterDownLinkPortCreate,
+		ReadContext:   resourceNsxtLogicalRouterDownLinkPortRead,
+		UpdateContext: resourceNsxtLogicalRouterDownLinkPortUpdate,
+		DeleteContext: resourceNsxtLogicalRouterDownLinkPortDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -97,15 +99,15 @@ func setIPSubnetsInSchema(d *schema.ResourceData, subnets []manager.IpSubnet) {
 	}
 }
 
-func resourceNsxtLogicalRouterDownLinkPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	macAddress := d.Get("mac_address").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
@@ -127,26 +129,26 @@ func resourceNsxtLogicalRouterDownLinkPortCreate(d *schema.ResourceData, m inter
 	logicalRouterDownLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateLogicalRouterDownLinkPort(nsxClient.Context, logicalRouterDownLinkPort)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort create: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalRouterDownLinkPort create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalRouterDownLinkPort create: %v", resp.StatusCode)
 	}
 	d.SetId(logicalRouterDownLinkPort.Id)
 
-	return resourceNsxtLogicalRouterDownLinkPortRead(d, m)
+	return resourceNsxtLogicalRouterDownLinkPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterDownLinkPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router downlink port id while reading")
+		return diag.Errorf("Error obtaining logical router downlink port id while reading")
 	}
 
 	logicalRouterDownLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouterDownLinkPort(nsxClient.Context, id)
@@ -156,13 +158,13 @@ func resourceNsxtLogicalRouterDownLinkPortRead(d *schema.ResourceData, m interfa
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort read: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort read: %v", err)
 	}
 
 	d.Set("revision", logicalRouterDownLinkPort.Revision)
 	d.Set("description", logicalRouterDownLinkPort.Description)
 	d.Set("display_name", logicalRouterDownLinkPort.DisplayName)
-	setTagsInSchema(d, logicalRouterDownLinkPort.Tags)
+	setTagsInSchema(d, logicalRouterDownLinkPort.Tags, m)
 	d.Set("logical_router_id", logicalRouterDownLinkPort.LogicalRouterId)
 	d.Set("mac_address", logicalRouterDownLinkPort.MacAddress)
 	d.Set("linked_logical_switch_port_id", logicalRouterDownLinkPort.LinkedLogicalSwitchPortId.TargetId)
@@ -170,27 +172,27 @@ func resourceNsxtLogicalRouterDownLinkPortRead(d *schema.ResourceData, m interfa
 	d.Set("urpf_mode", logicalRouterDownLinkPort.UrpfMode)
 	err = setServiceBindingsInSchema(d, logicalRouterDownLinkPort.ServiceBindings, "service_binding")
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort service_binding set in schema: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort service_binding set in schema: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtLogicalRouterDownLinkPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router downlink port id while updating")
+		return diag.Errorf("Error obtaining logical router downlink port id while updating")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalSwitchPortID := d.Get("linked_logical_switch_port_id").(string)
 	subnets := getIPSubnetsFromCidr(d.Get("ip_address").(string))
@@ -214,27 +216,27 @@ func resourceNsxtLogicalRouterDownLinkPortUpdate(d *schema.ResourceData, m inter
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateLogicalRouterDownLinkPort(nsxClient.Context, id, logicalRouterDownLinkPort)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort update: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort update: %v", err)
 	}
 
-	return resourceNsxtLogicalRouterDownLinkPortRead(d, m)
+	return resourceNsxtLogicalRouterDownLinkPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterDownLinkPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router downlink port id while deleting")
+		return diag.Errorf("Error obtaining logical router downlink port id while deleting")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouterPort(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort delete: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
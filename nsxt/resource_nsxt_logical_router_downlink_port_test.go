@@ -67,9 +67,8 @@ func TestAccResourceNsxtLogicalRouterDownlinkPort_withRelay(t *testing.T) {
 
 	// resource type changed to DhcpRelayService in NSX 2.5
 	resourceType := "DhcpRelayService"
-	// this is needed to init the version
 	testAccNSXVersion(t, "2.2.0")
-	if nsxVersionLower("2.5.0") {
+	if testAccNSXVersionLower(t, "2.5.0") {
 		resourceType = "LogicalService"
 	}
 
@@ -4,20 +4,21 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtLogicalRouterLinkPortOnTier0() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterLinkPortOnTier0Create,
-		Read:   resourceNsxtLogicalRouterLinkPortOnTier0Read,
-		Update: resourceNsxtLogicalRouterLinkPortOnTier0Update,
-		Delete: resourceNsxtLogicalRouterLinkPortOnTier0Delete,
+		CreateContext: resourceNsxtLogicalRouterLinkPortOnTier0Create,
+		ReadContext:   resourceNsxtLogicalRouterLinkPortOnTier0Read,
+		UpdateContext: resourceNsxtLogicalRouterLinkPortOnTier0Update,
+		DeleteContext: resourceNsxtLogicalRouterLinkPortOnTier0Delete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -51,15 +52,15 @@ func resourceNsxtLogicalRouterLinkPortOnTier0() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier0Create(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier0Create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalRouterPortID := d.Get("linked_logical_router_port_id").(string)
 	logicalRouterLinkPort := manager.LogicalRouterLinkPortOnTier0{
@@ -72,26 +73,26 @@ func resourceNsxtLogicalRouterLinkPortOnTier0Create(d *schema.ResourceData, m in
 	logicalRouterLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateLogicalRouterLinkPortOnTier0(nsxClient.Context, logicalRouterLinkPort)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier0 create: %v", err)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier0 create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalRouterLinkPortOnTier0 create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalRouterLinkPortOnTier0 create: %v", resp.StatusCode)
 	}
 	d.SetId(logicalRouterLinkPort.Id)
 
-	return resourceNsxtLogicalRouterLinkPortOnTier0Read(d, m)
+	return resourceNsxtLogicalRouterLinkPortOnTier0Read(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier0Read(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier0Read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router link port on tier0 id")
+		return diag.Errorf("Error obtaining logical router link port on tier0 id")
 	}
 
 	logicalRouterLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouterLinkPortOnTier0(nsxClient.Context, id)
@@ -101,34 +102,34 @@ func resourceNsxtLogicalRouterLinkPortOnTier0Read(d *schema.ResourceData, m inte
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier0 read: %v", err)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier0 read: %v", err)
 	}
 
 	d.Set("revision", logicalRouterLinkPort.Revision)
 	d.Set("description", logicalRouterLinkPort.Description)
 	d.Set("display_name", logicalRouterLinkPort.DisplayName)
-	setTagsInSchema(d, logicalRouterLinkPort.Tags)
+	setTagsInSchema(d, logicalRouterLinkPort.Tags, m)
 	d.Set("logical_router_id", logicalRouterLinkPort.LogicalRouterId)
 	d.Set("linked_logical_router_port_id", logicalRouterLinkPort.LinkedLogicalRouterPortId)
 
 	return nil
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier0Update(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier0Update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router link port on tier0 id")
+		return diag.Errorf("Error obtaining logical router link port on tier0 id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	logicalRouterID := d.Get("logical_router_id").(string)
 	linkedLogicalRouterPortID := d.Get("linked_logical_router_port_id").(string)
 	logicalRouterLinkPort := manager.LogicalRouterLinkPortOnTier0{
@@ -144,27 +145,27 @@ func resourceNsxtLogicalRouterLinkPortOnTier0Update(d *schema.ResourceData, m in
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateLogicalRouterLinkPortOnTier0(nsxClient.Context, id, logicalRouterLinkPort)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier0 update: %v", err)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier0 update: %v", err)
 	}
 
-	return resourceNsxtLogicalRouterLinkPortOnTier0Read(d, m)
+	return resourceNsxtLogicalRouterLinkPortOnTier0Read(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier0Delete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier0Delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router link port on tier0 id")
+		return diag.Errorf("Error obtaining logical router link port on tier0 id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouterPort(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier0 delete: %v", err)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier0 delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,10 +36,10 @@ and try again.
 // Vlan logical switch is represented with separate resource
 func resourceNsxtLogicalSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalSwitchCreate,
-		Read:   resourceNsxtLogicalSwitchRead,
-		Update: resourceNsxtLogicalSwitchUpdate,
-		Delete: resourceNsxtLogicalSwitchDelete,
+		CreateContext: resourceNsxtLogicalSwitchCreate,
+		ReadContext:   resourceNsxtLogicalSwitchRead,
+		UpdateContext: resourceNsxtLogicalSwitchUpdate,
+		DeleteContext: resourceNsxtLogicalSwitchDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -98,15 +100,15 @@ func resourceNsxtLogicalSwitch() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	addressBindings := getAddressBindingsFromSchema(d)
 	adminState := d.Get("admin_state").(string)
 	ipPoolID := d.Get("ip_pool_id").(string)
@@ -135,16 +137,16 @@ func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) erro
 	logicalSwitch, resp, err := nsxClient.LogicalSwitchingApi.CreateLogicalSwitch(nsxClient.Context, logicalSwitch)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch create: %v", err)
+		return diag.Errorf("Error during LogicalSwitch create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
 	}
 
 	d.SetId(logicalSwitch.Id)
 
-	return resourceNsxtLogicalSwitchRead(d, m)
+	return resourceNsxtLogicalSwitchRead(ctx, d, m)
 }
 
 func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClient *api.APIClient, logicalSwitch *manager.LogicalSwitch, toleratePartialSuccess bool) error {
@@ -196,15 +198,15 @@ func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClien
 	return nil
 }
 
-func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	logicalSwitch, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitch(nsxClient.Context, id)
@@ -214,23 +216,23 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch read: %v", err)
+		return diag.Errorf("Error during LogicalSwitch read: %v", err)
 	}
 
 	toleratePartialSuccess := getCommonProviderConfig(m.(nsxtClients)).ToleratePartialSuccess
 	err = resourceNsxtLogicalSwitchVerifyRealization(d, nsxClient, &logicalSwitch, toleratePartialSuccess)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("revision", logicalSwitch.Revision)
 	d.Set("description", logicalSwitch.Description)
 	d.Set("display_name", logicalSwitch.DisplayName)
-	setTagsInSchema(d, logicalSwitch.Tags)
+	setTagsInSchema(d, logicalSwitch.Tags, m)
 	err = setAddressBindingsInSchema(d, logicalSwitch.AddressBindings)
 	if err != nil {
-		return fmt.Errorf("Error during logical switch address bindings set in schema: %v", err)
+		return diag.Errorf("Error during logical switch address bindings set in schema: %v", err)
 	}
 	d.Set("admin_state", logicalSwitch.AdminState)
 	d.Set("ip_pool_id", logicalSwitch.IpPoolId)
@@ -238,7 +240,7 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 	d.Set("replication_mode", logicalSwitch.ReplicationMode)
 	err = setSwitchingProfileIdsInSchema(d, nsxClient, logicalSwitch.SwitchingProfileIds)
 	if err != nil {
-		return fmt.Errorf("Error during logical switch profiles set in schema: %v", err)
+		return diag.Errorf("Error during logical switch profiles set in schema: %v", err)
 	}
 	d.Set("transport_zone_id", logicalSwitch.TransportZoneId)
 	d.Set("vlan", logicalSwitch.Vlan)
@@ -247,20 +249,20 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtLogicalSwitchUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	addressBindings := getAddressBindingsFromSchema(d)
 	adminState := d.Get("admin_state").(string)
 	ipPoolID := d.Get("ip_pool_id").(string)
@@ -289,21 +291,21 @@ func resourceNsxtLogicalSwitchUpdate(d *schema.ResourceData, m interface{}) erro
 
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateLogicalSwitch(nsxClient.Context, id, logicalSwitch)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalSwitch update: %v", err)
+		return diag.Errorf("Error during LogicalSwitch update: %v", err)
 	}
 
-	return resourceNsxtLogicalSwitchRead(d, m)
+	return resourceNsxtLogicalSwitchRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalSwitchDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
@@ -311,7 +313,7 @@ func resourceNsxtLogicalSwitchDelete(d *schema.ResourceData, m interface{}) erro
 	localVarOptionals["detach"] = true
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteLogicalSwitch(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch delete: %v", err)
+		return diag.Errorf("Error during LogicalSwitch delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
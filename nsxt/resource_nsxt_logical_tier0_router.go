@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -18,10 +19,10 @@ var highAvailabilityValues = []string{"ACTIVE_ACTIVE", "ACTIVE_STANDBY"}
 // TODO: add advanced config
 func resourceNsxtLogicalTier0Router() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalTier0RouterCreate,
-		Read:   resourceNsxtLogicalTier0RouterRead,
-		Update: resourceNsxtLogicalTier0RouterUpdate,
-		Delete: resourceNsxtLogicalTier0RouterDelete,
+		CreateContext: resourceNsxtLogicalTier0RouterCreate,
+		ReadContext:   resourceNsxtLogicalTier0RouterRead,
+		UpdateContext: resourceNsxtLogicalTier0RouterUpdate,
+		DeleteContext: resourceNsxtLogicalTier0RouterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -68,15 +69,15 @@ func resourceNsxtLogicalTier0Router() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalTier0RouterCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier0RouterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	highAvailabilityMode := d.Get("high_availability_mode").(string)
 	failoverMode := d.Get("failover_mode").(string)
 	routerType := "TIER0"
@@ -93,27 +94,27 @@ func resourceNsxtLogicalTier0RouterCreate(d *schema.ResourceData, m interface{})
 	logicalRouter, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateLogicalRouter(nsxClient.Context, logicalRouter)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier0Router create: %v", err)
+		return diag.Errorf("Error during LogicalTier0Router create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned: %d", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned: %d", resp.StatusCode)
 	}
 
 	d.SetId(logicalRouter.Id)
 
-	return resourceNsxtLogicalTier0RouterRead(d, m)
+	return resourceNsxtLogicalTier0RouterRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalTier0RouterRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier0RouterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical Tier0 logical router id")
+		return diag.Errorf("Error obtaining logical Tier0 logical router id")
 	}
 
 	logicalRouter, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouter(nsxClient.Context, id)
@@ -123,39 +124,39 @@ func resourceNsxtLogicalTier0RouterRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier0Router read: %v", err)
+		return diag.Errorf("Error during LogicalTier0Router read: %v", err)
 	}
 
 	d.Set("revision", logicalRouter.Revision)
 	d.Set("description", logicalRouter.Description)
 	d.Set("display_name", logicalRouter.DisplayName)
-	setTagsInSchema(d, logicalRouter.Tags)
+	setTagsInSchema(d, logicalRouter.Tags, m)
 	d.Set("edge_cluster_id", logicalRouter.EdgeClusterId)
 	d.Set("high_availability_mode", logicalRouter.HighAvailabilityMode)
 	d.Set("failover_mode", logicalRouter.FailoverMode)
 	err = setResourceReferencesInSchema(d, logicalRouter.FirewallSections, "firewall_sections")
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier0Router firewall sections set in schema: %v", err)
+		return diag.Errorf("Error during LogicalTier0Router firewall sections set in schema: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtLogicalTier0RouterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier0RouterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical tier0 router id")
+		return diag.Errorf("Error obtaining logical tier0 router id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	highAvailabilityMode := d.Get("high_availability_mode").(string)
 	failoverMode := d.Get("failover_mode").(string)
 	routerType := "TIER0"
@@ -173,27 +174,27 @@ func resourceNsxtLogicalTier0RouterUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateLogicalRouter(nsxClient.Context, id, logicalRouter)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalTier0Router update error: %v, resp %+v", err, resp)
+		return diag.Errorf("Error during LogicalTier0Router update error: %v, resp %+v", err, resp)
 	}
 
-	return resourceNsxtLogicalTier0RouterRead(d, m)
+	return resourceNsxtLogicalTier0RouterRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalTier0RouterDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier0RouterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical tier0 router id")
+		return diag.Errorf("Error obtaining logical tier0 router id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouter(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier0Router delete: %v", err)
+		return diag.Errorf("Error during LogicalTier0Router delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtMacManagementSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtMacManagementSwitchingProfileCreate,
-		Read:   resourceNsxtMacManagementSwitchingProfileRead,
-		Update: resourceNsxtMacManagementSwitchingProfileUpdate,
-		Delete: resourceNsxtMacManagementSwitchingProfileDelete,
+		CreateContext: resourceNsxtMacManagementSwitchingProfileCreate,
+		ReadContext:   resourceNsxtMacManagementSwitchingProfileRead,
+		UpdateContext: resourceNsxtMacManagementSwitchingProfileUpdate,
+		DeleteContext: resourceNsxtMacManagementSwitchingProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -112,15 +113,15 @@ func setMacLearningInSchema(d *schema.ResourceData, macLearning *manager.MacLear
 	return err
 }
 
-func resourceNsxtMacManagementSwitchingProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtMacManagementSwitchingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	macChangeAllowed := d.Get("mac_change_allowed").(bool)
 	macLearning := getMacLearningFromSchema(d)
 
@@ -135,26 +136,26 @@ func resourceNsxtMacManagementSwitchingProfileCreate(d *schema.ResourceData, m i
 	switchingProfile, resp, err := nsxClient.LogicalSwitchingApi.CreateMacManagementSwitchingProfile(nsxClient.Context, switchingProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during MacManagementSwitchingProfile create: %v", err)
+		return diag.Errorf("Error during MacManagementSwitchingProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during MacManagementSwitchingProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during MacManagementSwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(switchingProfile.Id)
 
-	return resourceNsxtMacManagementSwitchingProfileRead(d, m)
+	return resourceNsxtMacManagementSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtMacManagementSwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtMacManagementSwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	switchingProfile, resp, err := nsxClient.LogicalSwitchingApi.GetMacManagementSwitchingProfile(nsxClient.Context, id)
@@ -164,36 +165,36 @@ func resourceNsxtMacManagementSwitchingProfileRead(d *schema.ResourceData, m int
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during MacManagementSwitchingProfile read: %v", err)
+		return diag.Errorf("Error during MacManagementSwitchingProfile read: %v", err)
 	}
 
 	d.Set("revision", switchingProfile.Revision)
 	d.Set("description", switchingProfile.Description)
 	d.Set("display_name", switchingProfile.DisplayName)
 	d.Set("mac_change_allowed", switchingProfile.MacChangeAllowed)
-	setTagsInSchema(d, switchingProfile.Tags)
+	setTagsInSchema(d, switchingProfile.Tags, m)
 	err = setMacLearningInSchema(d, switchingProfile.MacLearning)
 	if err != nil {
-		return fmt.Errorf("Error during setting MacManagementSwitchingProfile MacLearning: %v", err)
+		return diag.Errorf("Error during setting MacManagementSwitchingProfile MacLearning: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtMacManagementSwitchingProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtMacManagementSwitchingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	macChangeAllowed := d.Get("mac_change_allowed").(bool)
 	macLearning := getMacLearningFromSchema(d)
@@ -210,26 +211,26 @@ func resourceNsxtMacManagementSwitchingProfileUpdate(d *schema.ResourceData, m i
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateMacManagementSwitchingProfile(nsxClient.Context, id, switchingProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during MacManagementSwitchingProfile update: %v", err)
+		return diag.Errorf("Error during MacManagementSwitchingProfile update: %v", err)
 	}
 
-	return resourceNsxtMacManagementSwitchingProfileRead(d, m)
+	return resourceNsxtMacManagementSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtMacManagementSwitchingProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtMacManagementSwitchingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteSwitchingProfile(nsxClient.Context, id, nil)
 	if err != nil {
-		return fmt.Errorf("Error during MacManagementSwitchingProfile delete: %v", err)
+		return diag.Errorf("Error during MacManagementSwitchingProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"math/rand"
@@ -13,6 +14,7 @@ import (
 
 	"golang.org/x/exp/slices"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,10 +30,13 @@ const nodeConnectivityTimeout int = 1800
 
 func resourceNsxtManagerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtManagerClusterCreate,
-		Read:   resourceNsxtManagerClusterRead,
-		Update: resourceNsxtManagerClusterUpdate,
-		Delete: resourceNsxtManagerClusterDelete,
+		CreateContext: resourceNsxtManagerClusterCreate,
+		ReadContext:   resourceNsxtManagerClusterRead,
+		UpdateContext: resourceNsxtManagerClusterUpdate,
+		DeleteContext: resourceNsxtManagerClusterDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"revision": getRevisionSchema(),
@@ -147,7 +152,7 @@ func getNodeConnectivityStateConf(connector client.Connector, delay int, interva
 	}
 }
 
-func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxClusterNode) error {
+func waitForNodeStatus(ctx context.Context, d *schema.ResourceData, m interface{}, nodes []NsxClusterNode) error {
 
 	delay := nodeConnectivityInitialDelay
 	interval := nodeConnectivityInterval
@@ -170,7 +175,7 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 	}
 	connector := getPolicyConnectorForInit(m, false)
 	stateConf := getNodeConnectivityStateConf(connector, delay, interval, timeout)
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Failed to connect to main NSX manager endpoint")
 	}
@@ -184,7 +189,7 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 		newNsxClients := c.(nsxtClients)
 		nodeConnector := getPolicyConnectorForInit(newNsxClients, false)
 		nodeConf := getNodeConnectivityStateConf(nodeConnector, 0, interval, timeout)
-		_, err = nodeConf.WaitForState()
+		_, err = nodeConf.WaitForStateContext(ctx)
 		if err != nil {
 			return fmt.Errorf("Failed to connect to NSX node endpoint %s", node.IPAddress)
 		}
@@ -213,30 +218,34 @@ func getClusterNodesFromSchema(d *schema.ResourceData) []NsxClusterNode {
 	return clusterNodes
 }
 
-func resourceNsxtManagerClusterCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtManagerClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Call Joincluster function on nodes that are not in the cluster
 	nodes := getClusterNodesFromSchema(d)
 	if len(nodes) == 0 {
-		return fmt.Errorf("At least a manager appliance must be provided to form a cluster")
+		return diag.Errorf("At least a manager appliance must be provided to form a cluster")
 	}
 
-	err := waitForNodeStatus(d, m, nodes)
+	// Overall wait for cluster formation is bound by create timeout, while
+	// api_probing settings control probing of each individual node
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	err := waitForNodeStatus(ctx, d, m, nodes)
 	if err != nil {
-		return fmt.Errorf("Failed to establish connection to NSX API: %v", err)
+		return diag.Errorf("Failed to establish connection to NSX API: %v", err)
 	}
 	clusterID, certSha256Thumbprint, hostIPs, err := getClusterInfoFromHostNode(d, m)
 	if err != nil {
-		return handleCreateError("ManagerCluster", "", err)
+		return diag.FromErr(handleCreateError("ManagerCluster", "", err))
 	}
 
 	for _, guestNode := range nodes {
 		err := joinNodeToCluster(clusterID, certSha256Thumbprint, guestNode, hostIPs, d, m)
 		if err != nil {
-			return handleCreateError("ManagerCluster", clusterID, fmt.Errorf("failed to join node %s: %s", guestNode.ID, err))
+			return diag.FromErr(handleCreateError("ManagerCluster", clusterID, fmt.Errorf("failed to join node %s: %s", guestNode.ID, err)))
 		}
 	}
 	d.SetId(clusterID)
-	return resourceNsxtManagerClusterRead(d, m)
+	return resourceNsxtManagerClusterRead(ctx, d, m)
 }
 
 func getClusterInfoFromHostNode(d *schema.ResourceData, m interface{}) (string, string, []string, error) {
@@ -314,6 +323,7 @@ func getNewNsxtClient(node NsxClusterNode, d *schema.ResourceData, clients inter
 	commonConfig := c.CommonConfig
 	newClients := nsxtClients{
 		CommonConfig: commonConfig,
+		NsxVersion:   &nsxtVersionInfo{},
 	}
 	err := configureNewClient(&newClients, &c, host, username, password)
 	if err != nil {
@@ -330,7 +340,7 @@ func configureNewClient(newClient *nsxtClients, oldClient *nsxtClients, host str
 	}
 	newClient.PolicySecurityContext = securityCtx
 	newClient.PolicyHTTPClient = oldClient.PolicyHTTPClient
-	err = initNSXVersion(getPolicyConnector(*newClient))
+	err = initNSXVersion(*newClient, getPolicyConnector(*newClient))
 	if err != nil {
 		return fmt.Errorf("Failed to configure new client with host %s: %s", host, err)
 	}
@@ -410,13 +420,13 @@ func isMatchingNode(node nsxModel.ClusterNodeInfo, address string) bool {
 	return false
 }
 
-func resourceNsxtManagerClusterRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtManagerClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	connector := getPolicyConnector(m)
 	client := nsx.NewClusterClient(connector)
 	clusterConfig, err := client.Get()
 	if err != nil {
-		return handleReadError(d, "ManagerCluster", id, err)
+		return diag.FromErr(handleReadError(d, "ManagerCluster", id, err))
 	}
 	nsxNodes := clusterConfig.Nodes
 	var resultNodes []map[string]interface{}
@@ -444,7 +454,7 @@ func resourceNsxtManagerClusterRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtManagerClusterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtManagerClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if !d.HasChange("node") {
 		// CHanges to attributes other than "node" should be ignored
 		return nil
@@ -455,7 +465,7 @@ func resourceNsxtManagerClusterUpdate(d *schema.ResourceData, m interface{}) err
 
 	clusterID, certSha256Thumbprint, hostIPs, err := getClusterInfoFromHostNode(d, m)
 	if err != nil {
-		return handleUpdateError("ManagerCluster", id, err)
+		return diag.FromErr(handleUpdateError("ManagerCluster", id, err))
 	}
 	oldNodes, newNodes := d.GetChange("node")
 	oldNodesIPs := getClusterNodesIPs(oldNodes)
@@ -470,7 +480,7 @@ func resourceNsxtManagerClusterUpdate(d *schema.ResourceData, m interface{}) err
 			ignoreRepositoryIPCheckParam := "false"
 			_, err := client.Removenode(id, &force, &gracefulShutdown, &ignoreRepositoryIPCheckParam)
 			if err != nil {
-				return handleUpdateError("ManagerCluster", id, err)
+				return diag.FromErr(handleUpdateError("ManagerCluster", id, err))
 			}
 		}
 	}
@@ -488,12 +498,12 @@ func resourceNsxtManagerClusterUpdate(d *schema.ResourceData, m interface{}) err
 			}
 			err = joinNodeToCluster(clusterID, certSha256Thumbprint, nodeObj, hostIPs, d, m)
 			if err != nil {
-				return handleUpdateError("ManagerCluster", id, err)
+				return diag.FromErr(handleUpdateError("ManagerCluster", id, err))
 			}
 		}
 	}
 
-	return resourceNsxtManagerClusterRead(d, m)
+	return resourceNsxtManagerClusterRead(ctx, d, m)
 }
 
 func getClusterNodesIPs(nodes interface{}) []string {
@@ -505,7 +515,7 @@ func getClusterNodesIPs(nodes interface{}) []string {
 	return ips
 }
 
-func resourceNsxtManagerClusterDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtManagerClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nsx.NewClusterClient(connector)
 	nodes := getClusterNodesFromSchema(d)
@@ -516,7 +526,7 @@ func resourceNsxtManagerClusterDelete(d *schema.ResourceData, m interface{}) err
 		guestNodeID := node.ID
 		_, err := client.Removenode(guestNodeID, &force, &gracefulShutdown, &ignoreRepositoryIPCheckParam)
 		if err != nil {
-			return handleDeleteError("ManagerCluster", guestNodeID, err)
+			return diag.FromErr(handleDeleteError("ManagerCluster", guestNodeID, err))
 		}
 	}
 	return nil
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/logical_routers/nat"
@@ -25,10 +27,10 @@ var natRuleActionValues = []string{
 
 func resourceNsxtNatRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNatRuleCreate,
-		Read:   resourceNsxtNatRuleRead,
-		Update: resourceNsxtNatRuleUpdate,
-		Delete: resourceNsxtNatRuleDelete,
+		CreateContext: resourceNsxtNatRuleCreate,
+		ReadContext:   resourceNsxtNatRuleRead,
+		UpdateContext: resourceNsxtNatRuleUpdate,
+		DeleteContext: resourceNsxtNatRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtNatRuleImport,
 		},
@@ -108,21 +110,21 @@ func resourceNsxtNatRule() *schema.Resource {
 	}
 }
 
-func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nat.NewRulesClient(connector)
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	action := d.Get("action").(string)
-	if action == "NO_NAT" && nsxVersionHigherOrEqual("3.0.0") {
-		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
+	if action == "NO_NAT" && nsxVersionHigherOrEqual(m, "3.0.0") {
+		return diag.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
 	}
 	enabled := d.Get("enabled").(bool)
 	logging := d.Get("logging").(bool)
@@ -157,31 +159,31 @@ func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
 	natRule, err := client.Create(logicalRouterID, natRule)
 
 	if err != nil {
-		return fmt.Errorf("Error during NatRule create: %v", err)
+		return diag.Errorf("Error during NatRule create: %v", err)
 	}
 
 	d.SetId(*natRule.Id)
-	return resourceNsxtNatRuleRead(d, m)
+	return resourceNsxtNatRuleRead(ctx, d, m)
 }
 
-func resourceNsxtNatRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nat.NewRulesClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	natRule, err := client.Get(logicalRouterID, id)
 
 	if err != nil {
-		return fmt.Errorf("Error during NatRule read: %v", err)
+		return diag.Errorf("Error during NatRule read: %v", err)
 	}
 
 	var natPass bool
@@ -190,13 +192,13 @@ func resourceNsxtNatRuleRead(d *schema.ResourceData, m interface{}) error {
 	} else if *natRule.FirewallMatch == model.NatRule_FIREWALL_MATCH_BYPASS {
 		natPass = true
 	} else {
-		return fmt.Errorf("could not determine nat_pass value from firewall_match attribute value %s", *natRule.FirewallMatch)
+		return diag.Errorf("could not determine nat_pass value from firewall_match attribute value %s", *natRule.FirewallMatch)
 	}
 
 	d.Set("revision", natRule.Revision)
 	d.Set("description", natRule.Description)
 	d.Set("display_name", natRule.DisplayName)
-	setMPTagsInSchema(d, natRule.Tags)
+	setMPTagsInSchema(d, natRule.Tags, m)
 	d.Set("action", natRule.Action)
 	d.Set("enabled", natRule.Enabled)
 	d.Set("logging", natRule.Logging)
@@ -211,27 +213,27 @@ func resourceNsxtNatRuleRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtNatRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nat.NewRulesClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	action := d.Get("action").(string)
-	if action == "NO_NAT" && nsxVersionHigherOrEqual("3.0.0") {
-		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
+	if action == "NO_NAT" && nsxVersionHigherOrEqual(m, "3.0.0") {
+		return diag.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
 	}
 	enabled := d.Get("enabled").(bool)
 	logging := d.Get("logging").(bool)
@@ -267,28 +269,28 @@ func resourceNsxtNatRuleUpdate(d *schema.ResourceData, m interface{}) error {
 	_, err := client.Update(logicalRouterID, id, natRule)
 
 	if err != nil {
-		return fmt.Errorf("Error during NatRule update: %v", err)
+		return diag.Errorf("Error during NatRule update: %v", err)
 	}
 
-	return resourceNsxtNatRuleRead(d, m)
+	return resourceNsxtNatRuleRead(ctx, d, m)
 }
 
-func resourceNsxtNatRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nat.NewRulesClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	err := client.Delete(logicalRouterID, id)
 	if err != nil {
-		return fmt.Errorf("Error during NatRule delete: %v", err)
+		return diag.Errorf("Error during NatRule delete: %v", err)
 	}
 
 	return nil
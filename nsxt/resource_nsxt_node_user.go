@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
@@ -17,10 +19,10 @@ import (
 
 func resourceNsxtUsers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNodeUserCreate,
-		Read:   resourceNsxtNodeUserRead,
-		Update: resourceNsxtNodeUserUpdate,
-		Delete: resourceNsxtNodeUserDelete,
+		CreateContext: resourceNsxtNodeUserCreate,
+		ReadContext:   resourceNsxtNodeUserRead,
+		UpdateContext: resourceNsxtNodeUserUpdate,
+		DeleteContext: resourceNsxtNodeUserDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -109,7 +111,7 @@ func validateUsername() schema.SchemaValidateFunc {
 	}
 }
 
-func resourceNsxtNodeUserCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNodeUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := node.NewUsersClient(connector)
 
@@ -131,24 +133,24 @@ func resourceNsxtNodeUserCreate(d *schema.ResourceData, m interface{}) error {
 
 	user, err := client.Createuser(userProp)
 	if err != nil {
-		return handleCreateError("User", username, err)
+		return diag.FromErr(handleCreateError("User", username, err))
 	}
 	d.Set("user_id", user.Userid)
 	d.SetId(strconv.Itoa(int(*user.Userid)))
 
-	return resourceNsxtNodeUserRead(d, m)
+	return resourceNsxtNodeUserRead(ctx, d, m)
 }
 
-func resourceNsxtNodeUserRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNodeUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := node.NewUsersClient(connector)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 	user, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "User", id, err)
+		return diag.FromErr(handleReadError(d, "User", id, err))
 	}
 
 	// Password not return on GET
@@ -168,12 +170,12 @@ func resourceNsxtNodeUserRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNodeUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := node.NewUsersClient(connector)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 	password := d.Get("password").(string)
 	active := d.Get("active").(bool)
@@ -183,16 +185,16 @@ func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
 	// Password reset can be achieved by deactivating then re-activate the account
 	if status == nsxModel.NodeUserProperties_STATUS_NOT_ACTIVATED && active {
 		if len(password) == 0 {
-			return fmt.Errorf("must specify password to activate Nsxt Node user %s", id)
+			return diag.Errorf("must specify password to activate Nsxt Node user %s", id)
 		}
 		_, err := client.Activate(id, nsxModel.NodeUserPasswordProperty{Password: &password})
 		if err != nil {
-			return fmt.Errorf("failed to activate Nsxt Node user %s: %s", id, err)
+			return diag.Errorf("failed to activate Nsxt Node user %s: %s", id, err)
 		}
 	} else if status != nsxModel.NodeUserProperties_STATUS_NOT_ACTIVATED && !active {
 		_, err := client.Deactivate(id)
 		if err != nil {
-			return fmt.Errorf("failed to deactivate Nsxt Node user %s: %s", id, err)
+			return diag.Errorf("failed to deactivate Nsxt Node user %s: %s", id, err)
 		}
 	}
 
@@ -209,17 +211,17 @@ func resourceNsxtNodeUserUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	_, err := client.Update(id, userProp)
 	if err != nil {
-		return handleUpdateError("User", id, err)
+		return diag.FromErr(handleUpdateError("User", id, err))
 	}
-	return resourceNsxtNodeUserRead(d, m)
+	return resourceNsxtNodeUserRead(ctx, d, m)
 }
 
-func resourceNsxtNodeUserDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNodeUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := node.NewUsersClient(connector)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	// Workaround a bug where the delete response body is marked as non-json content type by NSX,
@@ -236,5 +238,5 @@ func resourceNsxtNodeUserDelete(d *schema.ResourceData, m interface{}) error {
 		time.Sleep(1 * time.Second)
 	}
 
-	return err
+	return diag.FromErr(err)
 }
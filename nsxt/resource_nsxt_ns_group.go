@@ -1,10 +1,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -16,10 +17,10 @@ var nsGroupTagOperationValues = []string{"EQUALS"}
 
 func resourceNsxtNsGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNsGroupCreate,
-		Read:   resourceNsxtNsGroupRead,
-		Update: resourceNsxtNsGroupUpdate,
-		Delete: resourceNsxtNsGroupDelete,
+		CreateContext: resourceNsxtNsGroupCreate,
+		ReadContext:   resourceNsxtNsGroupRead,
+		UpdateContext: resourceNsxtNsGroupUpdate,
+		DeleteContext: resourceNsxtNsGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -158,15 +159,15 @@ func setMembersInSchema(d *schema.ResourceData, members []manager.NsGroupSimpleE
 	return err
 }
 
-func resourceNsxtNsGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getMembersFromSchema(d)
 	membershipCriteria := getMembershipCriteriaFromSchema(d)
 	nsGroup := manager.NsGroup{
@@ -180,26 +181,26 @@ func resourceNsxtNsGroupCreate(d *schema.ResourceData, m interface{}) error {
 	nsGroup, resp, err := nsxClient.GroupingObjectsApi.CreateNSGroup(nsxClient.Context, nsGroup)
 
 	if err != nil {
-		return fmt.Errorf("Error during NsGroup create: %v", err)
+		return diag.Errorf("Error during NsGroup create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsGroup create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsGroup create: %v", resp.StatusCode)
 	}
 	d.SetId(nsGroup.Id)
 
-	return resourceNsxtNsGroupRead(d, m)
+	return resourceNsxtNsGroupRead(ctx, d, m)
 }
 
-func resourceNsxtNsGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
@@ -212,38 +213,38 @@ func resourceNsxtNsGroupRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsGroup read: %v", err)
+		return diag.Errorf("Error during NsGroup read: %v", err)
 	}
 
 	d.Set("revision", nsGroup.Revision)
 	d.Set("description", nsGroup.Description)
 	d.Set("display_name", nsGroup.DisplayName)
-	setTagsInSchema(d, nsGroup.Tags)
+	setTagsInSchema(d, nsGroup.Tags, m)
 	err1 := setMembersInSchema(d, nsGroup.Members)
 
 	err2 := setMembershipCriteriaInSchema(d, nsGroup.MembershipCriteria)
 	if err1 != nil || err2 != nil {
-		return fmt.Errorf("Error during NsGroup set in schema: %v / %v", err1, err2)
+		return diag.Errorf("Error during NsGroup set in schema: %v / %v", err1, err2)
 	}
 
 	return nil
 }
 
-func resourceNsxtNsGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getMembersFromSchema(d)
 	membershipCriteria := getMembershipCriteriaFromSchema(d)
 	nsGroup := manager.NsGroup{
@@ -258,27 +259,27 @@ func resourceNsxtNsGroupUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateNSGroup(nsxClient.Context, id, nsGroup)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsGroup update: %v", err)
+		return diag.Errorf("Error during NsGroup update: %v", err)
 	}
 
-	return resourceNsxtNsGroupRead(d, m)
+	return resourceNsxtNsGroupRead(ctx, d, m)
 }
 
-func resourceNsxtNsGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSGroup(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsGroup delete: %v", err)
+		return diag.Errorf("Error during NsGroup delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -15,10 +16,10 @@ import (
 
 func resourceNsxtNsServiceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNsServiceGroupCreate,
-		Read:   resourceNsxtNsServiceGroupRead,
-		Update: resourceNsxtNsServiceGroupUpdate,
-		Delete: resourceNsxtNsServiceGroupDelete,
+		CreateContext: resourceNsxtNsServiceGroupCreate,
+		ReadContext:   resourceNsxtNsServiceGroupRead,
+		UpdateContext: resourceNsxtNsServiceGroupUpdate,
+		DeleteContext: resourceNsxtNsServiceGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -72,15 +73,15 @@ func returnResourceReferencesTargetIDs(references []common.ResourceReference) []
 	return TargetIds
 }
 
-func resourceNsxtNsServiceGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsServiceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getResourceReferencesFromStringsSet(d, "members")
 	nsServiceGroup := manager.NsServiceGroup{
 		Description: description,
@@ -92,26 +93,26 @@ func resourceNsxtNsServiceGroupCreate(d *schema.ResourceData, m interface{}) err
 	nsServiceGroup, resp, err := nsxClient.GroupingObjectsApi.CreateNSServiceGroup(nsxClient.Context, nsServiceGroup)
 
 	if err != nil {
-		return fmt.Errorf("Error during NsServiceGroup create: %v", err)
+		return diag.Errorf("Error during NsServiceGroup create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsServiceGroup create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsServiceGroup create: %v", resp.StatusCode)
 	}
 	d.SetId(nsServiceGroup.Id)
 
-	return resourceNsxtNsServiceGroupRead(d, m)
+	return resourceNsxtNsServiceGroupRead(ctx, d, m)
 }
 
-func resourceNsxtNsServiceGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsServiceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	nsServiceGroup, resp, err := nsxClient.GroupingObjectsApi.ReadNSServiceGroup(nsxClient.Context, id)
@@ -121,33 +122,33 @@ func resourceNsxtNsServiceGroupRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsServiceGroup read: %v", err)
+		return diag.Errorf("Error during NsServiceGroup read: %v", err)
 	}
 
 	d.Set("revision", nsServiceGroup.Revision)
 	d.Set("description", nsServiceGroup.Description)
 	d.Set("display_name", nsServiceGroup.DisplayName)
-	setTagsInSchema(d, nsServiceGroup.Tags)
+	setTagsInSchema(d, nsServiceGroup.Tags, m)
 	d.Set("members", returnResourceReferencesTargetIDs(nsServiceGroup.Members))
 
 	return nil
 }
 
-func resourceNsxtNsServiceGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsServiceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	members := getResourceReferencesFromStringsSet(d, "members")
 	nsServiceGroup := manager.NsServiceGroup{
 		Revision:    revision,
@@ -160,29 +161,29 @@ func resourceNsxtNsServiceGroupUpdate(d *schema.ResourceData, m interface{}) err
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateNSServiceGroup(nsxClient.Context, id, nsServiceGroup)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsServiceGroup update: %v", err)
+		return diag.Errorf("Error during NsServiceGroup update: %v", err)
 	}
 
-	return resourceNsxtNsServiceGroupRead(d, m)
+	return resourceNsxtNsServiceGroupRead(ctx, d, m)
 }
 
-func resourceNsxtNsServiceGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNsServiceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSServiceGroup(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsServiceGroup delete: %v", err)
+		return diag.Errorf("Error during NsServiceGroup delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
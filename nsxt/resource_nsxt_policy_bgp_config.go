@@ -4,8 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gm_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s/locale_services"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
@@ -21,22 +23,22 @@ func resourceNsxtPolicyBgpConfig() *schema.Resource {
 	bgpSchema["locale_service_id"] = getComputedLocaleServiceIDSchema()
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyBgpConfigCreate,
-		Read:   resourceNsxtPolicyBgpConfigRead,
-		Update: resourceNsxtPolicyBgpConfigUpdate,
-		Delete: resourceNsxtPolicyBgpConfigDelete,
+		CreateContext: resourceNsxtPolicyBgpConfigCreate,
+		ReadContext:   resourceNsxtPolicyBgpConfigRead,
+		UpdateContext: resourceNsxtPolicyBgpConfigUpdate,
+		DeleteContext: resourceNsxtPolicyBgpConfigDelete,
 
 		Schema: bgpSchema,
 	}
 }
 
-func resourceNsxtPolicyBgpConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 	serviceID := d.Get("locale_service_id").(string)
 	var lmRoutingConfig model.BgpRoutingConfig
@@ -45,11 +47,11 @@ func resourceNsxtPolicyBgpConfigRead(d *schema.ResourceData, m interface{}) erro
 		client := gm_locale_services.NewBgpClient(connector)
 		gmObj, err := client.Get(gwID, serviceID)
 		if err != nil {
-			return handleReadError(d, "BGP Config", serviceID, err)
+			return diag.FromErr(handleReadError(d, "BGP Config", serviceID, err))
 		}
 		lmObj, convErr := convertModelBindingType(gmObj, gm_model.BgpRoutingConfigBindingType(), model.BgpRoutingConfigBindingType())
 		if convErr != nil {
-			return convErr
+			return diag.FromErr(convErr)
 		}
 		lmRoutingConfig = lmObj.(model.BgpRoutingConfig)
 	} else {
@@ -57,7 +59,7 @@ func resourceNsxtPolicyBgpConfigRead(d *schema.ResourceData, m interface{}) erro
 		client := locale_services.NewBgpClient(connector)
 		lmRoutingConfig, err = client.Get(gwID, serviceID)
 		if err != nil {
-			return handleReadError(d, "BGP Config", serviceID, err)
+			return diag.FromErr(handleReadError(d, "BGP Config", serviceID, err))
 		}
 	}
 
@@ -70,7 +72,7 @@ func resourceNsxtPolicyBgpConfigRead(d *schema.ResourceData, m interface{}) erro
 	return nil
 }
 
-func resourceNsxtPolicyBgpConfigToStruct(d *schema.ResourceData, isVRF bool) (*model.BgpRoutingConfig, error) {
+func resourceNsxtPolicyBgpConfigToStruct(d *schema.ResourceData, isVRF bool, m interface{}) (*model.BgpRoutingConfig, error) {
 	ecmp := d.Get("ecmp").(bool)
 	enabled := d.Get("enabled").(bool)
 	interSrIbgp := d.Get("inter_sr_ibgp").(bool)
@@ -79,7 +81,7 @@ func resourceNsxtPolicyBgpConfigToStruct(d *schema.ResourceData, isVRF bool) (*m
 	restartMode := d.Get("graceful_restart_mode").(string)
 	restartTimer := int64(d.Get("graceful_restart_timer").(int))
 	staleTimer := int64(d.Get("graceful_restart_stale_route_timer").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var aggregationStructs []model.RouteAggregationEntry
 	routeAggregations := d.Get("route_aggregation").([]interface{})
@@ -138,7 +140,7 @@ func resourceNsxtPolicyBgpConfigToStruct(d *schema.ResourceData, isVRF bool) (*m
 	return &routeStruct, nil
 }
 
-func resourceNsxtPolicyBgpConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// This is not a create operation on NSX, since BGP config us auto created
 	connector := getPolicyConnector(m)
 	context := getSessionContext(d, m)
@@ -146,31 +148,31 @@ func resourceNsxtPolicyBgpConfigCreate(d *schema.ResourceData, m interface{}) er
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 	sitePath := d.Get("site_path").(string)
 
 	isVrf, err := resourceNsxtPolicyTier0GatewayIsVrf(gwID, connector, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleCreateError("BgpRoutingConfig", gwID, err)
+		return diag.FromErr(handleCreateError("BgpRoutingConfig", gwID, err))
 	}
-	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf)
+	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf, m)
 	if err != nil {
-		return handleCreateError("BgpRoutingConfig", gwID, err)
+		return diag.FromErr(handleCreateError("BgpRoutingConfig", gwID, err))
 	}
 
 	var localeServiceID string
 	if isPolicyGlobalManager(m) {
 		serviceID, err1 := findTier0LocaleServiceForSite(context, connector, gwID, sitePath)
 		if err1 != nil {
-			return handleCreateError("BgpRoutingConfig", gwID, err1)
+			return diag.FromErr(handleCreateError("BgpRoutingConfig", gwID, err1))
 		}
 
 		localeServiceID = serviceID
 
 		gmObj, convErr := convertModelBindingType(obj, model.BgpRoutingConfigBindingType(), gm_model.BgpRoutingConfigBindingType())
 		if convErr != nil {
-			return convErr
+			return diag.FromErr(convErr)
 		}
 		gmRoutingConfig := gmObj.(gm_model.BgpRoutingConfig)
 
@@ -179,7 +181,7 @@ func resourceNsxtPolicyBgpConfigCreate(d *schema.ResourceData, m interface{}) er
 	} else {
 		localeService, err1 := getPolicyTier0GatewayLocaleServiceWithEdgeCluster(context, gwID, connector)
 		if err1 != nil {
-			return fmt.Errorf("Tier0 Gateway path with configured edge cluster expected, got %s", gwPath)
+			return diag.Errorf("Tier0 Gateway path with configured edge cluster expected, got %s", gwPath)
 		}
 		localeServiceID = *localeService.Id
 
@@ -187,17 +189,17 @@ func resourceNsxtPolicyBgpConfigCreate(d *schema.ResourceData, m interface{}) er
 		err = client.Patch(gwID, localeServiceID, *obj, nil)
 	}
 	if err != nil {
-		return handleCreateError("BgpRoutingConfig", gwID, err)
+		return diag.FromErr(handleCreateError("BgpRoutingConfig", gwID, err))
 	}
 
 	d.SetId(newUUID())
 	d.Set("gateway_id", gwID)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyBgpConfigRead(d, m)
+	return resourceNsxtPolicyBgpConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyBgpConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	revision := int64(d.Get("revision").(int))
@@ -207,19 +209,19 @@ func resourceNsxtPolicyBgpConfigUpdate(d *schema.ResourceData, m interface{}) er
 
 	isVrf, err := resourceNsxtPolicyTier0GatewayIsVrf(gwID, connector, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleCreateError("BgpRoutingConfig", gwID, err)
+		return diag.FromErr(handleCreateError("BgpRoutingConfig", gwID, err))
 	}
 
-	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf)
+	obj, err := resourceNsxtPolicyBgpConfigToStruct(d, isVrf, m)
 	if err != nil {
-		return handleUpdateError("BgpRoutingConfig", gwID, err)
+		return diag.FromErr(handleUpdateError("BgpRoutingConfig", gwID, err))
 	}
 
 	obj.Revision = &revision
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.BgpRoutingConfigBindingType(), gm_model.BgpRoutingConfigBindingType())
 		if convErr != nil {
-			return convErr
+			return diag.FromErr(convErr)
 		}
 		gmRoutingConfig := gmObj.(gm_model.BgpRoutingConfig)
 
@@ -231,13 +233,13 @@ func resourceNsxtPolicyBgpConfigUpdate(d *schema.ResourceData, m interface{}) er
 	}
 
 	if err != nil {
-		return handleUpdateError("BgpRoutingConfig", gwID, err)
+		return diag.FromErr(handleUpdateError("BgpRoutingConfig", gwID, err))
 	}
 
-	return resourceNsxtPolicyBgpConfigRead(d, m)
+	return resourceNsxtPolicyBgpConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyBgpConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// BGP object can not be deleted as long as locale service exist
 	// Delete call on NSX should revert settings to default, but this is
 	// not supported on platform as of today
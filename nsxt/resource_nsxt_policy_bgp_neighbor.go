@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -30,10 +32,10 @@ var bgpNeighborConfigRouteFilteringAddressFamilyValues = []string{
 
 func resourceNsxtPolicyBgpNeighbor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyBgpNeighborCreate,
-		Read:   resourceNsxtPolicyBgpNeighborRead,
-		Update: resourceNsxtPolicyBgpNeighborUpdate,
-		Delete: resourceNsxtPolicyBgpNeighborDelete,
+		CreateContext: resourceNsxtPolicyBgpNeighborCreate,
+		ReadContext:   resourceNsxtPolicyBgpNeighborRead,
+		UpdateContext: resourceNsxtPolicyBgpNeighborUpdate,
+		DeleteContext: resourceNsxtPolicyBgpNeighborDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyBgpNeighborImport,
 		},
@@ -211,12 +213,12 @@ func resourceNsxtPolicyBgpNeighborExists(t0ID string, localeServiceID string, ne
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, id string) (model.BgpNeighborConfig, error) {
+func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, id string, m interface{}) (model.BgpNeighborConfig, error) {
 	var neighborStruct model.BgpNeighborConfig
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	allowAsIn := d.Get("allow_as_in").(bool)
 	gracefulRestartMode := d.Get("graceful_restart_mode").(string)
 	holdDownTime := int64(d.Get("hold_down_time").(int))
@@ -243,13 +245,13 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 
 	var rFilters []model.BgpRouteFiltering
 	routeFiltering := d.Get("route_filtering").([]interface{})
-	if len(routeFiltering) > 1 && nsxVersionLower("3.0.0") {
+	if len(routeFiltering) > 1 && nsxVersionLower(m, "3.0.0") {
 		return neighborStruct, fmt.Errorf("Only 1 element for 'route_filtering' is supported with NSX-T versions up to 3.0.0")
 	}
 	for _, filter := range routeFiltering {
 		data := filter.(map[string]interface{})
 		addrFamily := data["address_family"].(string)
-		if addrFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN && nsxVersionLower("3.0.0") {
+		if addrFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN && nsxVersionLower(m, "3.0.0") {
 			return neighborStruct, fmt.Errorf("'%s' is not supported for 'address_family' with NSX-T versions less than 3.0.0", model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN)
 		}
 		enabled := data["enabled"].(bool)
@@ -271,7 +273,7 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 			filterStruct.OutRouteFilters = outFilters
 		}
 
-		if nsxVersionHigherOrEqual("3.0.0") && data["maximum_routes"] != 0 {
+		if nsxVersionHigherOrEqual(m, "3.0.0") && data["maximum_routes"] != 0 {
 			maxRoutes := int64(data["maximum_routes"].(int))
 			filterStruct.MaximumRoutes = &maxRoutes
 		}
@@ -310,7 +312,7 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
 	}
 
-	obj, err := resourceNsxtPolicyBgpNeighborResourceDataToStruct(d, id)
+	obj, err := resourceNsxtPolicyBgpNeighborResourceDataToStruct(d, id, m)
 	if err != nil {
 		return err
 	}
@@ -337,7 +339,7 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 	return nil
 }
 
-func resourceNsxtPolicyBgpNeighborCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 
@@ -348,39 +350,39 @@ func resourceNsxtPolicyBgpNeighborCreate(d *schema.ResourceData, m interface{})
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
 	if t0ID == "" || serviceID == "" {
-		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+		return diag.Errorf("Invalid bgp_path %s", bgpPath)
 	}
 	exists, err := resourceNsxtPolicyBgpNeighborExists(t0ID, serviceID, id, isGlobalManager, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if exists {
-		return fmt.Errorf("BGP Neighbor with ID %s already exists for Tier-O %s and Locale Service %s", id, t0ID, serviceID)
+		return diag.Errorf("BGP Neighbor with ID %s already exists for Tier-O %s and Locale Service %s", id, t0ID, serviceID)
 	}
 
 	err = resourceNsxtPolicyBgpNeighborConvertAndPatch(id, d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyBgpNeighborRead(d, m)
+	return resourceNsxtPolicyBgpNeighborRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining BgpNeighbor ID")
+		return diag.Errorf("Error obtaining BgpNeighbor ID")
 	}
 
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
 	if t0ID == "" || serviceID == "" {
-		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+		return diag.Errorf("Invalid bgp_path %s", bgpPath)
 	}
 
 	var obj model.BgpNeighborConfig
@@ -388,11 +390,11 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 		client := gm_bgp.NewNeighborsClient(connector)
 		gmObj, err := client.Get(t0ID, serviceID, id)
 		if err != nil {
-			return handleReadError(d, "BgpNeighbor", id, err)
+			return diag.FromErr(handleReadError(d, "BgpNeighbor", id, err))
 		}
 		lmObj, err := convertModelBindingType(gmObj, gm_model.BgpNeighborConfigBindingType(), model.BgpNeighborConfigBindingType())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		obj = lmObj.(model.BgpNeighborConfig)
 
@@ -401,13 +403,13 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 		client := bgp.NewNeighborsClient(connector)
 		obj, err = client.Get(t0ID, serviceID, id)
 		if err != nil {
-			return handleReadError(d, "BgpNeighbor", id, err)
+			return diag.FromErr(handleReadError(d, "BgpNeighbor", id, err))
 		}
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -448,7 +450,7 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 		}
 		rf["in_route_filter"] = inFilter
 		rf["out_route_filter"] = outFilter
-		if nsxVersionHigherOrEqual("3.0.0") && filter.MaximumRoutes != nil {
+		if nsxVersionHigherOrEqual(m, "3.0.0") && filter.MaximumRoutes != nil {
 			rf["maximum_routes"] = int(*filter.MaximumRoutes)
 		}
 		rFilters = append(rFilters, rf)
@@ -458,25 +460,25 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtPolicyBgpNeighborUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining BgpNeighbor ID")
+		return diag.Errorf("Error obtaining BgpNeighbor ID")
 	}
 
 	err := resourceNsxtPolicyBgpNeighborConvertAndPatch(id, d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceNsxtPolicyBgpNeighborRead(d, m)
+	return resourceNsxtPolicyBgpNeighborRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining BgpNeighbor ID")
+		return diag.Errorf("Error obtaining BgpNeighbor ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -484,7 +486,7 @@ func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{})
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
 	if t0ID == "" || serviceID == "" {
-		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+		return diag.Errorf("Invalid bgp_path %s", bgpPath)
 	}
 
 	var err error
@@ -496,7 +498,7 @@ func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{})
 		err = client.Delete(t0ID, serviceID, id, nil)
 	}
 	if err != nil {
-		return handleDeleteError("BgpNeighbor", id, err)
+		return diag.FromErr(handleDeleteError("BgpNeighbor", id, err))
 	}
 
 	return nil
@@ -504,9 +506,19 @@ func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{})
 
 func resourceNsxtPolicyBgpNeighborImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	if isPolicyPath(importID) {
+		s := strings.Split(importID, "/neighbors/")
+		if len(s) != 2 {
+			return nil, fmt.Errorf("Invalid BGP neighbor path %s", importID)
+		}
+		d.Set("bgp_path", s[0])
+		d.SetId(s[1])
+		return []*schema.ResourceData{d}, nil
+	}
+
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
-		return nil, fmt.Errorf("Please provide <tier0-id>/<locale-service-id>/<neighbor-id> as an input")
+		return nil, fmt.Errorf("Please provide <tier0-id>/<locale-service-id>/<neighbor-id> or policy path as an input")
 	}
 
 	tier0ID := s[0]
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -45,10 +47,10 @@ var subAttributeReverseKeyMap = map[string]string{
 
 func resourceNsxtPolicyContextProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyContextProfileCreate,
-		Read:   resourceNsxtPolicyContextProfileRead,
-		Update: resourceNsxtPolicyContextProfileUpdate,
-		Delete: resourceNsxtPolicyContextProfileDelete,
+		CreateContext: resourceNsxtPolicyContextProfileCreate,
+		ReadContext:   resourceNsxtPolicyContextProfileRead,
+		UpdateContext: resourceNsxtPolicyContextProfileUpdate,
+		DeleteContext: resourceNsxtPolicyContextProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -184,13 +186,13 @@ func resourceNsxtPolicyContextProfileExists(sessionContext utl.SessionContext, i
 	return false, logAPIError("Error retrieving ContextProfile", err)
 }
 
-func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	context := getSessionContext(d, m)
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyContextProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -201,20 +203,20 @@ func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{
 		if len(attributes) > 0 {
 			err = checkAttributesValid(context, attributes, m, key)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			attributeStructList, err := constructAttributesModelList(attributes, key)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			attributesStructList = append(attributesStructList, attributeStructList...)
 		}
 	}
 	if len(attributesStructList) == 0 {
-		return fmt.Errorf("At least one attribute should be set")
+		return diag.Errorf("At least one attribute should be set")
 	}
 
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.PolicyContextProfile{
 		DisplayName: &displayName,
@@ -228,45 +230,45 @@ func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{
 	client := infra.NewContextProfilesClient(context, connector)
 	err = client.Patch(id, obj, nil)
 	if err != nil {
-		return handleCreateError("ContextProfile", id, err)
+		return diag.FromErr(handleCreateError("ContextProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyContextProfileRead(d, m)
+	return resourceNsxtPolicyContextProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyContextProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ContextProfile ID")
+		return diag.Errorf("Error obtaining ContextProfile ID")
 	}
 
 	client := infra.NewContextProfilesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "ContextProfile", id, err)
+		return diag.FromErr(handleReadError(d, "ContextProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
-	fillAttributesInSchema(d, obj.Attributes)
+	fillAttributesInSchema(d, obj.Attributes, m)
 
 	return nil
 }
 
-func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	context := getSessionContext(d, m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ContextProfile ID")
+		return diag.Errorf("Error obtaining ContextProfile ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -278,15 +280,15 @@ func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{
 		attributes := d.Get(key).(*schema.Set).List()
 		err := checkAttributesValid(context, attributes, m, key)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		attributeStructList, err := constructAttributesModelList(attributes, key)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		attributesStructList = append(attributesStructList, attributeStructList...)
 	}
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.PolicyContextProfile{
 		DisplayName: &displayName,
@@ -300,16 +302,16 @@ func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{
 	err = client.Patch(id, obj, nil)
 
 	if err != nil {
-		return handleUpdateError("ContextProfile", id, err)
+		return diag.FromErr(handleUpdateError("ContextProfile", id, err))
 	}
 
-	return resourceNsxtPolicyContextProfileRead(d, m)
+	return resourceNsxtPolicyContextProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyContextProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ContextProfile ID")
+		return diag.Errorf("Error obtaining ContextProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -318,7 +320,7 @@ func resourceNsxtPolicyContextProfileDelete(d *schema.ResourceData, m interface{
 	client := infra.NewContextProfilesClient(getSessionContext(d, m), connector)
 	err = client.Delete(id, &force, nil)
 	if err != nil {
-		return handleDeleteError("ContextProfile", id, err)
+		return diag.FromErr(handleDeleteError("ContextProfile", id, err))
 	}
 
 	return nil
@@ -458,7 +460,7 @@ func constructSubAttributeModelList(rawSubAttributes []interface{}) ([]model.Pol
 	return res, nil
 }
 
-func fillAttributesInSchema(d *schema.ResourceData, policyAttributes []model.PolicyAttributes) {
+func fillAttributesInSchema(d *schema.ResourceData, policyAttributes []model.PolicyAttributes, m interface{}) {
 	attributes := make(map[string][]interface{})
 	for _, policyAttribute := range policyAttributes {
 		elem := make(map[string]interface{})
@@ -470,7 +472,7 @@ func fillAttributesInSchema(d *schema.ResourceData, policyAttributes []model.Pol
 				elem["sub_attribute"] = fillSubAttributesInSchema(policyAttribute.SubAttributes)
 			}
 			elem["is_alg_type"] = policyAttribute.IsALGType
-		} else if *policyAttribute.Key == model.PolicyAttributes_KEY_CUSTOM_URL && nsxVersionHigherOrEqual("4.0.0") {
+		} else if *policyAttribute.Key == model.PolicyAttributes_KEY_CUSTOM_URL && nsxVersionHigherOrEqual(m, "4.0.0") {
 			elem["custom_url_partial_match"] = policyAttribute.CustomUrlPartialMatch
 		}
 		attributes[key] = append(attributes[key], elem)
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
@@ -34,11 +36,11 @@ func makeCustomAttributeID(key string, attribute string) string {
 
 func resourceNsxtPolicyContextProfileCustomAttribute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyContextProfileCustomAttributeCreate,
-		Read:   resourceNsxtPolicyContextProfileCustomAttributeRead,
-		Delete: resourceNsxtPolicyContextProfileCustomAttributeDelete,
+		CreateContext: resourceNsxtPolicyContextProfileCustomAttributeCreate,
+		ReadContext:   resourceNsxtPolicyContextProfileCustomAttributeRead,
+		DeleteContext: resourceNsxtPolicyContextProfileCustomAttributeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceNsxtPolicyContextProfileCustomAttributeImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,7 +98,7 @@ func resourceNsxtPolicyContextProfileCustomAttributeExists(sessionContext utl.Se
 	return false, err
 }
 
-func resourceNsxtPolicyContextProfileCustomAttributeRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCustomAttributeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	key, attribute := splitCustomAttributeID(id)
 
@@ -105,17 +107,17 @@ func resourceNsxtPolicyContextProfileCustomAttributeRead(d *schema.ResourceData,
 	connector := getPolicyConnector(m)
 	exists, err := resourceNsxtPolicyContextProfileCustomAttributeExists(getSessionContext(d, m), id, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if !exists {
-		return errors.NotFound{}
+		return diag.FromErr(errors.NotFound{})
 	}
 	d.Set("key", key)
 	d.Set("attribute", attribute)
 	return nil
 }
 
-func resourceNsxtPolicyContextProfileCustomAttributeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCustomAttributeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var err error
 	key := d.Get("key").(string)
 	attribute := d.Get("attribute").(string)
@@ -135,24 +137,24 @@ func resourceNsxtPolicyContextProfileCustomAttributeCreate(d *schema.ResourceDat
 	client := infra.NewDefaultClient(getSessionContext(d, m), connector)
 	err = client.Create(obj, "add")
 	if err != nil {
-		return handleCreateError("ContextProfileCustomAttribute", attribute, err)
+		return diag.FromErr(handleCreateError("ContextProfileCustomAttribute", attribute, err))
 	}
 
 	d.Set("key", key)
 	d.Set("attribute", attribute)
 	d.SetId(makeCustomAttributeID(key, attribute))
 
-	return resourceNsxtPolicyContextProfileCustomAttributeRead(d, m)
+	return resourceNsxtPolicyContextProfileCustomAttributeRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyContextProfileCustomAttributeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCustomAttributeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	key, attribute := splitCustomAttributeID(d.Id())
 	log.Printf("[INFO] Deleting ContextProfileCustomAttribute with ID %s", attribute)
 	attributes := []string{attribute}
-	err := resourceNsxtPolicyContextProfileCustomAttributeRead(d, m)
+	diags := resourceNsxtPolicyContextProfileCustomAttributeRead(ctx, d, m)
 
-	if err != nil {
-		return err
+	if diags.HasError() {
+		return diags
 	}
 
 	connector := getPolicyConnector(m)
@@ -166,10 +168,28 @@ func resourceNsxtPolicyContextProfileCustomAttributeDelete(d *schema.ResourceDat
 
 	// PATCH the resource
 	client := infra.NewDefaultClient(getSessionContext(d, m), connector)
-	err = client.Create(obj, "remove")
+	err := client.Create(obj, "remove")
 
 	if err != nil {
-		return handleDeleteError("ContextProfileCustomAttribute", attribute, err)
+		return diag.FromErr(handleDeleteError("ContextProfileCustomAttribute", attribute, err))
+	}
+	return nil
+}
+
+// Import ID is <key>~<attribute>, optionally prefixed with policy path of
+// custom attributes, such as /infra/context-profiles/custom-attributes/default
+func resourceNsxtPolicyContextProfileCustomAttributeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if isPolicyPath(importID) {
+		if !strings.Contains(importID, "/context-profiles/custom-attributes/default/") {
+			return nil, fmt.Errorf("Invalid custom attribute path %s", importID)
+		}
+		if _, err := nsxtPolicyPathResourceImporterHelper(d, m); err != nil {
+			return nil, err
+		}
+	}
+	if s := strings.Split(d.Id(), "~"); len(s) != 2 || s[0] == "" || s[1] == "" {
+		return nil, fmt.Errorf("Please provide <key>~<attribute> as an input, got %s", d.Id())
 	}
-	return err
+	return []*schema.ResourceData{d}, nil
 }
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -17,10 +18,10 @@ import (
 
 func resourceNsxtPolicyDhcpRelayConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpRelayConfigCreate,
-		Read:   resourceNsxtPolicyDhcpRelayConfigRead,
-		Update: resourceNsxtPolicyDhcpRelayConfigUpdate,
-		Delete: resourceNsxtPolicyDhcpRelayConfigDelete,
+		CreateContext: resourceNsxtPolicyDhcpRelayConfigCreate,
+		ReadContext:   resourceNsxtPolicyDhcpRelayConfigRead,
+		UpdateContext: resourceNsxtPolicyDhcpRelayConfigUpdate,
+		DeleteContext: resourceNsxtPolicyDhcpRelayConfigDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -60,23 +61,23 @@ func resourceNsxtPolicyDhcpRelayConfigExists(sessionContext utl.SessionContext,
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpRelayConfigsClient(getSessionContext(d, m), connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyDhcpRelayConfigExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	serverAddresses := getStringListFromSchemaList(d, "server_addresses")
 
 	obj := model.DhcpRelayConfig{
@@ -90,36 +91,36 @@ func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface
 	log.Printf("[INFO] Creating DhcpRelayConfig with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("DhcpRelayConfig", id, err)
+		return diag.FromErr(handleCreateError("DhcpRelayConfig", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDhcpRelayConfigRead(d, m)
+	return resourceNsxtPolicyDhcpRelayConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpRelayConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpRelayConfigsClient(getSessionContext(d, m), connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
+		return diag.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "DhcpRelayConfig", id, err)
+		return diag.FromErr(handleReadError(d, "DhcpRelayConfig", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -129,22 +130,22 @@ func resourceNsxtPolicyDhcpRelayConfigRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyDhcpRelayConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpRelayConfigsClient(getSessionContext(d, m), connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
+		return diag.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 
 	serverAddresses := getStringListFromSchemaList(d, "server_addresses")
@@ -159,27 +160,27 @@ func resourceNsxtPolicyDhcpRelayConfigUpdate(d *schema.ResourceData, m interface
 
 	_, err := client.Update(id, obj)
 	if err != nil {
-		return handleUpdateError("DhcpRelayConfig", id, err)
+		return diag.FromErr(handleUpdateError("DhcpRelayConfig", id, err))
 	}
 
-	return resourceNsxtPolicyDhcpRelayConfigRead(d, m)
+	return resourceNsxtPolicyDhcpRelayConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpRelayConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
+		return diag.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpRelayConfigsClient(getSessionContext(d, m), connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("DhcpRelayConfig", id, err)
+		return diag.FromErr(handleDeleteError("DhcpRelayConfig", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,10 +19,10 @@ import (
 
 func resourceNsxtPolicyDhcpServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpServerCreate,
-		Read:   resourceNsxtPolicyDhcpServerRead,
-		Update: resourceNsxtPolicyDhcpServerUpdate,
-		Delete: resourceNsxtPolicyDhcpServerDelete,
+		CreateContext: resourceNsxtPolicyDhcpServerCreate,
+		ReadContext:   resourceNsxtPolicyDhcpServerRead,
+		UpdateContext: resourceNsxtPolicyDhcpServerUpdate,
+		DeleteContext: resourceNsxtPolicyDhcpServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -82,10 +83,10 @@ func resourceNsxtPolicyDhcpServerExists(sessionContext utl.SessionContext, id st
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyDhcpServerSchemaToModel(d *schema.ResourceData) model.DhcpServerConfig {
+func resourceNsxtPolicyDhcpServerSchemaToModel(d *schema.ResourceData, m interface{}) model.DhcpServerConfig {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	leaseTime := int64(d.Get("lease_time").(int))
 	preferredEdgePaths := interface2StringList(d.Get("preferred_edge_paths").([]interface{}))
@@ -113,46 +114,46 @@ func resourceNsxtPolicyDhcpServerSchemaToModel(d *schema.ResourceData) model.Dhc
 	return obj
 }
 
-func resourceNsxtPolicyDhcpServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyDhcpServerExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating DhcpServer with ID %s", id)
 	client := infra.NewDhcpServerConfigsClient(getSessionContext(d, m), connector)
-	err = client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d))
+	err = client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d, m))
 	if err != nil {
-		return handleCreateError("DhcpServer", id, err)
+		return diag.FromErr(handleCreateError("DhcpServer", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDhcpServerRead(d, m)
+	return resourceNsxtPolicyDhcpServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpServer ID")
+		return diag.Errorf("Error obtaining DhcpServer ID")
 	}
 
 	client := infra.NewDhcpServerConfigsClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "DhcpServer", id, err)
+		return diag.FromErr(handleReadError(d, "DhcpServer", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -165,31 +166,31 @@ func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtPolicyDhcpServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpServerConfigsClient(getSessionContext(d, m), connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpServer ID")
+		return diag.Errorf("Error obtaining DhcpServer ID")
 	}
 
 	// Update the resource using PATCH
-	err := client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d))
+	err := client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d, m))
 	if err != nil {
-		return handleUpdateError("DhcpServer", id, err)
+		return diag.FromErr(handleUpdateError("DhcpServer", id, err))
 	}
 
-	return resourceNsxtPolicyDhcpServerRead(d, m)
+	return resourceNsxtPolicyDhcpServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpServer ID")
+		return diag.Errorf("Error obtaining DhcpServer ID")
 	}
 
 	var err error
@@ -198,7 +199,7 @@ func resourceNsxtPolicyDhcpServerDelete(d *schema.ResourceData, m interface{}) e
 	err = client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("DhcpServer", id, err)
+		return diag.FromErr(handleDeleteError("DhcpServer", id, err))
 	}
 
 	return nil
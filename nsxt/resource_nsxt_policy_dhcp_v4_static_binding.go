@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -23,10 +25,10 @@ import (
 
 func resourceNsxtPolicyDhcpV4StaticBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpV4StaticBindingCreate,
-		Read:   resourceNsxtPolicyDhcpV4StaticBindingRead,
-		Update: resourceNsxtPolicyDhcpV4StaticBindingUpdate,
-		Delete: resourceNsxtPolicyDhcpStaticBindingDelete,
+		CreateContext: resourceNsxtPolicyDhcpV4StaticBindingCreate,
+		ReadContext:   resourceNsxtPolicyDhcpV4StaticBindingRead,
+		UpdateContext: resourceNsxtPolicyDhcpV4StaticBindingUpdate,
+		DeleteContext: resourceNsxtPolicyDhcpStaticBindingDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtSegmentResourceImporter,
 		},
@@ -107,7 +109,7 @@ func policyDhcpV4StaticBindingConvertAndPatch(d *schema.ResourceData, segmentPat
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	gatewayAddress := d.Get("gateway_address").(string)
 	hostName := d.Get("hostname").(string)
 	ipAddress := d.Get("ip_address").(string)
@@ -184,34 +186,34 @@ func getDhcpOptsFromSchema(d *schema.ResourceData) *model.DhcpV4Options {
 
 }
 
-func resourceNsxtPolicyDhcpV4StaticBindingCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV4StaticBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	segmentPath := d.Get("segment_path").(string)
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyDhcpStaticBindingExists(segmentPath))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating DhcpV4 Static Binding Config with ID %s on segment %s", id, segmentPath)
 	err = policyDhcpV4StaticBindingConvertAndPatch(d, segmentPath, id, m)
 
 	if err != nil {
-		return handleCreateError("DhcpV4 Static Binding Config", id, err)
+		return diag.FromErr(handleCreateError("DhcpV4 Static Binding Config", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDhcpV4StaticBindingRead(d, m)
+	return resourceNsxtPolicyDhcpV4StaticBindingRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV4StaticBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
+		return diag.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
 	}
 
 	segmentPath := d.Get("segment_path").(string)
@@ -222,12 +224,12 @@ func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interfa
 	var dhcpObj *data.StructValue
 	isT0, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
 	if isT0 {
-		return fmt.Errorf("This resource is not applicable to segment %s", segmentPath)
+		return diag.Errorf("This resource is not applicable to segment %s", segmentPath)
 	}
 
 	context := getSessionContext(d, m)
 	if context.ClientType == utl.Global && gwID != "" {
-		return fmt.Errorf("This resource is not applicable to segment on Global Manager %s", segmentPath)
+		return diag.Errorf("This resource is not applicable to segment on Global Manager %s", segmentPath)
 	}
 
 	if gwID == "" {
@@ -241,22 +243,22 @@ func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interfa
 	}
 
 	if err != nil {
-		return handleReadError(d, "DhcpV4 Static Binding Config", id, err)
+		return diag.FromErr(handleReadError(d, "DhcpV4 Static Binding Config", id, err))
 	}
 
 	convObj, errs := converter.ConvertToGolang(dhcpObj, model.DhcpV4StaticBindingConfigBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 	obj = convObj.(model.DhcpV4StaticBindingConfig)
 
 	if obj.ResourceType != "DhcpV4StaticBindingConfig" {
-		return handleReadError(d, "DhcpV4 Static Binding Config", id, fmt.Errorf("Unexpected ResourceType"))
+		return diag.FromErr(handleReadError(d, "DhcpV4 Static Binding Config", id, fmt.Errorf("Unexpected ResourceType")))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -280,26 +282,26 @@ func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func resourceNsxtPolicyDhcpV4StaticBindingUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV4StaticBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
+		return diag.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
 	}
 	segmentPath := d.Get("segment_path").(string)
 
 	log.Printf("[INFO] Updating DhcpV4 Static Binding Config with ID %s", id)
 	err := policyDhcpV4StaticBindingConvertAndPatch(d, segmentPath, id, m)
 	if err != nil {
-		return handleUpdateError("DhcpV4 Static Binding Config", id, err)
+		return diag.FromErr(handleUpdateError("DhcpV4 Static Binding Config", id, err))
 	}
 
-	return resourceNsxtPolicyDhcpV4StaticBindingRead(d, m)
+	return resourceNsxtPolicyDhcpV4StaticBindingRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpStaticBindingDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpStaticBindingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dhcp Static Binding Config ID")
+		return diag.Errorf("Error obtaining Dhcp Static Binding Config ID")
 	}
 	segmentPath := d.Get("segment_path").(string)
 	_, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
@@ -318,7 +320,7 @@ func resourceNsxtPolicyDhcpStaticBindingDelete(d *schema.ResourceData, m interfa
 	}
 
 	if err != nil {
-		return handleDeleteError("Dhcp Static Binding Config", id, err)
+		return diag.FromErr(handleDeleteError("Dhcp Static Binding Config", id, err))
 	}
 
 	return nil
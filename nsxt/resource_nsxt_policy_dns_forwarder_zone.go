@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,10 +19,10 @@ import (
 
 func resourceNsxtPolicyDNSForwarderZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDNSForwarderZoneCreate,
-		Read:   resourceNsxtPolicyDNSForwarderZoneRead,
-		Update: resourceNsxtPolicyDNSForwarderZoneUpdate,
-		Delete: resourceNsxtPolicyDNSForwarderZoneDelete,
+		CreateContext: resourceNsxtPolicyDNSForwarderZoneCreate,
+		ReadContext:   resourceNsxtPolicyDNSForwarderZoneRead,
+		UpdateContext: resourceNsxtPolicyDNSForwarderZoneUpdate,
+		DeleteContext: resourceNsxtPolicyDNSForwarderZoneDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -72,7 +73,7 @@ func resourceNsxtPolicyDNSForwarderZoneExists(sessionContext utl.SessionContext,
 func policyDNSForwarderZonePatch(id string, d *schema.ResourceData, m interface{}, connector client.Connector) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	dnsDomainNames := getStringListFromSchemaList(d, "dns_domain_names")
 	sourceIP := d.Get("source_ip").(string)
 	upstreamServers := getStringListFromSchemaList(d, "upstream_servers")
@@ -97,45 +98,45 @@ func policyDNSForwarderZonePatch(id string, d *schema.ResourceData, m interface{
 	return client.Patch(id, obj)
 }
 
-func resourceNsxtPolicyDNSForwarderZoneCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyDNSForwarderZoneExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating Dns Forwarder Zone with ID %s", id)
 	err = policyDNSForwarderZonePatch(id, d, m, connector)
 
 	if err != nil {
-		return handleCreateError("Dns Forwarder Zone", id, err)
+		return diag.FromErr(handleCreateError("Dns Forwarder Zone", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDNSForwarderZoneRead(d, m)
+	return resourceNsxtPolicyDNSForwarderZoneRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDNSForwarderZoneRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dns Forwarder Zone ID")
+		return diag.Errorf("Error obtaining Dns Forwarder Zone ID")
 	}
 
 	client := infra.NewDnsForwarderZonesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "Dns Forwarder Zone", id, err)
+		return diag.FromErr(handleReadError(d, "Dns Forwarder Zone", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -147,27 +148,27 @@ func resourceNsxtPolicyDNSForwarderZoneRead(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func resourceNsxtPolicyDNSForwarderZoneUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dns Forwarder Zone ID")
+		return diag.Errorf("Error obtaining Dns Forwarder Zone ID")
 	}
 
 	log.Printf("[INFO] Updating Dns Forwarder Zone with ID %s", id)
 	err := policyDNSForwarderZonePatch(id, d, m, connector)
 	if err != nil {
-		return handleUpdateError("Dns Forwarder Zone", id, err)
+		return diag.FromErr(handleUpdateError("Dns Forwarder Zone", id, err))
 	}
 
-	return resourceNsxtPolicyDNSForwarderZoneRead(d, m)
+	return resourceNsxtPolicyDNSForwarderZoneRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDNSForwarderZoneDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dns Forwarder Zone ID")
+		return diag.Errorf("Error obtaining Dns Forwarder Zone ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -175,7 +176,7 @@ func resourceNsxtPolicyDNSForwarderZoneDelete(d *schema.ResourceData, m interfac
 	err := client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("Dns Forwarder Zone", id, err)
+		return diag.FromErr(handleDeleteError("Dns Forwarder Zone", id, err))
 	}
 
 	return nil
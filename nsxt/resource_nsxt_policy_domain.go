@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
@@ -20,12 +22,12 @@ import (
 // This resource is supported only for Policy Global Manager
 func resourceNsxtPolicyDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDomainCreate,
-		Read:   resourceNsxtPolicyDomainRead,
-		Update: resourceNsxtPolicyDomainUpdate,
-		Delete: resourceNsxtPolicyDomainDelete,
+		CreateContext: resourceNsxtPolicyDomainCreate,
+		ReadContext:   resourceNsxtPolicyDomainRead,
+		UpdateContext: resourceNsxtPolicyDomainUpdate,
+		DeleteContext: resourceNsxtPolicyDomainDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -154,16 +156,16 @@ func setDomainStructWithChildren(m interface{}, domain *model.Domain, locations
 	return nil
 }
 
-func resourceNsxtPolicyDomainCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDomainExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	Type := "Domain"
 	obj := model.Domain{
@@ -176,7 +178,7 @@ func resourceNsxtPolicyDomainCreate(d *schema.ResourceData, m interface{}) error
 	locations := getStringListFromSchemaSet(d, "sites")
 	err = setDomainStructWithChildren(m, &obj, locations, false)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	childDomain := model.ChildDomain{
@@ -187,7 +189,7 @@ func resourceNsxtPolicyDomainCreate(d *schema.ResourceData, m interface{}) error
 	converter := bindings.NewTypeConverter()
 	dataValue, errors := converter.ConvertToVapi(childDomain, model.ChildDomainBindingType())
 	if errors != nil {
-		return fmt.Errorf("Error converting Domain Child: %v", errors[0])
+		return diag.Errorf("Error converting Domain Child: %v", errors[0])
 	}
 
 	infraType := "Infra"
@@ -202,41 +204,41 @@ func resourceNsxtPolicyDomainCreate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Creating Domain with ID %s", id)
 	err = policyInfraPatch(getSessionContext(d, m), infraStruct, getPolicyConnector(m), false)
 	if err != nil {
-		return handleCreateError("Domain", id, err)
+		return diag.FromErr(handleCreateError("Domain", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDomainRead(d, m)
+	return resourceNsxtPolicyDomainRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDomainRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Domain ID")
+		return diag.Errorf("Error obtaining Domain ID")
 	}
 
 	if !isPolicyGlobalManager(m) {
-		return handleCreateError("Domain", id, fmt.Errorf("Domain resource is not supported for local manager"))
+		return diag.FromErr(handleCreateError("Domain", id, fmt.Errorf("Domain resource is not supported for local manager")))
 	}
 
 	client := gm_infra.NewDomainsClient(connector)
 	gmObj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "Domain", id, err)
+		return diag.FromErr(handleReadError(d, "Domain", id, err))
 	}
 
 	lmObj, err := convertModelBindingType(gmObj, gm_model.DomainBindingType(), model.DomainBindingType())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	obj := lmObj.(model.Domain)
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -245,7 +247,7 @@ func resourceNsxtPolicyDomainRead(d *schema.ResourceData, m interface{}) error {
 	dmClient := gm_domain.NewDomainDeploymentMapsClient(connector)
 	objList, err := dmClient.List(id, nil, nil, nil, nil, nil, nil)
 	if err != nil {
-		return handleListError("Domain", err)
+		return diag.FromErr(handleListError("Domain", err))
 	}
 	var locations []string
 	for _, objInList := range objList.Results {
@@ -255,15 +257,15 @@ func resourceNsxtPolicyDomainRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyDomainUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDomainUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Domain ID")
+		return diag.Errorf("Error obtaining Domain ID")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	Type := "Domain"
 	obj := model.Domain{
 		Id:           &id,
@@ -275,7 +277,7 @@ func resourceNsxtPolicyDomainUpdate(d *schema.ResourceData, m interface{}) error
 	locations := getStringListFromSchemaSet(d, "sites")
 	err := setDomainStructWithChildren(m, &obj, locations, true)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	childDomain := model.ChildDomain{
@@ -286,7 +288,7 @@ func resourceNsxtPolicyDomainUpdate(d *schema.ResourceData, m interface{}) error
 	converter := bindings.NewTypeConverter()
 	dataValue, errors := converter.ConvertToVapi(childDomain, model.ChildDomainBindingType())
 	if errors != nil {
-		return fmt.Errorf("Error converting Domain Child: %v", errors[0])
+		return diag.Errorf("Error converting Domain Child: %v", errors[0])
 	}
 
 	infraType := "Infra"
@@ -299,22 +301,22 @@ func resourceNsxtPolicyDomainUpdate(d *schema.ResourceData, m interface{}) error
 
 	err = policyInfraPatch(getSessionContext(d, m), infraStruct, getPolicyConnector(m), false)
 	if err != nil {
-		return handleUpdateError("Domain", id, err)
+		return diag.FromErr(handleUpdateError("Domain", id, err))
 	}
-	return resourceNsxtPolicyDomainRead(d, m)
+	return resourceNsxtPolicyDomainRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDomainDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Domain ID")
+		return diag.Errorf("Error obtaining Domain ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := gm_infra.NewDomainsClient(connector)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("Domain", id, err)
+		return diag.FromErr(handleDeleteError("Domain", id, err))
 	}
 
 	return nil
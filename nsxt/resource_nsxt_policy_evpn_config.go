@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -21,10 +22,10 @@ var policyEvpnConfigTypeValues = []string{
 
 func resourceNsxtPolicyEvpnConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnConfigCreate,
-		Read:   resourceNsxtPolicyEvpnConfigRead,
-		Update: resourceNsxtPolicyEvpnConfigUpdate,
-		Delete: resourceNsxtPolicyEvpnConfigDelete,
+		CreateContext: resourceNsxtPolicyEvpnConfigCreate,
+		ReadContext:   resourceNsxtPolicyEvpnConfigRead,
+		UpdateContext: resourceNsxtPolicyEvpnConfigUpdate,
+		DeleteContext: resourceNsxtPolicyEvpnConfigDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyEvpnConfigImport,
 		},
@@ -59,27 +60,27 @@ func policyEvpnConfigGet(connector client.Connector, gwID string) (model.EvpnCon
 	return client.Get(gwID)
 }
 
-func resourceNsxtPolicyEvpnConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 	if !isT0 {
-		return fmt.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
+		return diag.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
 	}
 
 	obj, err := policyEvpnConfigGet(connector, gwID)
 
 	if err != nil {
-		return handleReadError(d, "Evpn Config", gwID, err)
+		return diag.FromErr(handleReadError(d, "Evpn Config", gwID, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 	d.Set("mode", obj.Mode)
@@ -91,13 +92,13 @@ func resourceNsxtPolicyEvpnConfigRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func patchNsxtPolicyEvpnConfig(connector client.Connector, d *schema.ResourceData, gwID string, isGlobalManager bool) error {
+func patchNsxtPolicyEvpnConfig(connector client.Connector, d *schema.ResourceData, gwID string, isGlobalManager bool, m interface{}) error {
 
 	var obj model.EvpnConfig
 	if d != nil {
 		displayName := d.Get("display_name").(string)
 		description := d.Get("description").(string)
-		tags := getPolicyTagsFromSchema(d)
+		tags := getPolicyTagsFromSchema(d, m)
 		vniPoolPath := d.Get("vni_pool_path").(string)
 		evpnTenantPath := d.Get("evpn_tenant_path").(string)
 		mode := d.Get("mode").(string)
@@ -125,62 +126,62 @@ func patchNsxtPolicyEvpnConfig(connector client.Connector, d *schema.ResourceDat
 	return client.Patch(gwID, obj)
 }
 
-func resourceNsxtPolicyEvpnConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 	if !isT0 {
-		return fmt.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
+		return diag.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
 	}
 
 	isGlobalManager := isPolicyGlobalManager(m)
 
 	log.Printf("[INFO] Creating EVPN Config for Gateway %s", gwID)
 
-	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isGlobalManager)
+	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isGlobalManager, m)
 	if err != nil {
-		return handleCreateError("Evpn Config", gwID, err)
+		return diag.FromErr(handleCreateError("Evpn Config", gwID, err))
 	}
 
 	d.SetId(gwID)
 
-	return resourceNsxtPolicyEvpnConfigRead(d, m)
+	return resourceNsxtPolicyEvpnConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	log.Printf("[INFO] Updating Evpn Config with ID %s", gwID)
-	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isPolicyGlobalManager(m))
+	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isPolicyGlobalManager(m), m)
 	if err != nil {
-		return handleUpdateError("Evpn Config", gwID, err)
+		return diag.FromErr(handleUpdateError("Evpn Config", gwID, err))
 	}
 
-	return resourceNsxtPolicyEvpnConfigRead(d, m)
+	return resourceNsxtPolicyEvpnConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	// There is no DELETE API for this object - we need to just disable it
-	err := patchNsxtPolicyEvpnConfig(connector, nil, gwID, isPolicyGlobalManager(m))
+	err := patchNsxtPolicyEvpnConfig(connector, nil, gwID, isPolicyGlobalManager(m), m)
 	if err != nil {
-		return handleDeleteError("Evpn Config", gwID, err)
+		return diag.FromErr(handleDeleteError("Evpn Config", gwID, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtPolicyEvpnTenant() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnTenantCreate,
-		Read:   resourceNsxtPolicyEvpnTenantRead,
-		Update: resourceNsxtPolicyEvpnTenantUpdate,
-		Delete: resourceNsxtPolicyEvpnTenantDelete,
+		CreateContext: resourceNsxtPolicyEvpnTenantCreate,
+		ReadContext:   resourceNsxtPolicyEvpnTenantRead,
+		UpdateContext: resourceNsxtPolicyEvpnTenantUpdate,
+		DeleteContext: resourceNsxtPolicyEvpnTenantDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,7 +104,7 @@ func policyEvpnTenantPatch(id string, d *schema.ResourceData, m interface{}) err
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	tzPath := d.Get("transport_zone_path").(string)
 	vniPoolPath := d.Get("vni_pool_path").(string)
 	mappings := getEvpnTenantMappingsFromSchema(d)
@@ -122,49 +123,49 @@ func policyEvpnTenantPatch(id string, d *schema.ResourceData, m interface{}) err
 	return client.Patch(id, obj)
 }
 
-func resourceNsxtPolicyEvpnTenantCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTenantCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyEvpnTenantExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating Evpn Tenant with ID %s", id)
 	err = policyEvpnTenantPatch(id, d, m)
 	if err != nil {
-		return handleCreateError("Evpn Tenant", id, err)
+		return diag.FromErr(handleCreateError("Evpn Tenant", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyEvpnTenantRead(d, m)
+	return resourceNsxtPolicyEvpnTenantRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnTenantRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTenantRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Evpn Tenant ID")
+		return diag.Errorf("Error obtaining Evpn Tenant ID")
 	}
 
 	client := infra.NewEvpnTenantConfigsClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "Evpn Tenant", id, err)
+		return diag.FromErr(handleReadError(d, "Evpn Tenant", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("transport_zone_path", obj.TransportZonePath)
 	d.Set("vni_pool_path", obj.VniPoolPath)
 
 	err = setEvpnTenantMappingsInSchema(d, obj.Mappings)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
@@ -173,26 +174,26 @@ func resourceNsxtPolicyEvpnTenantRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtPolicyEvpnTenantUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTenantUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Evpn Tenant ID")
+		return diag.Errorf("Error obtaining Evpn Tenant ID")
 	}
 
 	log.Printf("[INFO] Creating Evpn Tenant with ID %s", id)
 	err := policyEvpnTenantPatch(id, d, m)
 	if err != nil {
-		return handleUpdateError("Evpn Tenant", id, err)
+		return diag.FromErr(handleUpdateError("Evpn Tenant", id, err))
 	}
 
-	return resourceNsxtPolicyEvpnTenantRead(d, m)
+	return resourceNsxtPolicyEvpnTenantRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnTenantDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTenantDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Evpn Tenant ID")
+		return diag.Errorf("Error obtaining Evpn Tenant ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -200,7 +201,7 @@ func resourceNsxtPolicyEvpnTenantDelete(d *schema.ResourceData, m interface{}) e
 	err := client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("Evpn Tenant", id, err)
+		return diag.FromErr(handleDeleteError("Evpn Tenant", id, err))
 	}
 
 	return nil
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -16,10 +18,10 @@ import (
 
 func resourceNsxtPolicyEvpnTunnelEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnTunnelEndpointCreate,
-		Read:   resourceNsxtPolicyEvpnTunnelEndpointRead,
-		Update: resourceNsxtPolicyEvpnTunnelEndpointUpdate,
-		Delete: resourceNsxtPolicyEvpnTunnelEndpointDelete,
+		CreateContext: resourceNsxtPolicyEvpnTunnelEndpointCreate,
+		ReadContext:   resourceNsxtPolicyEvpnTunnelEndpointRead,
+		UpdateContext: resourceNsxtPolicyEvpnTunnelEndpointUpdate,
+		DeleteContext: resourceNsxtPolicyEvpnTunnelEndpointDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyEvpnTunnelEndpointImport,
 		},
@@ -59,7 +61,7 @@ func policyEvpnTunnelEndpointPatch(d *schema.ResourceData, m interface{}, gwID s
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	edgePath := d.Get("edge_node_path").(string)
 	mtu := int64(d.Get("mtu").(int))
 	localAddress := d.Get("local_address").(string)
@@ -96,14 +98,14 @@ func resourceNsxtPolicyEvpnTunnelEndpointExists(connector client.Connector, gwID
 	return true, err
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("nsx_id").(string)
 	interfacePath := d.Get("external_interface_path").(string)
 	isT0, gwID, localeServiceID, _ := parseGatewayInterfacePolicyPath(interfacePath)
 	if !isT0 || gwID == "" {
-		return fmt.Errorf("Tier0 External Interface path expected, got %s", interfacePath)
+		return diag.Errorf("Tier0 External Interface path expected, got %s", interfacePath)
 	}
 
 	if id == "" {
@@ -111,16 +113,16 @@ func resourceNsxtPolicyEvpnTunnelEndpointCreate(d *schema.ResourceData, m interf
 	} else {
 		exists, err := resourceNsxtPolicyEvpnTunnelEndpointExists(connector, gwID, localeServiceID, id)
 		if exists {
-			return fmt.Errorf("EVPN Tunnel Endpoint with nsx_id '%s' already exists on Gateway %s and Locale Service %s", id, gwID, localeServiceID)
+			return diag.Errorf("EVPN Tunnel Endpoint with nsx_id '%s' already exists on Gateway %s and Locale Service %s", id, gwID, localeServiceID)
 		} else if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	}
 
 	err := policyEvpnTunnelEndpointPatch(d, m, gwID, localeServiceID, id)
 	if err != nil {
-		return handleCreateError("EVPN Tunnel Endpoint", id, err)
+		return diag.FromErr(handleCreateError("EVPN Tunnel Endpoint", id, err))
 	}
 
 	d.Set("nsx_id", id)
@@ -129,10 +131,10 @@ func resourceNsxtPolicyEvpnTunnelEndpointCreate(d *schema.ResourceData, m interf
 	d.Set("external_interface_path", interfacePath)
 	d.SetId(id)
 
-	return resourceNsxtPolicyEvpnTunnelEndpointRead(d, m)
+	return resourceNsxtPolicyEvpnTunnelEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -142,7 +144,7 @@ func resourceNsxtPolicyEvpnTunnelEndpointRead(d *schema.ResourceData, m interfac
 	client := locale_services.NewEvpnTunnelEndpointsClient(connector)
 	obj, err := client.Get(gwID, localeServiceID, id)
 	if err != nil {
-		return handleReadError(d, "EVPN Tunnel Endpoint", id, err)
+		return diag.FromErr(handleReadError(d, "EVPN Tunnel Endpoint", id, err))
 	}
 
 	d.Set("edge_node_path", obj.EdgePath)
@@ -153,7 +155,7 @@ func resourceNsxtPolicyEvpnTunnelEndpointRead(d *schema.ResourceData, m interfac
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -162,48 +164,86 @@ func resourceNsxtPolicyEvpnTunnelEndpointRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	err := policyEvpnTunnelEndpointPatch(d, m, gwID, localeServiceID, id)
 	if err != nil {
-		return handleCreateError("EVPN Tunnel Endpoint", id, err)
+		return diag.FromErr(handleCreateError("EVPN Tunnel Endpoint", id, err))
 	}
 
-	return resourceNsxtPolicyEvpnTunnelEndpointRead(d, m)
+	return resourceNsxtPolicyEvpnTunnelEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	client := locale_services.NewEvpnTunnelEndpointsClient(connector)
 	err := client.Delete(gwID, localeServiceID, id)
 
 	if err != nil {
-		return handleDeleteError("EVPN Tunnel Endpoint", id, err)
+		return diag.FromErr(handleDeleteError("EVPN Tunnel Endpoint", id, err))
 	}
 
 	return nil
 }
 
+// Endpoint does not refer to its external interface, hence the interface is
+// looked up by edge node of the endpoint
+func getPolicyEvpnTunnelEndpointInterfaceID(connector client.Connector, gwID string, localeServiceID string, id string) (string, error) {
+	obj, err := locale_services.NewEvpnTunnelEndpointsClient(connector).Get(gwID, localeServiceID, id)
+	if err != nil {
+		return "", err
+	}
+	interfaces, err := locale_services.NewInterfacesClient(connector).List(gwID, localeServiceID, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var interfaceIDs []string
+	for _, iface := range interfaces.Results {
+		if iface.Type_ == nil || *iface.Type_ != model.Tier0Interface_TYPE_EXTERNAL {
+			continue
+		}
+		if iface.EdgePath != nil && obj.EdgePath != nil && *iface.EdgePath == *obj.EdgePath {
+			interfaceIDs = append(interfaceIDs, *iface.Id)
+		}
+	}
+	if len(interfaceIDs) != 1 {
+		return "", fmt.Errorf("Failed to determine external interface of EVPN Tunnel Endpoint %s, please provide gateway-id/locale-service-id/interface-id/endpoint-id as an input", id)
+	}
+	return interfaceIDs[0], nil
+}
+
 func resourceNsxtPolicyEvpnTunnelEndpointImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
+	if isPolicyPath(importID) {
+		// Path should be like /infra/tier-0s/gw/locale-services/ls/evpn-tunnel-endpoints/id
+		if len(s) != 8 || s[2] != "tier-0s" || s[6] != "evpn-tunnel-endpoints" {
+			return nil, fmt.Errorf("Invalid EVPN Tunnel Endpoint path %s", importID)
+		}
+		interfaceID, err := getPolicyEvpnTunnelEndpointInterfaceID(getPolicyConnector(m), s[3], s[5], s[7])
+		if err != nil {
+			return nil, err
+		}
+		s = []string{s[3], s[5], interfaceID, s[7]}
+	}
 	if len(s) != 4 {
-		return nil, fmt.Errorf("Please provide gateway-id/locale-service-id/interface-id/endpoint-id as an input")
+		return nil, fmt.Errorf("Please provide gateway-id/locale-service-id/interface-id/endpoint-id or policy path as an input")
 	}
 
 	gwID := s[0]
@@ -4,19 +4,21 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNsxtPolicyFixedSegment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyFixedSegmentCreate,
-		Read:   resourceNsxtPolicyFixedSegmentRead,
-		Update: resourceNsxtPolicyFixedSegmentUpdate,
-		Delete: resourceNsxtPolicyFixedSegmentDelete,
+		CreateContext: resourceNsxtPolicyFixedSegmentCreate,
+		ReadContext:   resourceNsxtPolicyFixedSegmentRead,
+		UpdateContext: resourceNsxtPolicyFixedSegmentUpdate,
+		DeleteContext: resourceNsxtPolicyFixedSegmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtGatewayResourceImporter,
 		},
@@ -25,20 +27,20 @@ func resourceNsxtPolicyFixedSegment() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyFixedSegmentCreate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentCreate(d, m, false, true)
+func resourceNsxtPolicyFixedSegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentCreate(d, m, false, true))
 }
 
-func resourceNsxtPolicyFixedSegmentRead(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentRead(d, m, false, true)
+func resourceNsxtPolicyFixedSegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentRead(d, m, false, true))
 }
 
-func resourceNsxtPolicyFixedSegmentUpdate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentUpdate(d, m, false, true)
+func resourceNsxtPolicyFixedSegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentUpdate(d, m, false, true))
 }
 
-func resourceNsxtPolicyFixedSegmentDelete(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentDelete(d, m, true)
+func resourceNsxtPolicyFixedSegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentDelete(d, m, true))
 }
 
 func nsxtGatewayResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
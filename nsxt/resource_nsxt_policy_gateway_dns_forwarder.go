@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -27,10 +28,10 @@ var gatewayDNSForwarderLogLevelTypeValues = []string{
 
 func resourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayDNSForwarderCreate,
-		Read:   resourceNsxtPolicyGatewayDNSForwarderRead,
-		Update: resourceNsxtPolicyGatewayDNSForwarderUpdate,
-		Delete: resourceNsxtPolicyGatewayDNSForwarderDelete,
+		CreateContext: resourceNsxtPolicyGatewayDNSForwarderCreate,
+		ReadContext:   resourceNsxtPolicyGatewayDNSForwarderRead,
+		UpdateContext: resourceNsxtPolicyGatewayDNSForwarderUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayDNSForwarderDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyGatewayDNSForwarderImport,
 		},
@@ -88,29 +89,29 @@ func policyGatewayDNSForwarderGet(sessionContext utl.SessionContext, connector c
 	return client.Get(gwID)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	obj, err := policyGatewayDNSForwarderGet(context, connector, gwID, isT0)
 
 	if err != nil {
-		return handleReadError(d, "Gateway Dns Forwarder", gwID, err)
+		return diag.FromErr(handleReadError(d, "Gateway Dns Forwarder", gwID, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 	d.Set("listener_ip", obj.ListenerIp)
@@ -123,11 +124,11 @@ func resourceNsxtPolicyGatewayDNSForwarderRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, connector client.Connector, d *schema.ResourceData, gwID string, isT0 bool) error {
+func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, connector client.Connector, d *schema.ResourceData, gwID string, isT0 bool, m interface{}) error {
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	listenerIP := d.Get("listener_ip").(string)
 	defaultZonePath := d.Get("default_forwarder_zone_path").(string)
 	conditionalZonePaths := getStringListFromSchemaSet(d, "conditional_forwarder_zone_paths")
@@ -149,7 +150,7 @@ func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, conne
 		obj.ConditionalForwarderZonePaths = conditionalZonePaths
 	}
 
-	if nsxVersionHigherOrEqual("3.2.0") {
+	if nsxVersionHigherOrEqual(m, "3.2.0") {
 		obj.CacheSize = &cacheSize
 	}
 
@@ -161,13 +162,13 @@ func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, conne
 	return client.Patch(gwID, obj)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	// Verify DNS forwarder is not yet defined for this Gateway
@@ -175,7 +176,7 @@ func resourceNsxtPolicyGatewayDNSForwarderCreate(d *schema.ResourceData, m inter
 	context := getSessionContext(d, m)
 
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	if isT0 {
@@ -186,57 +187,57 @@ func resourceNsxtPolicyGatewayDNSForwarderCreate(d *schema.ResourceData, m inter
 		_, err = client.Get(gwID)
 	}
 	if err == nil {
-		return fmt.Errorf("Gateway Dns Forwarder already exists for Gateway '%s'", gwID)
+		return diag.Errorf("Gateway Dns Forwarder already exists for Gateway '%s'", gwID)
 	} else if !isNotFoundError(err) {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating Dns Forwarder for Gateway %s", gwID)
 
-	err = patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0)
+	err = patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0, m)
 	if err != nil {
-		return handleCreateError("Gateway Dns Forwarder", gwID, err)
+		return diag.FromErr(handleCreateError("Gateway Dns Forwarder", gwID, err))
 	}
 
 	d.SetId(gwID)
 
-	return resourceNsxtPolicyGatewayDNSForwarderRead(d, m)
+	return resourceNsxtPolicyGatewayDNSForwarderRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 	log.Printf("[INFO] Updating Gateway Dns Forwarder with ID %s", gwID)
-	err := patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0)
+	err := patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0, m)
 	if err != nil {
-		return handleUpdateError("Gateway Dns Forwarder", gwID, err)
+		return diag.FromErr(handleUpdateError("Gateway Dns Forwarder", gwID, err))
 	}
 
-	return resourceNsxtPolicyGatewayDNSForwarderRead(d, m)
+	return resourceNsxtPolicyGatewayDNSForwarderRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	var err error
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	if isT0 {
@@ -247,7 +248,7 @@ func resourceNsxtPolicyGatewayDNSForwarderDelete(d *schema.ResourceData, m inter
 		err = client.Delete(gwID)
 	}
 	if err != nil {
-		return handleDeleteError("Gateway Dns Forwarder", gwID, err)
+		return diag.FromErr(handleDeleteError("Gateway Dns Forwarder", gwID, err))
 	}
 
 	return nil
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,10 +20,10 @@ import (
 
 func resourceNsxtPolicyGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyGatewayPolicyRead,
-		Update: resourceNsxtPolicyGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyGatewayPolicyDelete,
+		CreateContext: resourceNsxtPolicyGatewayPolicyCreate,
+		ReadContext:   resourceNsxtPolicyGatewayPolicyRead,
+		UpdateContext: resourceNsxtPolicyGatewayPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
@@ -67,8 +69,12 @@ func getUpdatedRuleChildren(d *schema.ResourceData) ([]*data.StructValue, error)
 	rules := getPolicyRulesFromSchema(d)
 	newRulesCount := len(newRules.([]interface{}))
 	oldRulesCount := len(oldRules.([]interface{}))
+	assignedIDs := false
 	for ruleNo := 0; ruleNo < newRulesCount; ruleNo++ {
 		ruleIndicator := fmt.Sprintf("rule.%d", ruleNo)
+		if d.Get(fmt.Sprintf("%s.nsx_id", ruleIndicator)).(string) == "" {
+			assignedIDs = true
+		}
 		autoAssignedSequence := false
 		originalSequence := d.Get(fmt.Sprintf("%s.sequence_number", ruleIndicator)).(int)
 		if originalSequence == 0 {
@@ -117,6 +123,20 @@ func getUpdatedRuleChildren(d *schema.ResourceData) ([]*data.StructValue, error)
 
 	}
 
+	if assignedIDs {
+		// Record IDs assigned to new rules, so that rules owned by this policy
+		// can be distinguished from rules managed elsewhere
+		var rulesList []interface{}
+		for ruleNo, rule := range newRules.([]interface{}) {
+			ruleMap := rule.(map[string]interface{})
+			ruleMap["nsx_id"] = *rules[ruleNo].Id
+			rulesList = append(rulesList, ruleMap)
+		}
+		if err := d.Set("rule", rulesList); err != nil {
+			return policyChildren, err
+		}
+	}
+
 	return policyChildren, nil
 
 }
@@ -126,7 +146,7 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 	domain := d.Get("domain").(string)
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	category := d.Get("category").(string)
 	comments := d.Get("comments").(string)
 	locked := d.Get("locked").(bool)
@@ -169,42 +189,42 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 	return gatewayPolicyInfraPatch(getSessionContext(d, m), obj, domain, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGatewayPolicyExistsPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
 	if err != nil {
-		return handleCreateError("Gateway Policy", id, err)
+		return diag.FromErr(handleCreateError("Gateway Policy", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	obj, err := getGatewayPolicyInDomain(getSessionContext(d, m), id, d.Get("domain").(string), connector)
 	if err != nil {
-		return handleReadError(d, "Gateway Policy", id, err)
+		return diag.FromErr(handleReadError(d, "Gateway Policy", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
@@ -218,36 +238,38 @@ func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{})
 		d.Set("tcp_strict", *obj.TcpStrict)
 	}
 	d.Set("revision", obj.Revision)
-	return setPolicyRulesInSchema(d, obj.Rules)
+	return diag.FromErr(setPolicyRulesInSchema(d, obj.Rules))
 }
 
-func resourceNsxtPolicyGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
-	err := policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
+	err := retryPolicyUpdateUponPreconditionFailed(d, m, func() error {
+		return policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
+	})
 	if err != nil {
-		return handleUpdateError("Gateway Policy", id, err)
+		return diag.FromErr(handleUpdateError("Gateway Policy", id, err))
 	}
 
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := domains.NewGatewayPoliciesClient(getSessionContext(d, m), connector)
 	err := client.Delete(d.Get("domain").(string), id)
 	if err != nil {
-		return handleDeleteError("Gateway Policy", id, err)
+		return diag.FromErr(handleDeleteError("Gateway Policy", id, err))
 	}
 
 	return nil
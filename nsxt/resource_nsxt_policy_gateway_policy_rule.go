@@ -0,0 +1,160 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+
+	gatewaypolicies "github.com/vmware/terraform-provider-nsxt/api/infra/domains/gateway_policies"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyGatewayPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyGatewayPolicyRuleCreate,
+		ReadContext:   resourceNsxtPolicyGatewayPolicyRuleRead,
+		UpdateContext: resourceNsxtPolicyGatewayPolicyRuleUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayPolicyRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyRuleImporter,
+		},
+		Schema: getPolicyGatewayPolicyRuleSchema(),
+	}
+}
+
+func getPolicyGatewayPolicyRuleSchema() map[string]*schema.Schema {
+	ruleSchema := getPolicyStandaloneRuleSchema(true)
+	// GW Policy rules are applied on gateways or gateway interfaces
+	ruleSchema["scope"].Elem.(*schema.Schema).ValidateFunc = validatePolicyGatewayScopePath()
+	return ruleSchema
+}
+
+func parseGatewayPolicyRuleParentPath(policyPath string) (string, string, error) {
+	domain := getDomainFromResourcePath(policyPath)
+	policyID := getResourceIDFromResourcePath(policyPath, "gateway-policies")
+	if domain == "" || policyID == "" {
+		return "", "", fmt.Errorf("invalid gateway policy path %s", policyPath)
+	}
+	return domain, policyID, nil
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleExistsPartial(policyPath string) func(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return func(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+		domain, policyID, err := parseGatewayPolicyRuleParentPath(policyPath)
+		if err != nil {
+			return false, err
+		}
+		client := gatewaypolicies.NewRulesClient(sessionContext, connector)
+		_, err = client.Get(domain, policyID, id)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Gateway Policy Rule", err)
+	}
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	policyPath := d.Get("policy_path").(string)
+	domain, policyID, err := parseGatewayPolicyRuleParentPath(policyPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGatewayPolicyRuleExistsPartial(policyPath))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	obj := getPolicyRuleFromMap(getPolicyStandaloneRuleData(d), id, sequenceNumber)
+
+	log.Printf("[INFO] Creating Gateway Policy Rule with ID %s under policy %s", id, policyPath)
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	if err := client.Patch(domain, policyID, id, obj); err != nil {
+		return diag.FromErr(handleCreateError("Gateway Policy Rule", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyGatewayPolicyRuleRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Gateway Policy Rule ID")
+	}
+	domain, policyID, err := parseGatewayPolicyRuleParentPath(d.Get("policy_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(domain, policyID, id)
+	if err != nil {
+		return diag.FromErr(handleReadError(d, "Gateway Policy Rule", id, err))
+	}
+
+	return diag.FromErr(setPolicyStandaloneRuleInSchema(d, obj))
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Gateway Policy Rule ID")
+	}
+	domain, policyID, err := parseGatewayPolicyRuleParentPath(d.Get("policy_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	err = retryPolicyUpdateUponPreconditionFailed(d, m, func() error {
+		revision := int64(d.Get("revision").(int))
+		obj := getPolicyRuleFromMap(getPolicyStandaloneRuleData(d), id, sequenceNumber)
+		obj.Revision = &revision
+		_, err := client.Update(domain, policyID, id, obj)
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(handleUpdateError("Gateway Policy Rule", id, err))
+	}
+
+	return resourceNsxtPolicyGatewayPolicyRuleRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyGatewayPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Gateway Policy Rule ID")
+	}
+	domain, policyID, err := parseGatewayPolicyRuleParentPath(d.Get("policy_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := gatewaypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	if err := client.Delete(domain, policyID, id); err != nil {
+		return diag.FromErr(handleDeleteError("Gateway Policy Rule", id, err))
+	}
+
+	return nil
+}
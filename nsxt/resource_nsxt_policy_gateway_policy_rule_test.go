@@ -0,0 +1,194 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyGatewayPolicyRule_basic(t *testing.T) {
+	testAccResourceNsxtPolicyGatewayPolicyRuleBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayPolicyRule_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicyGatewayPolicyRuleBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicyGatewayPolicyRuleBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_policy_rule.test"
+	policyResourceName := "nsxt_policy_gateway_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayPolicyRuleCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayPolicyRuleTemplate(name, "IN", "DROP", 10, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "direction", "IN"),
+					resource.TestCheckResourceAttr(testResourceName, "action", "DROP"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "10"),
+					resource.TestCheckResourceAttr(testResourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttrPair(testResourceName, "policy_path", policyResourceName, "path"),
+					resource.TestCheckResourceAttr(policyResourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayPolicyRuleTemplate(updatedName, "OUT", "ALLOW", 20, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "direction", "OUT"),
+					resource.TestCheckResourceAttr(testResourceName, "action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "20"),
+					resource.TestCheckResourceAttr(policyResourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayPolicyRule_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_policy_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayPolicyRuleCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayPolicyRuleTemplate(name, "IN", "DROP", 10, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayPolicyRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Gateway Policy Rule resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Gateway Policy Rule resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyGatewayPolicyRuleExistsPartial(rs.Primary.Attributes["policy_path"])(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Error while retrieving Policy Gateway Policy Rule ID %s", resourceID)
+		}
+		return nil
+	}
+}
+
+func testAccNsxtPolicyGatewayPolicyRuleCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_gateway_policy_rule" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyGatewayPolicyRuleExistsPartial(rs.Primary.Attributes["policy_path"])(testAccGetSessionContext(), resourceID, connector)
+		if err == nil && exists {
+			return fmt.Errorf("Policy Gateway Policy Rule %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyGatewayPolicyRuleTemplate(name string, direction string, action string, sequenceNumber int, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "gwt1test" {
+%s
+  display_name = "tf-t1-gw"
+  description  = "Acceptance Test"
+}
+
+resource "nsxt_policy_gateway_policy" "test" {
+%s
+  display_name           = "%s"
+  category               = "LocalGatewayRules"
+  ignore_unmanaged_rules = true
+
+  rule {
+    display_name    = "inline"
+    sequence_number = 1
+    scope           = [nsxt_policy_tier1_gateway.gwt1test.path]
+  }
+}
+
+resource "nsxt_policy_gateway_policy_rule" "test" {
+%s
+  display_name    = "%s"
+  description     = "Acceptance Test"
+  policy_path     = nsxt_policy_gateway_policy.test.path
+  sequence_number = %d
+  direction       = "%s"
+  action          = "%s"
+  scope           = [nsxt_policy_tier1_gateway.gwt1test.path]
+}`, context, context, name, context, name, sequenceNumber, direction, action)
+}
+
+func TestMockNsxPolicyGatewayPolicyRule(t *testing.T) {
+	testMockResourceLifecycle(t, resourceNsxtPolicyGatewayPolicyRule(), map[string]interface{}{
+		"display_name":    "mock-gw-rule",
+		"policy_path":     "/infra/domains/default/gateway-policies/mock-policy",
+		"sequence_number": 10,
+		"scope":           []interface{}{"/infra/tier-1s/mock-tier1"},
+	}, map[string]interface{}{
+		"description": "updated",
+	})
+
+	validate := getPolicyGatewayPolicyRuleSchema()["scope"].Elem.(*schema.Schema).ValidateFunc
+	if _, errs := validate("/infra/domains/default/groups/mock-group", "scope"); len(errs) == 0 {
+		t.Fatalf("Expected group path to be rejected in gateway rule scope")
+	}
+	if _, errs := validate("/infra/tier-0s/mock-tier0/locale-services/default/interfaces/uplink", "scope"); len(errs) > 0 {
+		t.Fatalf("Expected gateway interface path to be accepted in gateway rule scope: %v", errs)
+	}
+}
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -22,12 +23,12 @@ var gatewayQosProfileExcessActionValues = []string{
 
 func resourceNsxtPolicyGatewayQosProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayQosProfileCreate,
-		Read:   resourceNsxtPolicyGatewayQosProfileRead,
-		Update: resourceNsxtPolicyGatewayQosProfileUpdate,
-		Delete: resourceNsxtPolicyGatewayQosProfileDelete,
+		CreateContext: resourceNsxtPolicyGatewayQosProfileCreate,
+		ReadContext:   resourceNsxtPolicyGatewayQosProfileRead,
+		UpdateContext: resourceNsxtPolicyGatewayQosProfileUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayQosProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,7 +83,7 @@ func resourceNsxtPolicyGatewayQosProfilePatch(d *schema.ResourceData, m interfac
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	burstSize := int64(d.Get("burst_size").(int))
 	committedBandwidth := int64(d.Get("committed_bandwidth").(int))
 	excessAction := d.Get("excess_action").(string)
@@ -112,31 +113,31 @@ func resourceNsxtPolicyGatewayQosProfilePatch(d *schema.ResourceData, m interfac
 	return client.Patch(id, obj, nil)
 }
 
-func resourceNsxtPolicyGatewayQosProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayQosProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyGatewayQosProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = resourceNsxtPolicyGatewayQosProfilePatch(d, m, id)
 	if err != nil {
-		return handleCreateError("GatewayQosProfile", id, err)
+		return diag.FromErr(handleCreateError("GatewayQosProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayQosProfileRead(d, m)
+	return resourceNsxtPolicyGatewayQosProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayQosProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayQosProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining GatewayQosProfile ID")
+		return diag.Errorf("Error obtaining GatewayQosProfile ID")
 	}
 
 	var obj model.GatewayQosProfile
@@ -144,12 +145,12 @@ func resourceNsxtPolicyGatewayQosProfileRead(d *schema.ResourceData, m interface
 		client := gm_infra.NewGatewayQosProfilesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "GatewayQosProfile", id, err)
+			return diag.FromErr(handleReadError(d, "GatewayQosProfile", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.GatewayQosProfileBindingType(), model.GatewayQosProfileBindingType())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		obj = lmObj.(model.GatewayQosProfile)
 	} else {
@@ -157,13 +158,13 @@ func resourceNsxtPolicyGatewayQosProfileRead(d *schema.ResourceData, m interface
 		var err error
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "GatewayQosProfile", id, err)
+			return diag.FromErr(handleReadError(d, "GatewayQosProfile", id, err))
 		}
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -175,25 +176,25 @@ func resourceNsxtPolicyGatewayQosProfileRead(d *schema.ResourceData, m interface
 	return nil
 }
 
-func resourceNsxtPolicyGatewayQosProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayQosProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining GatewayQosProfile ID")
+		return diag.Errorf("Error obtaining GatewayQosProfile ID")
 	}
 
 	err := resourceNsxtPolicyGatewayQosProfilePatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("GatewayQosProfile", id, err)
+		return diag.FromErr(handleUpdateError("GatewayQosProfile", id, err))
 	}
 
-	return resourceNsxtPolicyGatewayQosProfileRead(d, m)
+	return resourceNsxtPolicyGatewayQosProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayQosProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayQosProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining GatewayQosProfile ID")
+		return diag.Errorf("Error obtaining GatewayQosProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -207,7 +208,7 @@ func resourceNsxtPolicyGatewayQosProfileDelete(d *schema.ResourceData, m interfa
 	}
 
 	if err != nil {
-		return handleDeleteError("GatewayQosProfile", id, err)
+		return diag.FromErr(handleDeleteError("GatewayQosProfile", id, err))
 	}
 
 	return nil
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
@@ -15,10 +17,10 @@ import (
 
 func resourceNsxtPolicyGatewayRedistributionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayRedistributionConfigCreate,
-		Read:   resourceNsxtPolicyGatewayRedistributionConfigRead,
-		Update: resourceNsxtPolicyGatewayRedistributionConfigUpdate,
-		Delete: resourceNsxtPolicyGatewayRedistributionConfigDelete,
+		CreateContext: resourceNsxtPolicyGatewayRedistributionConfigCreate,
+		ReadContext:   resourceNsxtPolicyGatewayRedistributionConfigRead,
+		UpdateContext: resourceNsxtPolicyGatewayRedistributionConfigUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayRedistributionConfigDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyGatewayRedistributionConfigImport,
 		},
@@ -71,11 +73,11 @@ func policyGatewayRedistributionConfigPatch(d *schema.ResourceData, m interface{
 		BgpEnabled: &bgpEnabled,
 	}
 
-	if nsxVersionHigherOrEqual("3.1.0") {
+	if nsxVersionHigherOrEqual(m, "3.1.0") {
 		redistributionStruct.OspfEnabled = &ospfEnabled
 	}
 
-	setLocaleServiceRedistributionRulesConfig(rulesConfig, &redistributionStruct)
+	setLocaleServiceRedistributionRulesConfig(rulesConfig, &redistributionStruct, m)
 
 	lsType := "LocaleServices"
 	serviceStruct := model.LocaleServices{
@@ -93,40 +95,40 @@ func policyGatewayRedistributionConfigPatch(d *schema.ResourceData, m interface{
 	return doPatch()
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPath := d.Get("gateway_path").(string)
 	sitePath := d.Get("site_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	localeServiceID := ""
 	context := getSessionContext(d, m)
 	if isPolicyGlobalManager(m) {
 		if sitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_gateway_redistribution_config")
+			return diag.FromErr(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_gateway_redistribution_config"))
 		}
 		localeServices, err := listPolicyTier0GatewayLocaleServices(context, connector, gwID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		localeServiceID, err = getGlobalPolicyGatewayLocaleServiceIDWithSite(localeServices, sitePath, gwID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if sitePath != "" {
-			return globalManagerOnlyError()
+			return diag.FromErr(globalManagerOnlyError())
 		}
 		localeService, err := getPolicyTier0GatewayLocaleServiceWithEdgeCluster(context, gwID, connector)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if localeService == nil {
-			return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", gwID)
+			return diag.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", gwID)
 		}
 		localeServiceID = *localeService.Id
 	}
@@ -134,37 +136,37 @@ func resourceNsxtPolicyGatewayRedistributionConfigCreate(d *schema.ResourceData,
 	id := newUUID()
 	err := policyGatewayRedistributionConfigPatch(d, m, gwID, localeServiceID)
 	if err != nil {
-		return handleCreateError("Tier0 Redistribution Config", id, err)
+		return diag.FromErr(handleCreateError("Tier0 Redistribution Config", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("gateway_id", gwID)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyGatewayRedistributionConfigRead(d, m)
+	return resourceNsxtPolicyGatewayRedistributionConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
 	}
 
 	client := tier0s.NewLocaleServicesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(gwID, localeServiceID)
 	if err != nil {
-		return handleReadError(d, "Tier0 Redistribution Config", id, err)
+		return diag.FromErr(handleReadError(d, "Tier0 Redistribution Config", id, err))
 	}
 
 	config := obj.RouteRedistributionConfig
 	if config != nil {
 		d.Set("bgp_enabled", config.BgpEnabled)
 		d.Set("ospf_enabled", config.OspfEnabled)
-		d.Set("rule", getLocaleServiceRedistributionRuleConfig(config))
+		d.Set("rule", getLocaleServiceRedistributionRuleConfig(config, m))
 	}
 	if isPolicyGlobalManager(m) && obj.EdgeClusterPath != nil {
 		d.Set("site_path", getSitePathFromEdgePath(*obj.EdgeClusterPath))
@@ -176,31 +178,31 @@ func resourceNsxtPolicyGatewayRedistributionConfigRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
 	}
 
 	err := policyGatewayRedistributionConfigPatch(d, m, gwID, localeServiceID)
 	if err != nil {
-		return handleUpdateError("Tier0 Redistribution Config", id, err)
+		return diag.FromErr(handleUpdateError("Tier0 Redistribution Config", id, err))
 	}
 
-	return resourceNsxtPolicyGatewayRedistributionConfigRead(d, m)
+	return resourceNsxtPolicyGatewayRedistributionConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
 	}
 
 	// Update the locale service with empty Redistribution config using get/post
@@ -219,7 +221,7 @@ func resourceNsxtPolicyGatewayRedistributionConfigDelete(d *schema.ResourceData,
 	commonProviderConfig := getCommonProviderConfig(m)
 	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
 	if err != nil {
-		return handleDeleteError("Tier0 RedistributionConfig config", id, err)
+		return diag.FromErr(handleDeleteError("Tier0 RedistributionConfig config", id, err))
 	}
 
 	return nil
@@ -228,8 +230,15 @@ func resourceNsxtPolicyGatewayRedistributionConfigDelete(d *schema.ResourceData,
 func resourceNsxtPolicyGatewayRedistributionConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
+	if isPolicyPath(importID) {
+		isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(importID)
+		if err != nil || !isT0 {
+			return nil, fmt.Errorf("Tier0 locale service path expected, got %s", importID)
+		}
+		s = []string{gwID, localeServiceID}
+	}
 	if len(s) != 2 {
-		return nil, fmt.Errorf("Please provide <tier0-gateway-id>/<locale-service-id> as an input")
+		return nil, fmt.Errorf("Please provide <tier0-gateway-id>/<locale-service-id> or locale service policy path as an input")
 	}
 
 	gwID := s[0]
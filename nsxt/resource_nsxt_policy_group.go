@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -85,10 +87,10 @@ var groupTypeValues = []string{
 
 func resourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGroupCreate,
-		Read:   resourceNsxtPolicyGroupRead,
-		Update: resourceNsxtPolicyGroupUpdate,
-		Delete: resourceNsxtPolicyGroupDelete,
+		CreateContext: resourceNsxtPolicyGroupCreate,
+		ReadContext:   resourceNsxtPolicyGroupRead,
+		UpdateContext: resourceNsxtPolicyGroupUpdate,
+		DeleteContext: resourceNsxtPolicyGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
@@ -828,13 +830,13 @@ func validateGroupCriteriaAndConjunctions(criteriaSets []interface{}, conjunctio
 	return criteriaMeta, nil
 }
 
-func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGroupExistsInDomainPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	criteriaSets := d.Get("criteria").([]interface{})
@@ -842,26 +844,26 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 
 	criteriaMeta, err := validateGroupCriteriaAndConjunctions(criteriaSets, conjunctions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	expressionData, err := buildGroupExpressionData(criteriaMeta, conjunctions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	extendedCriteriaSets := d.Get("extended_criteria").([]interface{})
 	err = validateExtendedCriteriaLocalManager(extendedCriteriaSets, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	extendedExpressionList, err := buildGroupExtendedExpressionListData(extendedCriteriaSets)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var groupTypes []string
 	groupType := d.Get("group_type").(string)
@@ -874,7 +876,7 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 		ExtendedExpression: extendedExpressionList,
 	}
 
-	if groupType != "" && nsxVersionHigherOrEqual("3.2.0") {
+	if groupType != "" && nsxVersionHigherOrEqual(m, "3.2.0") {
 		obj.GroupType = groupTypes
 	}
 
@@ -884,50 +886,50 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating Group with ID %s", id)
 	if err != nil {
-		return handleCreateError("Group", id, err)
+		return diag.FromErr(handleCreateError("Group", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGroupRead(d, m)
+	return resourceNsxtPolicyGroupRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	domainName := d.Get("domain").(string)
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 	client := domains.NewGroupsClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(domainName, id)
 	if err != nil {
-		return handleReadError(d, "Group", id, err)
+		return diag.FromErr(handleReadError(d, "Group", id, err))
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
 	d.Set("revision", obj.Revision)
 	groupType := ""
-	if len(obj.GroupType) > 0 && nsxVersionHigherOrEqual("3.2.0") {
+	if len(obj.GroupType) > 0 && nsxVersionHigherOrEqual(m, "3.2.0") {
 		groupType = obj.GroupType[0]
 		d.Set("group_type", groupType)
 	}
 	criteria, conditions, err := fromGroupExpressionData(obj.Expression)
 	log.Printf("[INFO] Found %d criteria, %d conjunctions for group %s", len(criteria), len(conditions), id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("criteria", criteria)
 	d.Set("conjunction", conditions)
 	identityGroups, err := getIdentityGroupsData(obj.ExtendedExpression)
 	log.Printf("[INFO] Found %d identity groups for group %s", len(identityGroups), id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var extendedCriteria []map[string]interface{}
 	if len(identityGroups) > 0 {
@@ -940,12 +942,12 @@ func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 
 	criteriaSets := d.Get("criteria").([]interface{})
@@ -953,28 +955,28 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 
 	criteriaMeta, err := validateGroupCriteriaAndConjunctions(criteriaSets, conjunctions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	expressionData, err := buildGroupExpressionData(criteriaMeta, conjunctions)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	extendedCriteriaSets := d.Get("extended_criteria").([]interface{})
 	err = validateExtendedCriteriaLocalManager(extendedCriteriaSets, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	extendedExpressionList, err := buildGroupExtendedExpressionListData(extendedCriteriaSets)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var groupTypes []string
 	groupType := d.Get("group_type").(string)
@@ -987,7 +989,7 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 		ExtendedExpression: extendedExpressionList,
 	}
 
-	if groupType != "" && nsxVersionHigherOrEqual("3.2.0") {
+	if groupType != "" && nsxVersionHigherOrEqual(m, "3.2.0") {
 		obj.GroupType = groupTypes
 	}
 
@@ -996,16 +998,16 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 	// Update the resource using PATCH
 	err = client.Patch(d.Get("domain").(string), id, obj)
 	if err != nil {
-		return handleUpdateError("Group", id, err)
+		return diag.FromErr(handleUpdateError("Group", id, err))
 	}
 
-	return resourceNsxtPolicyGroupRead(d, m)
+	return resourceNsxtPolicyGroupRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -1019,7 +1021,7 @@ func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error
 
 	err := doDelete()
 	if err != nil {
-		return handleDeleteError("Group", id, err)
+		return diag.FromErr(handleDeleteError("Group", id, err))
 	}
 
 	return nil
@@ -1508,3 +1508,18 @@ resource "nsxt_policy_group" "test" {
 }
 `, name)
 }
+
+func TestMockNsxPolicyGroup(t *testing.T) {
+	testMockResourceLifecycle(t, resourceNsxtPolicyGroup(), map[string]interface{}{
+		"display_name": "mock-group",
+		"criteria": []interface{}{
+			map[string]interface{}{
+				"ipaddress_expression": []interface{}{
+					map[string]interface{}{"ip_addresses": []interface{}{"10.1.1.1"}},
+				},
+			},
+		},
+	}, map[string]interface{}{
+		"description": "updated",
+	})
+}
@@ -4,26 +4,36 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points/host_transport_nodes"
 	model2 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"golang.org/x/exp/maps"
 )
 
 func resourceNsxtPolicyHostTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeRead,
-		Update: resourceNsxtPolicyHostTransportNodeUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeDelete,
+		CreateContext: resourceNsxtPolicyHostTransportNodeCreate,
+		ReadContext:   resourceNsxtPolicyHostTransportNodeRead,
+		UpdateContext: resourceNsxtPolicyHostTransportNodeUpdate,
+		DeleteContext: resourceNsxtPolicyHostTransportNodeDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyHostTransportNodeImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -91,36 +101,36 @@ func getFabricHostNodeSchema() *schema.Schema {
 	return &s
 }
 
-func resourceNsxtPolicyHostTransportNodeRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	htnClient := enforcement_points.NewHostTransportNodesClient(connector)
 
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj, err := htnClient.Get(siteID, epID, id)
 	if err != nil {
-		return handleReadError(d, "HostTransportNode", id, err)
+		return diag.FromErr(handleReadError(d, "HostTransportNode", id, err))
 	}
 	sitePath, err := getSitePathFromChildResourcePath(*obj.ParentPath)
 	if err != nil {
-		return handleReadError(d, "HostTransportNode", id, err)
+		return diag.FromErr(handleReadError(d, "HostTransportNode", id, err))
 	}
 
 	d.Set("site_path", sitePath)
 	d.Set("enforcement_point", epID)
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
 	err = setHostSwitchSpecInSchema(d, obj.HostSwitchSpec)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	fabricHostNode := obj.NodeDeploymentInfo
@@ -201,7 +211,7 @@ func policyHostTransportNodePatch(siteID, epID, htnID string, d *schema.Resource
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	discoveredNodeID := d.Get("discovered_node_id").(string)
 	nodeDeploymentInfo := getFabricHostNodeFromSchema(d)
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d)
@@ -223,7 +233,7 @@ func policyHostTransportNodePatch(siteID, epID, htnID string, d *schema.Resource
 	return htnClient.Patch(siteID, epID, htnID, obj, nil, nil, nil, nil, nil, nil, nil)
 }
 
-func resourceNsxtPolicyHostTransportNodeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Get("nsx_id").(string)
 	if id == "" {
@@ -232,7 +242,7 @@ func resourceNsxtPolicyHostTransportNodeCreate(d *schema.ResourceData, m interfa
 	sitePath := d.Get("site_path").(string)
 	siteID := getResourceIDFromResourcePath(sitePath, "sites")
 	if siteID == "" {
-		return fmt.Errorf("error obtaining Site ID from site path %s", sitePath)
+		return diag.Errorf("error obtaining Site ID from site path %s", sitePath)
 	}
 	epID := d.Get("enforcement_point").(string)
 	if epID == "" {
@@ -240,58 +250,131 @@ func resourceNsxtPolicyHostTransportNodeCreate(d *schema.ResourceData, m interfa
 	}
 	exists, err := resourceNsxtPolicyHostTransportNodeExists(siteID, epID, id, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if exists {
-		return fmt.Errorf("resource with ID %s already exists", id)
+		return diag.Errorf("resource with ID %s already exists", id)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating HostTransportNode with ID %s under site %s enforcement point %s", id, siteID, epID)
 	err = policyHostTransportNodePatch(siteID, epID, id, d, m)
 	if err != nil {
-		return handleCreateError("HostTransportNode", id, err)
+		return diag.FromErr(handleCreateError("HostTransportNode", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyHostTransportNodeRead(d, m)
+	stateConf := getPolicyHostTransportNodeStateConf(connector, siteID, epID, id, d.Timeout(schema.TimeoutCreate))
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to realize HostTransportNode %s: %v", id, err)
+	}
+
+	return resourceNsxtPolicyHostTransportNodeRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyHostTransportNodeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updating HostTransportNode with ID %s", id)
 	err = policyHostTransportNodePatch(siteID, epID, id, d, m)
 	if err != nil {
-		return handleUpdateError("HostTransportNode", id, err)
+		return diag.FromErr(handleUpdateError("HostTransportNode", id, err))
+	}
+
+	stateConf := getPolicyHostTransportNodeStateConf(getPolicyConnector(m), siteID, epID, id, d.Timeout(schema.TimeoutUpdate))
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to realize HostTransportNode %s: %v", id, err)
 	}
 
-	return resourceNsxtPolicyHostTransportNodeRead(d, m)
+	return resourceNsxtPolicyHostTransportNodeRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyHostTransportNodeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	htnClient := enforcement_points.NewHostTransportNodesClient(connector)
 
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting HostTransportNode with ID %s", id)
 	err = htnClient.Delete(siteID, epID, id, nil, nil)
 	if err != nil {
-		return handleDeleteError("HostTransportNode", id, err)
+		return diag.FromErr(handleDeleteError("HostTransportNode", id, err))
+	}
+
+	stateConf := getPolicyHostTransportNodeDeleteStateConf(connector, siteID, epID, id, d.Timeout(schema.TimeoutDelete))
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to delete HostTransportNode %s: %v", id, err)
 	}
 
 	return nil
 }
 
+func getPolicyHostTransportNodeStateConf(connector client.Connector, siteID, epID, id string, timeout time.Duration) *resource.StateChangeConf {
+	client := host_transport_nodes.NewStateClient(connector)
+	return &resource.StateChangeConf{
+		Pending: []string{
+			model2.TransportNodeState_STATE_PENDING,
+			model2.TransportNodeState_STATE_IN_PROGRESS,
+			model2.TransportNodeState_STATE_VM_DEPLOYMENT_QUEUED,
+			model2.TransportNodeState_STATE_VM_DEPLOYMENT_IN_PROGRESS,
+		},
+		Target: []string{model2.TransportNodeState_STATE_SUCCESS},
+		Refresh: func() (interface{}, string, error) {
+			state, err := client.Get(siteID, epID, id)
+			if err != nil {
+				return state, "", logAPIError("Error retrieving HostTransportNode state", err)
+			}
+			if state.State == nil {
+				return state, model2.TransportNodeState_STATE_PENDING, nil
+			}
+			log.Printf("[DEBUG] HostTransportNode %s state is %s", id, *state.State)
+			if *state.State == model2.TransportNodeState_STATE_FAILED || *state.State == model2.TransportNodeState_STATE_ERROR {
+				msg := *state.State
+				if state.FailureMessage != nil {
+					msg = *state.FailureMessage
+				}
+				return state, *state.State, fmt.Errorf("%s", msg)
+			}
+			return state, *state.State, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      1 * time.Second,
+	}
+}
+
+func getPolicyHostTransportNodeDeleteStateConf(connector client.Connector, siteID, epID, id string, timeout time.Duration) *resource.StateChangeConf {
+	client := enforcement_points.NewHostTransportNodesClient(connector)
+	return &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			obj, err := client.Get(siteID, epID, id)
+			if isNotFoundError(err) {
+				return obj, "deleted", nil
+			}
+			if err != nil {
+				return obj, "", logAPIError("Error retrieving HostTransportNode", err)
+			}
+			return obj, "deleting", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      1 * time.Second,
+	}
+}
+
 func resourceNsxtPolicyHostTransportNodeImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -16,10 +18,10 @@ import (
 
 func resourceNsxtPolicyHostTransportNodeCollection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeCollectionCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeCollectionRead,
-		Update: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeCollectionDelete,
+		CreateContext: resourceNsxtPolicyHostTransportNodeCollectionCreate,
+		ReadContext:   resourceNsxtPolicyHostTransportNodeCollectionRead,
+		UpdateContext: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
+		DeleteContext: resourceNsxtPolicyHostTransportNodeCollectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyHostTransportNodeCollectionImporter,
 		},
@@ -120,7 +122,7 @@ func policyHostTransportNodeCollectionUpdate(siteID, epID, id string, applyProfi
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	computeCollectionID := d.Get("compute_collection_id").(string)
 	transportNodeProfileID := d.Get("transport_node_profile_path").(string)
@@ -160,7 +162,7 @@ func policyHostTransportNodeCollectionUpdate(siteID, epID, id string, applyProfi
 	return err
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCollectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Get("nsx_id").(string)
 	if id == "" {
@@ -169,7 +171,7 @@ func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData,
 	sitePath := d.Get("site_path").(string)
 	siteID := getResourceIDFromResourcePath(sitePath, "sites")
 	if siteID == "" {
-		return fmt.Errorf("error obtaining Site ID from site path %s", sitePath)
+		return diag.Errorf("error obtaining Site ID from site path %s", sitePath)
 	}
 	epID := d.Get("enforcement_point").(string)
 	if epID == "" {
@@ -178,42 +180,42 @@ func resourceNsxtPolicyHostTransportNodeCollectionCreate(d *schema.ResourceData,
 
 	exists, err := resourceNsxtPolicyHostTransportNodeCollectionExists(siteID, epID, id, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if exists {
-		return fmt.Errorf("resource with ID %s already exists", id)
+		return diag.Errorf("resource with ID %s already exists", id)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating HostTransportNodeCollection with ID %s under site %s enforcement point %s", id, siteID, epID)
 	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, true, d, m)
 	if err != nil {
-		return handleCreateError("HostTransportNodeCollection", id, err)
+		return diag.FromErr(handleCreateError("HostTransportNodeCollection", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyHostTransportNodeCollectionRead(d, m)
+	return resourceNsxtPolicyHostTransportNodeCollectionRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCollectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
 	// (TODO) Reusing this code here - maybe worthwhile renaming this func as it's usable for other resources
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	obj, err := client.Get(siteID, epID, id)
 	if err != nil {
-		return handleReadError(d, "HostTransportNodeCollection", id, err)
+		return diag.FromErr(handleReadError(d, "HostTransportNodeCollection", id, err))
 	}
 
 	d.Set("enforcement_point", epID)
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -243,34 +245,34 @@ func resourceNsxtPolicyHostTransportNodeCollectionRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCollectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updating HostTransportNodeCollection with ID %s", id)
 	err = policyHostTransportNodeCollectionUpdate(siteID, epID, id, false, d, m)
 
 	if err != nil {
-		return handleUpdateError("HostTransportNodeCollection", id, err)
+		return diag.FromErr(handleUpdateError("HostTransportNodeCollection", id, err))
 	}
 
-	return resourceNsxtPolicyHostTransportNodeCollectionRead(d, m)
+	return resourceNsxtPolicyHostTransportNodeCollectionRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyHostTransportNodeCollectionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeCollectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting HostTransportNodeCollection with ID %s", id)
 	err = client.Delete(siteID, epID, id)
 	if err != nil {
-		return handleDeleteError("HostTransportNodeCollection", id, err)
+		return diag.FromErr(handleDeleteError("HostTransportNodeCollection", id, err))
 	}
 
 	return nil
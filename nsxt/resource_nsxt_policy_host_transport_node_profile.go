@@ -4,8 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -14,10 +15,10 @@ import (
 
 func resourceNsxtPolicyHostTransportNodeProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeProfileCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeProfileRead,
-		Update: resourceNsxtPolicyHostTransportNodeProfileUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeProfileDelete,
+		CreateContext: resourceNsxtPolicyHostTransportNodeProfileCreate,
+		ReadContext:   resourceNsxtPolicyHostTransportNodeProfileRead,
+		UpdateContext: resourceNsxtPolicyHostTransportNodeProfileUpdate,
+		DeleteContext: resourceNsxtPolicyHostTransportNodeProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -56,23 +57,23 @@ func resourceNsxtPolicyHostTransportNodeProfileExists(id string, connector clien
 	return false, logAPIError("Error retrieving IP Block", err)
 }
 
-func resourceNsxtPolicyHostTransportNodeProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewHostTransportNodeProfilesClient(connector)
 
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyHostTransportNodeProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	ignoreOverridenHosts := d.Get("ignore_overridden_hosts").(bool)
 
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj := model.PolicyHostTransportNodeProfile{
@@ -85,62 +86,62 @@ func resourceNsxtPolicyHostTransportNodeProfileCreate(d *schema.ResourceData, m
 
 	_, err = client.Update(id, obj, nil)
 	if err != nil {
-		return handleCreateError("Policy Host Transport Node Profile", id, err)
+		return diag.FromErr(handleCreateError("Policy Host Transport Node Profile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyHostTransportNodeProfileRead(d, m)
+	return resourceNsxtPolicyHostTransportNodeProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyHostTransportNodeProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewHostTransportNodeProfilesClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining Policy Host Transport Node Profile ID")
+		return diag.Errorf("error obtaining Policy Host Transport Node Profile ID")
 	}
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "Policy Host Transport Node Profile", id, err)
+		return diag.FromErr(handleReadError(d, "Policy Host Transport Node Profile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", obj.Id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
 	err = setHostSwitchSpecInSchema(d, obj.HostSwitchSpec)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyHostTransportNodeProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewHostTransportNodeProfilesClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining Policy Host Transport Node Profile ID")
+		return diag.Errorf("error obtaining Policy Host Transport Node Profile ID")
 	}
 
 	// Read the rest of the configured parameters
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	ignoreOverridenHosts := d.Get("ignore_overridden_hosts").(bool)
 
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj := model.PolicyHostTransportNodeProfile{
@@ -154,23 +155,23 @@ func resourceNsxtPolicyHostTransportNodeProfileUpdate(d *schema.ResourceData, m
 
 	_, err = client.Update(id, obj, nil)
 	if err != nil {
-		return handleUpdateError("Policy Host Transport Node Profile", id, err)
+		return diag.FromErr(handleUpdateError("Policy Host Transport Node Profile", id, err))
 	}
 
-	return resourceNsxtPolicyHostTransportNodeProfileRead(d, m)
+	return resourceNsxtPolicyHostTransportNodeProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyHostTransportNodeProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyHostTransportNodeProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining Policy Host Transport Node Profile ID")
+		return diag.Errorf("error obtaining Policy Host Transport Node Profile ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewHostTransportNodeProfilesClient(connector)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("Policy Host Transport Node Profile", id, err)
+		return diag.FromErr(handleDeleteError("Policy Host Transport Node Profile", id, err))
 	}
 
 	return nil
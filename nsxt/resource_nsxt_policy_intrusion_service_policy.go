@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
@@ -22,10 +24,10 @@ var policyIntrusionServiceRuleActionValues = []string{model.IdsRule_ACTION_DETEC
 
 func resourceNsxtPolicyIntrusionServicePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIntrusionServicePolicyCreate,
-		Read:   resourceNsxtPolicyIntrusionServicePolicyRead,
-		Update: resourceNsxtPolicyIntrusionServicePolicyUpdate,
-		Delete: resourceNsxtPolicyIntrusionServicePolicyDelete,
+		CreateContext: resourceNsxtPolicyIntrusionServicePolicyCreate,
+		ReadContext:   resourceNsxtPolicyIntrusionServicePolicyRead,
+		UpdateContext: resourceNsxtPolicyIntrusionServicePolicyUpdate,
+		DeleteContext: resourceNsxtPolicyIntrusionServicePolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
@@ -215,7 +217,7 @@ func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) e
 	domain := d.Get("domain").(string)
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	comments := d.Get("comments").(string)
 	locked := d.Get("locked").(bool)
 	sequenceNumber := int64(d.Get("sequence_number").(int))
@@ -306,42 +308,42 @@ func idsPolicyInfraPatch(context tf_api.SessionContext, policy model.IdsSecurity
 
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIntrusionServicePolicyExistsPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating Intrusion Service Policy with ID %s", id)
 	err = updateIdsSecurityPolicy(id, d, m)
 
 	if err != nil {
-		return handleCreateError("Intrusion Service Policy", id, err)
+		return diag.FromErr(handleCreateError("Intrusion Service Policy", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIntrusionServicePolicyRead(d, m)
+	return resourceNsxtPolicyIntrusionServicePolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	domainName := d.Get("domain").(string)
 	if id == "" {
-		return fmt.Errorf("Error obtaining Intrusion Service Policy id")
+		return diag.Errorf("Error obtaining Intrusion Service Policy id")
 	}
 	client := domains.NewIntrusionServicePoliciesClient(connector)
 	obj, err := client.Get(domainName, id)
 	if err != nil {
-		return handleReadError(d, "Intrusion Service Policy", id, err)
+		return diag.FromErr(handleReadError(d, "Intrusion Service Policy", id, err))
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
@@ -350,30 +352,30 @@ func resourceNsxtPolicyIntrusionServicePolicyRead(d *schema.ResourceData, m inte
 	d.Set("sequence_number", obj.SequenceNumber)
 	d.Set("stateful", obj.Stateful)
 	d.Set("revision", obj.Revision)
-	return setPolicyIdsRulesInSchema(d, obj.Rules)
+	return diag.FromErr(setPolicyIdsRulesInSchema(d, obj.Rules))
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Intrusion Service Policy id")
+		return diag.Errorf("Error obtaining Intrusion Service Policy id")
 	}
 
 	log.Printf("[INFO] Updating Intrusion Service Policy with ID %s", id)
 	err := updateIdsSecurityPolicy(id, d, m)
 
 	if err != nil {
-		return handleUpdateError("Intrusion Service Policy", id, err)
+		return diag.FromErr(handleUpdateError("Intrusion Service Policy", id, err))
 	}
 
-	return resourceNsxtPolicyIntrusionServicePolicyRead(d, m)
+	return resourceNsxtPolicyIntrusionServicePolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Intrusion Service Policy id")
+		return diag.Errorf("Error obtaining Intrusion Service Policy id")
 	}
 
 	connector := getPolicyConnector(m)
@@ -382,7 +384,7 @@ func resourceNsxtPolicyIntrusionServicePolicyDelete(d *schema.ResourceData, m in
 	err := client.Delete(d.Get("domain").(string), id)
 
 	if err != nil {
-		return handleDeleteError("Intrusion Service Policy", id, err)
+		return diag.FromErr(handleDeleteError("Intrusion Service Policy", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -39,12 +40,12 @@ var idsProfileSignatureActionValues = []string{
 
 func resourceNsxtPolicyIntrusionServiceProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIntrusionServiceProfileCreate,
-		Read:   resourceNsxtPolicyIntrusionServiceProfileRead,
-		Update: resourceNsxtPolicyIntrusionServiceProfileUpdate,
-		Delete: resourceNsxtPolicyIntrusionServiceProfileDelete,
+		CreateContext: resourceNsxtPolicyIntrusionServiceProfileCreate,
+		ReadContext:   resourceNsxtPolicyIntrusionServiceProfileRead,
+		UpdateContext: resourceNsxtPolicyIntrusionServiceProfileUpdate,
+		DeleteContext: resourceNsxtPolicyIntrusionServiceProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -340,21 +341,21 @@ func resourceNsxtPolicyIntrusionServiceProfileExists(id string, connector client
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIntrusionServiceProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServiceProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIntrusionServiceProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	criteria, err := getIdsProfileCriteriaFromSchema(d)
 	if err != nil {
-		return fmt.Errorf("Failed to read criteria from Ids Profile: %v", err)
+		return diag.Errorf("Failed to read criteria from Ids Profile: %v", err)
 	}
 	signatures := getIdsProfileSignaturesFromSchema(d)
 	profileSeverity := getStringListFromSchemaSet(d, "severities")
@@ -373,64 +374,64 @@ func resourceNsxtPolicyIntrusionServiceProfileCreate(d *schema.ResourceData, m i
 	client := services.NewProfilesClient(connector)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("Ids Profile", id, err)
+		return diag.FromErr(handleCreateError("Ids Profile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIntrusionServiceProfileRead(d, m)
+	return resourceNsxtPolicyIntrusionServiceProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIntrusionServiceProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServiceProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Ids Profile ID")
+		return diag.Errorf("Error obtaining Ids Profile ID")
 	}
 
 	client := services.NewProfilesClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "Ids Profile", id, err)
+		return diag.FromErr(handleReadError(d, "Ids Profile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
 	err = setIdsProfileCriteriaInSchema(obj.Criteria, d)
 	if err != nil {
-		return handleReadError(d, "Ids Profile", id, err)
+		return diag.FromErr(handleReadError(d, "Ids Profile", id, err))
 	}
 	err = setIdsProfileSignaturesInSchema(obj.OverriddenSignatures, d)
 	if err != nil {
-		return handleReadError(d, "Ids Profile", id, err)
+		return diag.FromErr(handleReadError(d, "Ids Profile", id, err))
 	}
 	d.Set("severities", obj.ProfileSeverity)
 
 	return nil
 }
 
-func resourceNsxtPolicyIntrusionServiceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServiceProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IdsProfile ID")
+		return diag.Errorf("Error obtaining IdsProfile ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	criteria, err := getIdsProfileCriteriaFromSchema(d)
 	if err != nil {
-		return fmt.Errorf("Failed to read criteria from Ids Profile: %v", err)
+		return diag.Errorf("Failed to read criteria from Ids Profile: %v", err)
 	}
 	signatures := getIdsProfileSignaturesFromSchema(d)
 	profileSeverity := getStringListFromSchemaSet(d, "severities")
@@ -449,19 +450,19 @@ func resourceNsxtPolicyIntrusionServiceProfileUpdate(d *schema.ResourceData, m i
 	client := services.NewProfilesClient(connector)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("Ids Profile", id, err)
+		return diag.FromErr(handleUpdateError("Ids Profile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIntrusionServiceProfileRead(d, m)
+	return resourceNsxtPolicyIntrusionServiceProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIntrusionServiceProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServiceProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Ids Profile ID")
+		return diag.Errorf("Error obtaining Ids Profile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -470,7 +471,7 @@ func resourceNsxtPolicyIntrusionServiceProfileDelete(d *schema.ResourceData, m i
 	err = client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("Ids Profile", id, err)
+		return diag.FromErr(handleDeleteError("Ids Profile", id, err))
 	}
 
 	return nil
@@ -4,12 +4,14 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -21,10 +23,10 @@ import (
 
 func resourceNsxtPolicyIPAddressAllocation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPAddressAllocationCreate,
-		Read:   resourceNsxtPolicyIPAddressAllocationRead,
-		Update: resourceNsxtPolicyIPAddressAllocationUpdate,
-		Delete: resourceNsxtPolicyIPAddressAllocationDelete,
+		CreateContext: resourceNsxtPolicyIPAddressAllocationCreate,
+		ReadContext:   resourceNsxtPolicyIPAddressAllocationRead,
+		UpdateContext: resourceNsxtPolicyIPAddressAllocationUpdate,
+		DeleteContext: resourceNsxtPolicyIPAddressAllocationDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPAddressAllocationImport,
 		},
@@ -65,13 +67,13 @@ func resourceNsxtPolicyIPAddressAllocationExists(sessionContext utl.SessionConte
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	sessionContext := getSessionContext(d, m)
 	client := ippools.NewIpAllocationsClient(sessionContext, connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
@@ -84,15 +86,15 @@ func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m inter
 
 	exists, err := resourceNsxtPolicyIPAddressAllocationExists(sessionContext, poolID, id, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if exists {
-		return fmt.Errorf("Resource with ID %s already exists", id)
+		return diag.Errorf("Resource with ID %s already exists", id)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	allocationIP := d.Get("allocation_ip").(string)
 
 	obj := model.IpAddressAllocation{
@@ -109,38 +111,38 @@ func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m inter
 	log.Printf("[INFO] Creating IPAddressAllocation with ID %s", id)
 	err = client.Patch(poolID, id, obj)
 	if err != nil {
-		return handleCreateError("IPAddressAllocation", id, err)
+		return diag.FromErr(handleCreateError("IPAddressAllocation", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPAddressAllocationRead(d, m)
+	return resourceNsxtPolicyIPAddressAllocationRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpAllocationsClient(getSessionContext(d, m), connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPAddressAllocation ID")
+		return diag.Errorf("Error obtaining IPAddressAllocation ID")
 	}
 
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
 
 	obj, err := client.Get(poolID, id)
 	if err != nil {
-		return handleReadError(d, "IPAddressAllocation", id, err)
+		return diag.FromErr(handleReadError(d, "IPAddressAllocation", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -151,40 +153,41 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 	if d.Get("allocation_ip").(string) == "" {
 		log.Printf("[DEBUG] Waiting for realization of IP Address for IP Allocation with ID %s", id)
 
-		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, d, d.Get("path").(string))
-		entity, err := stateConf.WaitForState()
+		stateConf := nsxtPolicyWaitForRealizationStateConf(getSessionContext(d, m), connector, d.Get("path").(string), d.Timeout(schema.TimeoutCreate))
+		result, err := stateConf.WaitForState()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		realizedResource := entity.(model.GenericPolicyRealizedResource)
-		for _, attr := range realizedResource.ExtendedAttributes {
-			if *attr.Key == "allocation_ip" {
-				d.Set("allocation_ip", attr.Values[0])
-				return nil
+		for _, realizedResource := range result.(model.GenericPolicyRealizedResourceListResult).Results {
+			for _, attr := range realizedResource.ExtendedAttributes {
+				if *attr.Key == "allocation_ip" {
+					d.Set("allocation_ip", attr.Values[0])
+					return nil
+				}
 			}
 		}
-		return fmt.Errorf("Failed to get realized IP for path %s", d.Get("path"))
+		return diag.Errorf("Failed to get realized IP for path %s", d.Get("path"))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyIPAddressAllocationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpAllocationsClient(getSessionContext(d, m), connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
 
 	obj := model.IpAddressAllocation{
@@ -197,29 +200,29 @@ func resourceNsxtPolicyIPAddressAllocationUpdate(d *schema.ResourceData, m inter
 	log.Printf("[INFO] Updating IPAddressAllocation with ID %s", id)
 	err := client.Patch(poolID, id, obj)
 	if err != nil {
-		return handleUpdateError("IPAddressAllocation", id, err)
+		return diag.FromErr(handleUpdateError("IPAddressAllocation", id, err))
 	}
 
-	return resourceNsxtPolicyIPAddressAllocationRead(d, m)
+	return resourceNsxtPolicyIPAddressAllocationRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPAddressAllocationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpAllocationsClient(getSessionContext(d, m), connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPAddressAllocation ID")
+		return diag.Errorf("Error obtaining IPAddressAllocation ID")
 	}
 
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
 
 	err := client.Delete(poolID, id)
 	if err != nil {
-		return handleDeleteError("IPAddressAllocation", id, err)
+		return diag.FromErr(handleDeleteError("IPAddressAllocation", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -17,10 +18,10 @@ import (
 
 func resourceNsxtPolicyIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPBlockCreate,
-		Read:   resourceNsxtPolicyIPBlockRead,
-		Update: resourceNsxtPolicyIPBlockUpdate,
-		Delete: resourceNsxtPolicyIPBlockDelete,
+		CreateContext: resourceNsxtPolicyIPBlockCreate,
+		ReadContext:   resourceNsxtPolicyIPBlockRead,
+		UpdateContext: resourceNsxtPolicyIPBlockUpdate,
+		DeleteContext: resourceNsxtPolicyIPBlockDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -58,23 +59,23 @@ func resourceNsxtPolicyIPBlockExists(sessionContext utl.SessionContext, id strin
 	return false, logAPIError("Error retrieving IP Block", err)
 }
 
-func resourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(getSessionContext(d, m), connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Block ID")
+		return diag.Errorf("Error obtaining IP Block ID")
 	}
 
 	block, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "IP Block", id, err)
+		return diag.FromErr(handleReadError(d, "IP Block", id, err))
 	}
 
 	d.Set("display_name", block.DisplayName)
 	d.Set("description", block.Description)
-	setPolicyTagsInSchema(d, block.Tags)
+	setPolicyTagsInSchema(d, block.Tags, m)
 	d.Set("nsx_id", block.Id)
 	d.Set("path", block.Path)
 	d.Set("revision", block.Revision)
@@ -83,19 +84,19 @@ func resourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtPolicyIPBlockCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(getSessionContext(d, m), connector)
 
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIPBlockExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	cidr := d.Get("cidr").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressBlock{
 		DisplayName: &displayName,
@@ -108,21 +109,21 @@ func resourceNsxtPolicyIPBlockCreate(d *schema.ResourceData, m interface{}) erro
 	log.Printf("[INFO] Creating IP Block with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("IP Block", id, err)
+		return diag.FromErr(handleCreateError("IP Block", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPBlockRead(d, m)
+	return resourceNsxtPolicyIPBlockRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPBlockUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(getSessionContext(d, m), connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Block ID")
+		return diag.Errorf("Error obtaining IP Block ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -130,7 +131,7 @@ func resourceNsxtPolicyIPBlockUpdate(d *schema.ResourceData, m interface{}) erro
 	description := d.Get("description").(string)
 	cidr := d.Get("cidr").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressBlock{
 		Id:          &id,
@@ -143,23 +144,23 @@ func resourceNsxtPolicyIPBlockUpdate(d *schema.ResourceData, m interface{}) erro
 
 	_, err := client.Update(id, obj)
 	if err != nil {
-		return handleUpdateError("IP Block", id, err)
+		return diag.FromErr(handleUpdateError("IP Block", id, err))
 	}
-	return resourceNsxtPolicyIPBlockRead(d, m)
+	return resourceNsxtPolicyIPBlockRead(ctx, d, m)
 
 }
 
-func resourceNsxtPolicyIPBlockDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Block ID")
+		return diag.Errorf("Error obtaining IP Block ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(getSessionContext(d, m), connector)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("IP Block", id, err)
+		return diag.FromErr(handleDeleteError("IP Block", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,10 +19,10 @@ import (
 
 func resourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPDiscoveryProfileCreate,
-		Read:   resourceNsxtPolicyIPDiscoveryProfileRead,
-		Update: resourceNsxtPolicyIPDiscoveryProfileUpdate,
-		Delete: resourceNsxtPolicyIPDiscoveryProfileDelete,
+		CreateContext: resourceNsxtPolicyIPDiscoveryProfileCreate,
+		ReadContext:   resourceNsxtPolicyIPDiscoveryProfileRead,
+		UpdateContext: resourceNsxtPolicyIPDiscoveryProfileUpdate,
+		DeleteContext: resourceNsxtPolicyIPDiscoveryProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -107,10 +108,10 @@ func resourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
 	}
 }
 
-func ipDiscoveryProfileObjFromSchema(d *schema.ResourceData) model.IPDiscoveryProfile {
+func ipDiscoveryProfileObjFromSchema(d *schema.ResourceData, m interface{}) model.IPDiscoveryProfile {
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	arpNdBindingTimeout := int64(d.Get("arp_nd_binding_timeout").(int))
 	duplicateIPDetectionEnabled := d.Get("duplicate_ip_detection_enabled").(bool)
@@ -168,16 +169,16 @@ func resourceNsxtPolicyIPDiscoveryProfileExists(sessionContext utl.SessionContex
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIPDiscoveryProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	obj := ipDiscoveryProfileObjFromSchema(d)
+	obj := ipDiscoveryProfileObjFromSchema(d, m)
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating IPDiscoveryProfile with ID %s", id)
@@ -185,32 +186,32 @@ func resourceNsxtPolicyIPDiscoveryProfileCreate(d *schema.ResourceData, m interf
 	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
 	err = client.Patch(id, obj, &boolFalse)
 	if err != nil {
-		return handleCreateError("IPDiscoveryProfile", id, err)
+		return diag.FromErr(handleCreateError("IPDiscoveryProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyIPDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining IPDiscoveryProfile ID")
 	}
 
 	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "IPDiscoveryProfile", id, err)
+		return diag.FromErr(handleReadError(d, "IPDiscoveryProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -230,36 +231,36 @@ func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining IPDiscoveryProfile ID")
 	}
 
 	// Read the rest of the configured parameters
-	obj := ipDiscoveryProfileObjFromSchema(d)
+	obj := ipDiscoveryProfileObjFromSchema(d, m)
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Updating IPDiscoveryProfile with ID %s", id)
 	boolFalse := false
 	err := client.Patch(id, obj, &boolFalse)
 	if err != nil {
-		return handleUpdateError("IPDiscoveryProfile", id, err)
+		return diag.FromErr(handleUpdateError("IPDiscoveryProfile", id, err))
 	}
 
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyIPDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining IPDiscoveryProfile ID")
 	}
 
 	var err error
@@ -270,7 +271,7 @@ func resourceNsxtPolicyIPDiscoveryProfileDelete(d *schema.ResourceData, m interf
 	err = client.Delete(id, &boolFalse)
 
 	if err != nil {
-		return handleDeleteError("IPDiscoveryProfile", id, err)
+		return diag.FromErr(handleDeleteError("IPDiscoveryProfile", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -17,10 +18,10 @@ import (
 
 func resourceNsxtPolicyIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolCreate,
-		Read:   resourceNsxtPolicyIPPoolRead,
-		Update: resourceNsxtPolicyIPPoolUpdate,
-		Delete: resourceNsxtPolicyIPPoolDelete,
+		CreateContext: resourceNsxtPolicyIPPoolCreate,
+		ReadContext:   resourceNsxtPolicyIPPoolRead,
+		UpdateContext: resourceNsxtPolicyIPPoolUpdate,
+		DeleteContext: resourceNsxtPolicyIPPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -57,13 +58,13 @@ func resourceNsxtPolicyIPPoolExists(sessionContext utl.SessionContext, id string
 	return false, logAPIError("Error retrieving IP Pool", err)
 }
 
-func resourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(getSessionContext(d, m), connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Pool ID")
+		return diag.Errorf("Error obtaining IP Pool ID")
 	}
 
 	pool, err := client.Get(id)
@@ -73,12 +74,12 @@ func resourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
 			log.Printf("[DEBUG] IP Pool %s not found", id)
 			return nil
 		}
-		return handleReadError(d, "IP Pool", id, err)
+		return diag.FromErr(handleReadError(d, "IP Pool", id, err))
 	}
 
 	d.Set("display_name", pool.DisplayName)
 	d.Set("description", pool.Description)
-	setPolicyTagsInSchema(d, pool.Tags)
+	setPolicyTagsInSchema(d, pool.Tags, m)
 	d.Set("nsx_id", pool.Id)
 	d.Set("path", pool.Path)
 	d.Set("revision", pool.Revision)
@@ -87,18 +88,18 @@ func resourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyIPPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(getSessionContext(d, m), connector)
 
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIPPoolExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPool{
 		DisplayName: &displayName,
@@ -110,26 +111,26 @@ func resourceNsxtPolicyIPPoolCreate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Creating IP Pool with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("IP Pool", id, err)
+		return diag.FromErr(handleCreateError("IP Pool", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolRead(d, m)
+	return resourceNsxtPolicyIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(getSessionContext(d, m), connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Pool ID")
+		return diag.Errorf("Error obtaining IP Pool ID")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPool{
 		DisplayName: &displayName,
@@ -141,27 +142,27 @@ func resourceNsxtPolicyIPPoolUpdate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Updating IP Pool with ID %s", id)
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("IP Pool", id, err)
+		return diag.FromErr(handleUpdateError("IP Pool", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolRead(d, m)
+	return resourceNsxtPolicyIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(getSessionContext(d, m), connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Pool ID")
+		return diag.Errorf("Error obtaining IP Pool ID")
 	}
 
 	log.Printf("[INFO] Deleting IP Pool with ID %s", id)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("IP Pool", id, err)
+		return diag.FromErr(handleDeleteError("IP Pool", id, err))
 	}
 
 	return nil
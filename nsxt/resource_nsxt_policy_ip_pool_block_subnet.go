@@ -4,12 +4,14 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -25,10 +27,10 @@ import (
 
 func resourceNsxtPolicyIPPoolBlockSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolBlockSubnetCreate,
-		Read:   resourceNsxtPolicyIPPoolBlockSubnetRead,
-		Update: resourceNsxtPolicyIPPoolBlockSubnetUpdate,
-		Delete: resourceNsxtPolicyIPPoolBlockSubnetDelete,
+		CreateContext: resourceNsxtPolicyIPPoolBlockSubnetCreate,
+		ReadContext:   resourceNsxtPolicyIPPoolBlockSubnetRead,
+		UpdateContext: resourceNsxtPolicyIPPoolBlockSubnetUpdate,
+		DeleteContext: resourceNsxtPolicyIPPoolBlockSubnetDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPPoolSubnetImport,
 		},
@@ -60,7 +62,7 @@ func resourceNsxtPolicyIPPoolBlockSubnet() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceData, id string) (*data.StructValue, error) {
+func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceData, id string, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
 	displayName := d.Get("display_name").(string)
@@ -69,7 +71,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceDa
 	autoAssignGateway := d.Get("auto_assign_gateway").(bool)
 	size := d.Get("size").(int)
 	size64 := int64(size)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPoolBlockSubnet{
 		DisplayName:       &displayName,
@@ -90,7 +92,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceDa
 	return dataValue.(*data.StructValue), nil
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 	converter := bindings.NewTypeConverter()
@@ -100,7 +102,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Block Subnet ID")
+		return diag.Errorf("Error obtaining Block Subnet ID")
 	}
 
 	subnetData, err := client.Get(poolID, id)
@@ -110,18 +112,18 @@ func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface
 			log.Printf("[DEBUG] Block Subnet %s not found", id)
 			return nil
 		}
-		return handleReadError(d, "Block Subnet", id, err)
+		return diag.FromErr(handleReadError(d, "Block Subnet", id, err))
 	}
 
 	snet, errs := converter.ConvertToGolang(subnetData, model.IpAddressPoolBlockSubnetBindingType())
 	if len(errs) > 0 {
-		return fmt.Errorf("Error converting Block Subnet %s", errs[0])
+		return diag.Errorf("Error converting Block Subnet %s", errs[0])
 	}
 	blockSubnet := snet.(model.IpAddressPoolBlockSubnet)
 
 	d.Set("display_name", blockSubnet.DisplayName)
 	d.Set("description", blockSubnet.Description)
-	setPolicyTagsInSchema(d, blockSubnet.Tags)
+	setPolicyTagsInSchema(d, blockSubnet.Tags, m)
 	d.Set("nsx_id", blockSubnet.Id)
 	d.Set("path", blockSubnet.Path)
 	d.Set("revision", blockSubnet.Revision)
@@ -133,7 +135,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface
 	return nil
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 
@@ -146,29 +148,29 @@ func resourceNsxtPolicyIPPoolBlockSubnetCreate(d *schema.ResourceData, m interfa
 	} else {
 		_, err := client.Get(poolID, id)
 		if err == nil {
-			return fmt.Errorf("Block Subnet with ID '%s' already exists on Pool %s", id, poolID)
+			return diag.Errorf("Block Subnet with ID '%s' already exists on Pool %s", id, poolID)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating IP Pool Block Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleCreateError("Block Subnet", id, err)
+		return diag.FromErr(handleCreateError("Block Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolBlockSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolBlockSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 
@@ -177,26 +179,26 @@ func resourceNsxtPolicyIPPoolBlockSubnetUpdate(d *schema.ResourceData, m interfa
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Block Subnet ID")
+		return diag.Errorf("Error obtaining Block Subnet ID")
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating IP Pool Block Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleUpdateError("Block Subnet", id, err)
+		return diag.FromErr(handleUpdateError("Block Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolBlockSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolBlockSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 
@@ -205,16 +207,16 @@ func resourceNsxtPolicyIPPoolBlockSubnetDelete(d *schema.ResourceData, m interfa
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Block Subnet ID")
+		return diag.Errorf("Error obtaining Block Subnet ID")
 	}
 
 	log.Printf("[INFO] Deleting Block Subnet with ID %s", id)
 	err := client.Delete(poolID, id)
 	if err != nil {
-		return handleDeleteError("Block Subnet", id, err)
+		return diag.FromErr(handleDeleteError("Block Subnet", id, err))
 	}
 
-	return resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(getSessionContext(d, m), d, connector)
+	return diag.FromErr(resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(getSessionContext(d, m), d, connector))
 }
 
 // NOTE: This will not be needed when IPAM is handled by NSXT Policy
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	ippools "github.com/vmware/terraform-provider-nsxt/api/infra/ip_pools"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -16,10 +18,10 @@ import (
 
 func resourceNsxtPolicyIPPoolStaticSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolStaticSubnetCreate,
-		Read:   resourceNsxtPolicyIPPoolStaticSubnetRead,
-		Update: resourceNsxtPolicyIPPoolStaticSubnetUpdate,
-		Delete: resourceNsxtPolicyIPPoolStaticSubnetDelete,
+		CreateContext: resourceNsxtPolicyIPPoolStaticSubnetCreate,
+		ReadContext:   resourceNsxtPolicyIPPoolStaticSubnetRead,
+		UpdateContext: resourceNsxtPolicyIPPoolStaticSubnetUpdate,
+		DeleteContext: resourceNsxtPolicyIPPoolStaticSubnetDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPPoolSubnetImport,
 		},
@@ -66,7 +68,7 @@ func resourceNsxtPolicyIPPoolStaticSubnet() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceData, id string) (*data.StructValue, error) {
+func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceData, id string, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
 	displayName := d.Get("display_name").(string)
@@ -75,7 +77,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceD
 	dnsNameservers := interfaceListToStringList(d.Get("dns_nameservers").([]interface{}))
 	dnsSuffix := d.Get("dns_suffix").(string)
 	gateway := d.Get("gateway").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.IpAddressPoolStaticSubnet{
 		DisplayName:  &displayName,
@@ -118,7 +120,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceD
 	return dataValue.(*data.StructValue), nil
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 	converter := bindings.NewTypeConverter()
@@ -128,7 +130,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interfac
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Static Subnet ID")
+		return diag.Errorf("Error obtaining Static Subnet ID")
 	}
 
 	subnetData, err := client.Get(poolID, id)
@@ -138,18 +140,18 @@ func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interfac
 			log.Printf("[DEBUG] Static Subnet %s not found", id)
 			return nil
 		}
-		return handleReadError(d, "Static Subnet", id, err)
+		return diag.FromErr(handleReadError(d, "Static Subnet", id, err))
 	}
 
 	snet, errs := converter.ConvertToGolang(subnetData, model.IpAddressPoolStaticSubnetBindingType())
 	if len(errs) > 0 {
-		return fmt.Errorf("Error converting Static Subnet %s", errs[0])
+		return diag.Errorf("Error converting Static Subnet %s", errs[0])
 	}
 	staticSubnet := snet.(model.IpAddressPoolStaticSubnet)
 
 	d.Set("display_name", staticSubnet.DisplayName)
 	d.Set("description", staticSubnet.Description)
-	setPolicyTagsInSchema(d, staticSubnet.Tags)
+	setPolicyTagsInSchema(d, staticSubnet.Tags, m)
 	d.Set("nsx_id", staticSubnet.Id)
 	d.Set("path", staticSubnet.Path)
 	d.Set("revision", staticSubnet.Revision)
@@ -171,7 +173,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 
@@ -184,29 +186,29 @@ func resourceNsxtPolicyIPPoolStaticSubnetCreate(d *schema.ResourceData, m interf
 	} else {
 		_, err := client.Get(poolID, id)
 		if err == nil {
-			return fmt.Errorf("Static Subnet with ID '%s' already exists on Pool %s", id, poolID)
+			return diag.Errorf("Static Subnet with ID '%s' already exists on Pool %s", id, poolID)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating IP Pool Static Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleCreateError("Static Subnet", id, err)
+		return diag.FromErr(handleCreateError("Static Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolStaticSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolStaticSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 
@@ -215,26 +217,26 @@ func resourceNsxtPolicyIPPoolStaticSubnetUpdate(d *schema.ResourceData, m interf
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Static Subnet ID")
+		return diag.Errorf("Error obtaining Static Subnet ID")
 	}
 
-	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id)
+	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updating IP Pool Static Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleUpdateError("Static Subnet", id, err)
+		return diag.FromErr(handleUpdateError("Static Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolStaticSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolStaticSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ippools.NewIpSubnetsClient(getSessionContext(d, m), connector)
 
@@ -243,13 +245,13 @@ func resourceNsxtPolicyIPPoolStaticSubnetDelete(d *schema.ResourceData, m interf
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Static Subnet ID")
+		return diag.Errorf("Error obtaining Static Subnet ID")
 	}
 
 	log.Printf("[INFO] Deleting Static Subnet with ID %s", id)
 	err := client.Delete(poolID, id)
 	if err != nil {
-		return handleDeleteError("Static Subnet", id, err)
+		return diag.FromErr(handleDeleteError("Static Subnet", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -21,12 +22,12 @@ var iPSecVpnDpdProfileDpdProbeModeValues = []string{
 
 func resourceNsxtPolicyIPSecVpnDpdProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnDpdProfileCreate,
-		Read:   resourceNsxtPolicyIPSecVpnDpdProfileRead,
-		Update: resourceNsxtPolicyIPSecVpnDpdProfileUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnDpdProfileDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnDpdProfileCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnDpdProfileRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnDpdProfileUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnDpdProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -75,10 +76,10 @@ func resourceNsxtPolicyIPSecVpnDpdProfileExists(id string, connector client.Conn
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPSecVpnDpdProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnDpdProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if isPolicyGlobalManager(m) {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	connector := getPolicyConnector(m)
@@ -86,12 +87,12 @@ func resourceNsxtPolicyIPSecVpnDpdProfileCreate(d *schema.ResourceData, m interf
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPSecVpnDpdProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	dpdProbeInterval := int64(d.Get("dpd_probe_interval").(int))
 	dpdProbeMode := d.Get("dpd_probe_mode").(string)
 	enabled := d.Get("enabled").(bool)
@@ -112,32 +113,32 @@ func resourceNsxtPolicyIPSecVpnDpdProfileCreate(d *schema.ResourceData, m interf
 	client := infra.NewIpsecVpnDpdProfilesClient(connector)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("IPSecVpnDpdProfile", id, err)
+		return diag.FromErr(handleCreateError("IPSecVpnDpdProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPSecVpnDpdProfileRead(d, m)
+	return resourceNsxtPolicyIPSecVpnDpdProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnDpdProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnDpdProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnDpdProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnDpdProfile ID")
 	}
 
 	client := infra.NewIpsecVpnDpdProfilesClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "IPSecVpnDpdProfile", id, err)
+		return diag.FromErr(handleReadError(d, "IPSecVpnDpdProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -149,18 +150,18 @@ func resourceNsxtPolicyIPSecVpnDpdProfileRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnDpdProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnDpdProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnDpdProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnDpdProfile ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	dpdProbeInterval := int64(d.Get("dpd_probe_interval").(int))
 	dpdProbeMode := d.Get("dpd_probe_mode").(string)
 	enabled := d.Get("enabled").(bool)
@@ -179,16 +180,16 @@ func resourceNsxtPolicyIPSecVpnDpdProfileUpdate(d *schema.ResourceData, m interf
 	client := infra.NewIpsecVpnDpdProfilesClient(connector)
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("IPSecVpnDpdProfile", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnDpdProfile", id, err))
 	}
 
-	return resourceNsxtPolicyIPSecVpnDpdProfileRead(d, m)
+	return resourceNsxtPolicyIPSecVpnDpdProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnDpdProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnDpdProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnDpdProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnDpdProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -196,7 +197,7 @@ func resourceNsxtPolicyIPSecVpnDpdProfileDelete(d *schema.ResourceData, m interf
 	err := client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("IPSecVpnDpdProfile", id, err)
+		return diag.FromErr(handleDeleteError("IPSecVpnDpdProfile", id, err))
 	}
 
 	return nil
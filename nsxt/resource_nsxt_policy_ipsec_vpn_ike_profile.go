@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -48,12 +49,12 @@ var iPSecVpnIkeProfileIkeVersionValues = []string{
 
 func resourceNsxtPolicyIPSecVpnIkeProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnIkeProfileCreate,
-		Read:   resourceNsxtPolicyIPSecVpnIkeProfileRead,
-		Update: resourceNsxtPolicyIPSecVpnIkeProfileUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnIkeProfileDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnIkeProfileCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnIkeProfileRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnIkeProfileUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnIkeProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -116,9 +117,9 @@ func resourceNsxtPolicyIPSecVpnIkeProfileExists(id string, connector client.Conn
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPSecVpnIkeProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnIkeProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	connector := getPolicyConnector(m)
@@ -126,12 +127,12 @@ func resourceNsxtPolicyIPSecVpnIkeProfileCreate(d *schema.ResourceData, m interf
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPSecVpnIkeProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	dhGroups := getStringListFromSchemaSet(d, "dh_groups")
 	digestAlgorithms := getStringListFromSchemaSet(d, "digest_algorithms")
 	encryptionAlgorithms := getStringListFromSchemaSet(d, "encryption_algorithms")
@@ -154,32 +155,32 @@ func resourceNsxtPolicyIPSecVpnIkeProfileCreate(d *schema.ResourceData, m interf
 	client := infra.NewIpsecVpnIkeProfilesClient(connector)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("IPSecVpnIkeProfile", id, err)
+		return diag.FromErr(handleCreateError("IPSecVpnIkeProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPSecVpnIkeProfileRead(d, m)
+	return resourceNsxtPolicyIPSecVpnIkeProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnIkeProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnIkeProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnIkeProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnIkeProfile ID")
 	}
 
 	client := infra.NewIpsecVpnIkeProfilesClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "IPSecVpnIkeProfile", id, err)
+		return diag.FromErr(handleReadError(d, "IPSecVpnIkeProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -192,18 +193,18 @@ func resourceNsxtPolicyIPSecVpnIkeProfileRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnIkeProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnIkeProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnIkeProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnIkeProfile ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	dhGroups := getStringListFromSchemaSet(d, "dh_groups")
 	digestAlgorithms := getStringListFromSchemaSet(d, "digest_algorithms")
@@ -225,16 +226,16 @@ func resourceNsxtPolicyIPSecVpnIkeProfileUpdate(d *schema.ResourceData, m interf
 	client := infra.NewIpsecVpnIkeProfilesClient(connector)
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("IPSecVpnIkeProfile", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnIkeProfile", id, err))
 	}
 
-	return resourceNsxtPolicyIPSecVpnIkeProfileRead(d, m)
+	return resourceNsxtPolicyIPSecVpnIkeProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnIkeProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnIkeProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnIkeProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnIkeProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -242,7 +243,7 @@ func resourceNsxtPolicyIPSecVpnIkeProfileDelete(d *schema.ResourceData, m interf
 	err := client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("IPSecVpnIkeProfile", id, err)
+		return diag.FromErr(handleDeleteError("IPSecVpnIkeProfile", id, err))
 	}
 
 	return nil
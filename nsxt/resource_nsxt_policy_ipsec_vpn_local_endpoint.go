@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -20,10 +22,10 @@ import (
 
 func resourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnLocalEndpointCreate,
-		Read:   resourceNsxtPolicyIPSecVpnLocalEndpointRead,
-		Update: resourceNsxtPolicyIPSecVpnLocalEndpointUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnLocalEndpointDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnLocalEndpointCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnLocalEndpointRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnLocalEndpointUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnLocalEndpointDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtVPNServiceResourceImporter,
 		},
@@ -187,10 +189,10 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointExists(servicePath string) func(id s
 	}
 }
 
-func ipSecVpnLocalEndpointInitStruct(d *schema.ResourceData) model.IPSecVpnLocalEndpoint {
+func ipSecVpnLocalEndpointInitStruct(d *schema.ResourceData, m interface{}) model.IPSecVpnLocalEndpoint {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	certificatePath := d.Get("certificate_path").(string)
 	localAddress := d.Get("local_address").(string)
 	localID := d.Get("local_id").(string)
@@ -223,54 +225,54 @@ func ipSecVpnLocalEndpointInitStruct(d *schema.ResourceData) model.IPSecVpnLocal
 	return obj
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	servicePath := d.Get("service_path").(string)
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPSecVpnLocalEndpointExists(servicePath))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	obj := ipSecVpnLocalEndpointInitStruct(d)
+	obj := ipSecVpnLocalEndpointInitStruct(d, m)
 
 	log.Printf("[INFO] Creating IPSecVpnLocalEndpoint with ID %s", id)
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleCreateError("IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleCreateError("IPSecVpnLocalEndpoint", id, err))
 	}
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleCreateError("IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleCreateError("IPSecVpnLocalEndpoint", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(d, m)
+	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+		return diag.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleReadError(d, "IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleReadError(d, "IPSecVpnLocalEndpoint", id, err))
 	}
 	obj, err := client.Get(connector, id)
 	if err != nil {
-		return handleReadError(d, "IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleReadError(d, "IPSecVpnLocalEndpoint", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -283,50 +285,50 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+		return diag.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnLocalEndpoint", id, err))
 	}
 
-	obj := ipSecVpnLocalEndpointInitStruct(d)
+	obj := ipSecVpnLocalEndpointInitStruct(d, m)
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
 	log.Printf("[INFO] Updating IPSecVpnLocalEndpoint with ID %s", id)
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnLocalEndpoint", id, err))
 	}
 
-	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(d, m)
+	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+		return diag.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnLocalEndpoint", id, err))
 	}
 	connector := getPolicyConnector(m)
 	err = client.Delete(connector, id)
 
 	if err != nil {
-		return handleDeleteError("IPSecVpnLocalEndpoint", id, err)
+		return diag.FromErr(handleDeleteError("IPSecVpnLocalEndpoint", id, err))
 	}
 
 	return nil
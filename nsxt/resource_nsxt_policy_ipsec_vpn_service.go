@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -28,10 +30,10 @@ var IPSecVpnServiceIkeLogLevelTypes = []string{
 
 func resourceNsxtPolicyIPSecVpnService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnServiceCreate,
-		Read:   resourceNsxtPolicyIPSecVpnServiceRead,
-		Update: resourceNsxtPolicyIPSecVpnServiceUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnServiceDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnServiceCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnServiceRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnServiceUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPSecVpnServiceImport,
 		},
@@ -255,12 +257,12 @@ func getLocaleServiceAndGatewayPath(d *schema.ResourceData) (string, string, err
 	return gatewayPath, localeServicePath, nil
 }
 
-func resourceNsxtPolicyIPSecVpnServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnService ID")
+		return diag.Errorf("Error obtaining IPSecVpnService ID")
 	}
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -268,18 +270,18 @@ func resourceNsxtPolicyIPSecVpnServiceRead(d *schema.ResourceData, m interface{}
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
 	}
 	obj, err := getNsxtPolicyIPSecVpnServiceByID(connector, gwID, isT0, localeServiceID, id, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleReadError(d, "IPSecVpnService", id, err)
+		return diag.FromErr(handleReadError(d, "IPSecVpnService", id, err))
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -292,7 +294,7 @@ func resourceNsxtPolicyIPSecVpnServiceRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -300,7 +302,7 @@ func resourceNsxtPolicyIPSecVpnServiceCreate(d *schema.ResourceData, m interface
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -312,9 +314,9 @@ func resourceNsxtPolicyIPSecVpnServiceCreate(d *schema.ResourceData, m interface
 	} else {
 		_, err := getNsxtPolicyIPSecVpnServiceByID(connector, gwID, isT0, localeServiceID, id, isGlobalManager)
 		if err == nil {
-			return fmt.Errorf("IPSecVpnService with nsx_id '%s' already exists", id)
+			return diag.Errorf("IPSecVpnService with nsx_id '%s' already exists", id)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -323,7 +325,7 @@ func resourceNsxtPolicyIPSecVpnServiceCreate(d *schema.ResourceData, m interface
 	enabled := d.Get("enabled").(bool)
 	haSync := d.Get("ha_sync").(bool)
 	rules := getIPSecVPNBypassRulesFromSchema(d)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	ipSecVpnService := model.IPSecVpnService{
 		Id:          &id,
@@ -342,19 +344,19 @@ func resourceNsxtPolicyIPSecVpnServiceCreate(d *schema.ResourceData, m interface
 
 	err = patchNsxtPolicyIPSecVpnService(connector, gwID, localeServiceID, ipSecVpnService, isT0)
 	if err != nil {
-		return handleCreateError("IPSecVpnService", id, err)
+		return diag.FromErr(handleCreateError("IPSecVpnService", id, err))
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPSecVpnServiceRead(d, m)
+	return resourceNsxtPolicyIPSecVpnServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSec VPN Service ID")
+		return diag.Errorf("Error obtaining IPSec VPN Service ID")
 	}
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -362,7 +364,7 @@ func resourceNsxtPolicyIPSecVpnServiceUpdate(d *schema.ResourceData, m interface
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -373,7 +375,7 @@ func resourceNsxtPolicyIPSecVpnServiceUpdate(d *schema.ResourceData, m interface
 	enabled := d.Get("enabled").(bool)
 	haSync := d.Get("ha_sync").(bool)
 	rules := getIPSecVPNBypassRulesFromSchema(d)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	ipSecVpnService := model.IPSecVpnService{
 		Id:          &id,
@@ -394,16 +396,16 @@ func resourceNsxtPolicyIPSecVpnServiceUpdate(d *schema.ResourceData, m interface
 	log.Printf("[INFO] Updating IPSecVpnService with ID %s", id)
 	err = updateNsxtPolicyIPSecVpnService(connector, gwID, localeServiceID, ipSecVpnService, isT0)
 	if err != nil {
-		return handleUpdateError("IPSecVpnService", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnService", id, err))
 	}
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPSecVpnServiceRead(d, m)
+	return resourceNsxtPolicyIPSecVpnServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSec VPN Service ID")
+		return diag.Errorf("Error obtaining IPSec VPN Service ID")
 	}
 
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
@@ -412,7 +414,7 @@ func resourceNsxtPolicyIPSecVpnServiceDelete(d *schema.ResourceData, m interface
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -420,7 +422,7 @@ func resourceNsxtPolicyIPSecVpnServiceDelete(d *schema.ResourceData, m interface
 
 	err = deleteNsxtPolicyIPSecVpnService(getPolicyConnector(m), gwID, localeServiceID, isT0, id)
 	if err != nil {
-		return handleDeleteError("IPSecVpnService", id, err)
+		return diag.FromErr(handleDeleteError("IPSecVpnService", id, err))
 	}
 	return nil
 }
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -63,10 +65,10 @@ var IPSecRulesActionValues = []string{
 
 func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnSessionCreate,
-		Read:   resourceNsxtPolicyIPSecVpnSessionRead,
-		Update: resourceNsxtPolicyIPSecVpnSessionUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnSessionDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnSessionCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnSessionRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnSessionUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnSessionDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtVpnSessionImporter,
 		},
@@ -166,7 +168,7 @@ func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 	}
 }
 
-func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+func getIPSecVPNSessionFromSchema(d *schema.ResourceData, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 
 	psk := d.Get("psk").(string)
@@ -186,7 +188,7 @@ func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, er
 	enabled := d.Get("enabled").(bool)
 	direction := d.Get("direction").(string)
 	mss := int64(d.Get("max_segment_size").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	if resourceType == routeBasedIPSecVpnSession {
 		tunnelInterface := interfaceListToStringList(d.Get("ip_addresses").([]interface{}))
@@ -223,7 +225,7 @@ func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, er
 			Psk:                      &psk,
 			Tags:                     tags,
 		}
-		if nsxVersionHigherOrEqual("3.2.0") {
+		if nsxVersionHigherOrEqual(m, "3.2.0") {
 			if direction != "" {
 				tcpMSSClamping := model.TcpMaximumSegmentSizeClamping{
 					Direction: &direction,
@@ -271,7 +273,7 @@ func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, er
 			Psk:                      &psk,
 			Tags:                     tags,
 		}
-		if nsxVersionHigherOrEqual("3.2.0") {
+		if nsxVersionHigherOrEqual(m, "3.2.0") {
 			if direction != "" {
 				tcpMSSClamping := model.TcpMaximumSegmentSizeClamping{
 					Direction: &direction,
@@ -518,12 +520,12 @@ func getIPSecVPNRulesFromSchema(d *schema.ResourceData) []model.IPSecVpnRule {
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnSessionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Get("nsx_id").(string)
@@ -535,24 +537,24 @@ func resourceNsxtPolicyIPSecVpnSessionCreate(d *schema.ResourceData, m interface
 
 	_, err = resourceNsxtPolicyIPSecVpnSessionExists(servicePath, id, connector)
 	if err == nil {
-		return fmt.Errorf("IPSecVpnSession with nsx_id '%s' already exists under IPSecVpnService '%s'", id, servicePath)
+		return diag.Errorf("IPSecVpnSession with nsx_id '%s' already exists under IPSecVpnService '%s'", id, servicePath)
 	} else if !isNotFoundError(err) {
-		return err
+		return diag.FromErr(err)
 	}
 
-	obj, err := getIPSecVPNSessionFromSchema(d)
+	obj, err := getIPSecVPNSessionFromSchema(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleCreateError("IPSecVpnSession", id, err)
+		return diag.FromErr(handleCreateError("IPSecVpnSession", id, err))
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+	return resourceNsxtPolicyIPSecVpnSessionRead(ctx, d, m)
 }
 
 func resourceNsxtPolicyIPSecVpnSessionExists(servicePath string, sessionID string, connector client.Connector) (bool, error) {
@@ -572,20 +574,20 @@ func resourceNsxtPolicyIPSecVpnSessionExists(servicePath string, sessionID strin
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	converter := bindings.NewTypeConverter()
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+		return diag.Errorf("Error obtaining IPSecVpnSession ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return handleReadError(d, "IpsecVpnSession", id, err)
+		return diag.FromErr(handleReadError(d, "IpsecVpnSession", id, err))
 	}
 	obj, err := client.Get(connector, id)
 	if err != nil {
@@ -593,12 +595,12 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 			d.SetId("")
 			return nil
 		}
-		return handleReadError(d, "IpsecVpnSession", id, err)
+		return diag.FromErr(handleReadError(d, "IpsecVpnSession", id, err))
 	}
 
 	baseObj, errs := converter.ConvertToGolang(obj, model.IPSecVpnSessionBindingType())
 	if len(errs) > 0 {
-		return fmt.Errorf("Error converting IpsecVpnSession %s", errs[0])
+		return diag.Errorf("Error converting IpsecVpnSession %s", errs[0])
 	}
 	baseVPN := baseObj.(model.IPSecVpnSession)
 	resourceType := baseVPN.ResourceType
@@ -606,13 +608,13 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 	if resourceType == model.IPSecVpnSession_RESOURCE_TYPE_ROUTEBASEDIPSECVPNSESSION {
 		interfaceVpn, errs := converter.ConvertToGolang(obj, model.RouteBasedIPSecVpnSessionBindingType())
 		if len(errs) > 0 {
-			return fmt.Errorf("Error converting IpsecVpnSession %s", errs[0])
+			return diag.Errorf("Error converting IpsecVpnSession %s", errs[0])
 		}
 		blockVPN := interfaceVpn.(model.RouteBasedIPSecVpnSession)
 
 		d.Set("display_name", blockVPN.DisplayName)
 		d.Set("description", blockVPN.Description)
-		setPolicyTagsInSchema(d, blockVPN.Tags)
+		setPolicyTagsInSchema(d, blockVPN.Tags, m)
 		d.Set("nsx_id", blockVPN.Id)
 		d.Set("path", blockVPN.Path)
 		d.Set("revision", blockVPN.Revision)
@@ -627,7 +629,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 		d.Set("tunnel_profile_path", blockVPN.TunnelProfilePath)
 		d.Set("peer_address", blockVPN.PeerAddress)
 		d.Set("peer_id", blockVPN.PeerId)
-		if nsxVersionHigherOrEqual("3.2.0") {
+		if nsxVersionHigherOrEqual(m, "3.2.0") {
 			if blockVPN.TcpMssClamping != nil {
 				direction := blockVPN.TcpMssClamping.Direction
 				mss := blockVPN.TcpMssClamping.MaxSegmentSize
@@ -660,13 +662,13 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 		// Policy based ipsec vpn session
 		interfaceVpn, errs := converter.ConvertToGolang(obj, model.PolicyBasedIPSecVpnSessionBindingType())
 		if len(errs) > 0 {
-			return fmt.Errorf("Error converting IpsecVpnSession %s", errs[0])
+			return diag.Errorf("Error converting IpsecVpnSession %s", errs[0])
 		}
 		blockVPN := interfaceVpn.(model.PolicyBasedIPSecVpnSession)
 
 		d.Set("display_name", blockVPN.DisplayName)
 		d.Set("description", blockVPN.Description)
-		setPolicyTagsInSchema(d, blockVPN.Tags)
+		setPolicyTagsInSchema(d, blockVPN.Tags, m)
 		d.Set("nsx_id", blockVPN.Id)
 		d.Set("path", blockVPN.Path)
 		d.Set("revision", blockVPN.Revision)
@@ -684,7 +686,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 		if blockVPN.Rules != nil {
 			setRuleInSchema(d, blockVPN.Rules)
 		}
-		if nsxVersionHigherOrEqual("3.2.0") {
+		if nsxVersionHigherOrEqual(m, "3.2.0") {
 			if blockVPN.TcpMssClamping != nil {
 				direction := blockVPN.TcpMssClamping.Direction
 				mss := blockVPN.TcpMssClamping.MaxSegmentSize
@@ -696,49 +698,49 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnSessionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+		return diag.Errorf("Error obtaining IPSecVpnSession ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnSession", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnSession", id, err))
 	}
-	obj, err := getIPSecVPNSessionFromSchema(d)
+	obj, err := getIPSecVPNSessionFromSchema(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleUpdateError("IPSecVpnSession", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnSession", id, err))
 	}
 
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+	return resourceNsxtPolicyIPSecVpnSessionRead(ctx, d, m)
 
 }
 
-func resourceNsxtPolicyIPSecVpnSessionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+		return diag.Errorf("Error obtaining IPSecVpnSession ID")
 	}
 	servicePath := d.Get("service_path").(string)
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnSession", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnSession", id, err))
 	}
 	connector := getPolicyConnector(m)
 	err = client.Delete(connector, id)
 	if err != nil {
-		return handleDeleteError("IPSecVpnSession", id, err)
+		return diag.FromErr(handleDeleteError("IPSecVpnSession", id, err))
 	}
 
 	return nil
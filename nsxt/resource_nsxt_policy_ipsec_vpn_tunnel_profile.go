@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -51,12 +52,12 @@ var ipSecVpnTunnelProfileEncryptionAlgorithmsValues = []string{
 
 func resourceNsxtPolicyIPSecVpnTunnelProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnTunnelProfileCreate,
-		Read:   resourceNsxtPolicyIPSecVpnTunnelProfileRead,
-		Update: resourceNsxtPolicyIPSecVpnTunnelProfileUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnTunnelProfileDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnTunnelProfileCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnTunnelProfileRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnTunnelProfileUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnTunnelProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -124,21 +125,21 @@ func resourceNsxtPolicyIPSecVpnTunnelProfileExists(id string, connector client.C
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPSecVpnTunnelProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnTunnelProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPSecVpnTunnelProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	dfPolicy := d.Get("df_policy").(string)
 	dhGroups := getStringListFromSchemaSet(d, "dh_groups")
 	digestAlgorithms := getStringListFromSchemaSet(d, "digest_algorithms")
@@ -163,32 +164,32 @@ func resourceNsxtPolicyIPSecVpnTunnelProfileCreate(d *schema.ResourceData, m int
 	client := infra.NewIpsecVpnTunnelProfilesClient(connector)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("IPSecVpnTunnelProfile", id, err)
+		return diag.FromErr(handleCreateError("IPSecVpnTunnelProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPSecVpnTunnelProfileRead(d, m)
+	return resourceNsxtPolicyIPSecVpnTunnelProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnTunnelProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnTunnelProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnTunnelProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnTunnelProfile ID")
 	}
 
 	client := infra.NewIpsecVpnTunnelProfilesClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "IPSecVpnTunnelProfile", id, err)
+		return diag.FromErr(handleReadError(d, "IPSecVpnTunnelProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -202,18 +203,18 @@ func resourceNsxtPolicyIPSecVpnTunnelProfileRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnTunnelProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnTunnelProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnTunnelProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnTunnelProfile ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	dfPolicy := d.Get("df_policy").(string)
 	dhGroups := getStringListFromSchemaSet(d, "dh_groups")
@@ -237,16 +238,16 @@ func resourceNsxtPolicyIPSecVpnTunnelProfileUpdate(d *schema.ResourceData, m int
 	client := infra.NewIpsecVpnTunnelProfilesClient(connector)
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("IPSecVpnTunnelProfile", id, err)
+		return diag.FromErr(handleUpdateError("IPSecVpnTunnelProfile", id, err))
 	}
 
-	return resourceNsxtPolicyIPSecVpnTunnelProfileRead(d, m)
+	return resourceNsxtPolicyIPSecVpnTunnelProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnTunnelProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnTunnelProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnTunnelProfile ID")
+		return diag.Errorf("Error obtaining IPSecVpnTunnelProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -254,7 +255,7 @@ func resourceNsxtPolicyIPSecVpnTunnelProfileDelete(d *schema.ResourceData, m int
 	err := client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("IPSecVpnTunnelProfile", id, err)
+		return diag.FromErr(handleDeleteError("IPSecVpnTunnelProfile", id, err))
 	}
 
 	return nil
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -25,10 +27,10 @@ var L2VpnServiceModes = []string{
 
 func resourceNsxtPolicyL2VpnService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyL2VpnServiceCreate,
-		Read:   resourceNsxtPolicyL2VpnServiceRead,
-		Update: resourceNsxtPolicyL2VpnServiceUpdate,
-		Delete: resourceNsxtPolicyL2VpnServiceDelete,
+		CreateContext: resourceNsxtPolicyL2VpnServiceCreate,
+		ReadContext:   resourceNsxtPolicyL2VpnServiceRead,
+		UpdateContext: resourceNsxtPolicyL2VpnServiceUpdate,
+		DeleteContext: resourceNsxtPolicyL2VpnServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyL2VpnServiceImport,
 		},
@@ -151,12 +153,12 @@ func resourceNsxtPolicyL2VpnServiceImport(d *schema.ResourceData, m interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VpnService ID")
+		return diag.Errorf("Error obtaining L2VpnService ID")
 	}
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -164,18 +166,18 @@ func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) e
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
 	}
 	obj, err := getNsxtPolicyL2VpnServiceByID(connector, gwID, isT0, localeServiceID, id, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleReadError(d, "L2VpnService", id, err)
+		return diag.FromErr(handleReadError(d, "L2VpnService", id, err))
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -188,7 +190,7 @@ func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -196,7 +198,7 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -208,9 +210,9 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 	} else {
 		_, err := getNsxtPolicyL2VpnServiceByID(connector, gwID, isT0, localeServiceID, id, isGlobalManager)
 		if err == nil {
-			return fmt.Errorf("L2VpnService with nsx_id '%s' already exists", id)
+			return diag.Errorf("L2VpnService with nsx_id '%s' already exists", id)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -218,7 +220,7 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 	description := d.Get("description").(string)
 	enableHub := d.Get("enable_hub").(bool)
 	mode := d.Get("mode").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	l2VpnService := model.L2VPNService{
 		Id:          &id,
@@ -240,19 +242,19 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 
 	err = patchNsxtPolicyL2VpnService(connector, gwID, localeServiceID, l2VpnService, isT0)
 	if err != nil {
-		return handleCreateError("L2VpnService", id, err)
+		return diag.FromErr(handleCreateError("L2VpnService", id, err))
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyL2VpnServiceRead(d, m)
+	return resourceNsxtPolicyL2VpnServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2 VPN Service ID")
+		return diag.Errorf("Error obtaining L2 VPN Service ID")
 	}
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -260,7 +262,7 @@ func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{})
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -271,7 +273,7 @@ func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{})
 	enableHub := d.Get("enable_hub").(bool)
 	revision := int64(d.Get("revision").(int))
 	mode := d.Get("mode").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	l2VpnService := model.L2VPNService{
 		Id:          &id,
@@ -295,17 +297,17 @@ func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{})
 	log.Printf("[INFO] Updating L2VpnService with ID %s", id)
 	err = patchNsxtPolicyL2VpnService(connector, gwID, localeServiceID, l2VpnService, isT0)
 	if err != nil {
-		return handleUpdateError("L2VpnService", id, err)
+		return diag.FromErr(handleUpdateError("L2VpnService", id, err))
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyL2VpnServiceRead(d, m)
+	return resourceNsxtPolicyL2VpnServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyL2VpnServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2 VPN Service ID")
+		return diag.Errorf("Error obtaining L2 VPN Service ID")
 	}
 
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
@@ -314,7 +316,7 @@ func resourceNsxtPolicyL2VpnServiceDelete(d *schema.ResourceData, m interface{})
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return diag.FromErr(err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -322,7 +324,7 @@ func resourceNsxtPolicyL2VpnServiceDelete(d *schema.ResourceData, m interface{})
 
 	err = deleteNsxtPolicyL2VpnService(getPolicyConnector(m), gwID, localeServiceID, isT0, id)
 	if err != nil {
-		return handleDeleteError("L2VpnService", id, err)
+		return diag.FromErr(handleDeleteError("L2VpnService", id, err))
 	}
 	return nil
 }
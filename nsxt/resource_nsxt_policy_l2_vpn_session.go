@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -28,10 +30,10 @@ var L2VpnTunnelEncapsulationProtocal = []string{
 
 func resourceNsxtPolicyL2VPNSession() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyL2VPNSessionCreate,
-		Read:   resourceNsxtPolicyL2VPNSessionRead,
-		Update: resourceNsxtPolicyL2VPNSessionUpdate,
-		Delete: resourceNsxtPolicyL2VPNSessionDelete,
+		CreateContext: resourceNsxtPolicyL2VPNSessionCreate,
+		ReadContext:   resourceNsxtPolicyL2VPNSessionRead,
+		UpdateContext: resourceNsxtPolicyL2VPNSessionUpdate,
+		DeleteContext: resourceNsxtPolicyL2VPNSessionDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtVpnSessionImporter,
 		},
@@ -93,13 +95,13 @@ func resourceNsxtPolicyL2VPNSession() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	transportTunnel := getStringListFromSchemaList(d, "transport_tunnels")
 	id := d.Get("nsx_id").(string)
@@ -108,14 +110,14 @@ func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{})
 	}
 	_, err = resourceNsxtPolicyL2VpnSessionExists(isT0, gwID, localeServiceID, serviceID, id, connector)
 	if err == nil {
-		return fmt.Errorf("L2VpnSession with nsx_id '%s' already exists.'", id)
+		return diag.Errorf("L2VpnSession with nsx_id '%s' already exists.'", id)
 	} else if !isNotFoundError(err) {
-		return err
+		return diag.FromErr(err)
 	}
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	enabled := d.Get("enabled").(bool)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	obj := model.L2VPNSession{
 		DisplayName:      &displayName,
@@ -125,7 +127,7 @@ func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{})
 		TransportTunnels: transportTunnel,
 	}
 
-	if nsxVersionHigherOrEqual("3.2.0") {
+	if nsxVersionHigherOrEqual(m, "3.2.0") {
 		direction := d.Get("direction").(string)
 		maxSegmentSize := int64(d.Get("max_segment_size").(int))
 		if direction != "" {
@@ -168,13 +170,13 @@ func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{})
 		}
 	}
 	if err != nil {
-		return handleCreateError("L2VPNSession", id, err)
+		return diag.FromErr(handleCreateError("L2VPNSession", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyL2VPNSessionRead(d, m)
+	return resourceNsxtPolicyL2VPNSessionRead(ctx, d, m)
 }
 
 func parseL2VPNServicePolicyPath(path string) (bool, string, string, string, error) {
@@ -199,16 +201,16 @@ func parseL2VPNServicePolicyPath(path string) (bool, string, string, string, err
 	return isT0, gwID, localeServiceID, serviceID, nil
 }
 
-func resourceNsxtPolicyL2VPNSessionRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VPNSession ID")
+		return diag.Errorf("Error obtaining L2VPNSession ID")
 	}
 
 	var obj model.L2VPNSession
@@ -230,12 +232,12 @@ func resourceNsxtPolicyL2VPNSessionRead(d *schema.ResourceData, m interface{}) e
 		}
 	}
 	if err != nil {
-		return handleReadError(d, "L2VPNSession", id, err)
+		return diag.FromErr(handleReadError(d, "L2VPNSession", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -244,7 +246,7 @@ func resourceNsxtPolicyL2VPNSessionRead(d *schema.ResourceData, m interface{}) e
 	if len(obj.TransportTunnels) > 0 {
 		d.Set("transport_tunnels", obj.TransportTunnels)
 	}
-	if nsxVersionHigherOrEqual("3.2.0") {
+	if nsxVersionHigherOrEqual(m, "3.2.0") {
 		if obj.TcpMssClamping != nil {
 			direction := obj.TcpMssClamping.Direction
 			mss := obj.TcpMssClamping.MaxSegmentSize
@@ -296,18 +298,18 @@ func resourceNsxtPolicyL2VpnSessionExists(isT0 bool, gwID string, localeServiceI
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	transportTunnel := getStringListFromSchemaList(d, "transport_tunnels")
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VPNSession ID")
+		return diag.Errorf("Error obtaining L2VPNSession ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -315,7 +317,7 @@ func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{})
 	displayName := d.Get("display_name").(string)
 	revision := int64(d.Get("revision").(int))
 	enabled := d.Get("enabled").(bool)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	obj := model.L2VPNSession{
 		DisplayName:      &displayName,
 		Description:      &description,
@@ -324,7 +326,7 @@ func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{})
 		Revision:         &revision,
 		Enabled:          &enabled,
 	}
-	if nsxVersionHigherOrEqual("3.2.0") {
+	if nsxVersionHigherOrEqual(m, "3.2.0") {
 		direction := d.Get("direction").(string)
 		maxSegmentSize := int64(d.Get("max_segment_size").(int))
 		if direction != "" {
@@ -367,23 +369,23 @@ func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	if err != nil {
-		return handleUpdateError("L2VPNSession", id, err)
+		return diag.FromErr(handleUpdateError("L2VPNSession", id, err))
 	}
 
-	return resourceNsxtPolicyL2VPNSessionRead(d, m)
+	return resourceNsxtPolicyL2VPNSessionRead(ctx, d, m)
 
 }
 
-func resourceNsxtPolicyL2VPNSessionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VPNSession ID")
+		return diag.Errorf("Error obtaining L2VPNSession ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -406,7 +408,7 @@ func resourceNsxtPolicyL2VPNSessionDelete(d *schema.ResourceData, m interface{})
 	}
 
 	if err != nil {
-		return handleDeleteError("L2VPNSession", id, err)
+		return diag.FromErr(handleDeleteError("L2VPNSession", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -23,10 +24,10 @@ var lBClientSslProfileCipherGroupLabelValues = []string{
 
 func resourceNsxtPolicyLBClientSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBClientSslProfileCreate,
-		Read:   resourceNsxtPolicyLBClientSslProfileRead,
-		Update: resourceNsxtPolicyLBClientSslProfileUpdate,
-		Delete: resourceNsxtPolicyLBClientSslProfileDelete,
+		CreateContext: resourceNsxtPolicyLBClientSslProfileCreate,
+		ReadContext:   resourceNsxtPolicyLBClientSslProfileRead,
+		UpdateContext: resourceNsxtPolicyLBClientSslProfileUpdate,
+		DeleteContext: resourceNsxtPolicyLBClientSslProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -95,7 +96,7 @@ func resourceNsxtPolicyLBClientSslProfilePatch(d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	cipherGroupLabel := d.Get("cipher_group_label").(string)
 	ciphers := getStringListFromSchemaSet(d, "ciphers")
 	preferServerCiphers := d.Get("prefer_server_ciphers").(bool)
@@ -121,31 +122,31 @@ func resourceNsxtPolicyLBClientSslProfilePatch(d *schema.ResourceData, m interfa
 	return client.Patch(id, obj)
 }
 
-func resourceNsxtPolicyLBClientSslProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBClientSslProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBClientSslProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = resourceNsxtPolicyLBClientSslProfilePatch(d, m, id)
 	if err != nil {
-		return handleCreateError("LBClientSslProfile", id, err)
+		return diag.FromErr(handleCreateError("LBClientSslProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLBClientSslProfileRead(d, m)
+	return resourceNsxtPolicyLBClientSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBClientSslProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBClientSslProfile ID")
+		return diag.Errorf("Error obtaining LBClientSslProfile ID")
 	}
 
 	var obj model.LBClientSslProfile
@@ -153,12 +154,12 @@ func resourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interfac
 	var err error
 	obj, err = client.Get(id)
 	if err != nil {
-		return handleReadError(d, "LBClientSslProfile", id, err)
+		return diag.FromErr(handleReadError(d, "LBClientSslProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -175,25 +176,25 @@ func resourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyLBClientSslProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBClientSslProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBClientSslProfile ID")
+		return diag.Errorf("Error obtaining LBClientSslProfile ID")
 	}
 
 	err := resourceNsxtPolicyLBClientSslProfilePatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("LBClientSslProfile", id, err)
+		return diag.FromErr(handleUpdateError("LBClientSslProfile", id, err))
 	}
 
-	return resourceNsxtPolicyLBClientSslProfileRead(d, m)
+	return resourceNsxtPolicyLBClientSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBClientSslProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBClientSslProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBClientSslProfile ID")
+		return diag.Errorf("Error obtaining LBClientSslProfile ID")
 	}
 
 	forceParam := true
@@ -203,7 +204,7 @@ func resourceNsxtPolicyLBClientSslProfileDelete(d *schema.ResourceData, m interf
 	err = client.Delete(id, &forceParam)
 
 	if err != nil {
-		return handleDeleteError("LBClientSslProfile", id, err)
+		return diag.FromErr(handleDeleteError("LBClientSslProfile", id, err))
 	}
 
 	return nil
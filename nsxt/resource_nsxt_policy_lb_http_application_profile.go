@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -23,10 +25,10 @@ var lBHttpProfileXForwardedForValues = []string{
 
 func resourceNsxtPolicyLBHttpApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBHttpApplicationProfileCreate,
-		Read:   resourceNsxtPolicyLBHttpApplicationProfileRead,
-		Update: resourceNsxtPolicyLBHttpApplicationProfileUpdate,
-		Delete: resourceNsxtPolicyLBHttpApplicationProfileDelete,
+		CreateContext: resourceNsxtPolicyLBHttpApplicationProfileCreate,
+		ReadContext:   resourceNsxtPolicyLBHttpApplicationProfileRead,
+		UpdateContext: resourceNsxtPolicyLBHttpApplicationProfileUpdate,
+		DeleteContext: resourceNsxtPolicyLBHttpApplicationProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -114,7 +116,7 @@ func resourceNsxtPolicyLBHttpApplicationProfilePatch(d *schema.ResourceData, m i
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	httpRedirectTo := d.Get("http_redirect_to").(string)
 	httpRedirectToHTTPS := d.Get("http_redirect_to_https").(bool)
 	idleTimeout := int64(d.Get("idle_timeout").(int))
@@ -159,49 +161,49 @@ func resourceNsxtPolicyLBHttpApplicationProfilePatch(d *schema.ResourceData, m i
 	return client.Patch(id, dataValue.(*data.StructValue))
 }
 
-func resourceNsxtPolicyLBHttpApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBHttpApplicationProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBHttpApplicationProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = resourceNsxtPolicyLBHttpApplicationProfilePatch(d, m, id)
 	if err != nil {
-		return handleCreateError("LBHttpProfile", id, err)
+		return diag.FromErr(handleCreateError("LBHttpProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLBHttpApplicationProfileRead(d, m)
+	return resourceNsxtPolicyLBHttpApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBHttpApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBHttpApplicationProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	converter := bindings.NewTypeConverter()
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBHttpProfile ID")
+		return diag.Errorf("Error obtaining LBHttpProfile ID")
 	}
 
 	client := infra.NewLbAppProfilesClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "LBHttpProfile", id, err)
+		return diag.FromErr(handleReadError(d, "LBHttpProfile", id, err))
 	}
 
 	baseObj, errs := converter.ConvertToGolang(obj, model.LBHttpProfileBindingType())
 	if len(errs) > 0 {
-		return fmt.Errorf("LBAppProfile with id %s is not of type LBHttpProfile %s", id, errs[0])
+		return diag.Errorf("LBAppProfile with id %s is not of type LBHttpProfile %s", id, errs[0])
 	}
 	lbHTTPProfile := baseObj.(model.LBHttpProfile)
 
 	d.Set("display_name", lbHTTPProfile.DisplayName)
 	d.Set("description", lbHTTPProfile.Description)
-	setPolicyTagsInSchema(d, lbHTTPProfile.Tags)
+	setPolicyTagsInSchema(d, lbHTTPProfile.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", lbHTTPProfile.Path)
 	d.Set("revision", lbHTTPProfile.Revision)
@@ -220,21 +222,21 @@ func resourceNsxtPolicyLBHttpApplicationProfileRead(d *schema.ResourceData, m in
 	return nil
 }
 
-func resourceNsxtPolicyLBHttpApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBHttpApplicationProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBHttpProfile ID")
+		return diag.Errorf("Error obtaining LBHttpProfile ID")
 	}
 
 	err := resourceNsxtPolicyLBHttpApplicationProfilePatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("LBHttpProfile", id, err)
+		return diag.FromErr(handleUpdateError("LBHttpProfile", id, err))
 	}
 
-	return resourceNsxtPolicyLBHttpApplicationProfileRead(d, m)
+	return resourceNsxtPolicyLBHttpApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBHttpApplicationProfileDelete(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicyLBAppProfileDelete(d, m)
+func resourceNsxtPolicyLBHttpApplicationProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(resourceNsxtPolicyLBAppProfileDelete(d, m))
 }
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -32,12 +34,12 @@ var lbPoolSnatTypeValues = []string{
 
 func resourceNsxtPolicyLBPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBPoolCreate,
-		Read:   resourceNsxtPolicyLBPoolRead,
-		Update: resourceNsxtPolicyLBPoolUpdate,
-		Delete: resourceNsxtPolicyLBPoolDelete,
+		CreateContext: resourceNsxtPolicyLBPoolCreate,
+		ReadContext:   resourceNsxtPolicyLBPoolRead,
+		UpdateContext: resourceNsxtPolicyLBPoolUpdate,
+		DeleteContext: resourceNsxtPolicyLBPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,6 +51,12 @@ func resourceNsxtPolicyLBPool() *schema.Resource {
 			"tag":          getTagsSchema(),
 			"member":       getPoolMembersSchema(),
 			"member_group": getPolicyPoolMemberGroupSchema(),
+			"ignore_unmanaged_members": {
+				Type:        schema.TypeBool,
+				Description: "Ignore pool members that are not configured in this resource, such as members managed by standalone pool member resources",
+				Optional:    true,
+				Default:     false,
+			},
 			"active_monitor_paths": {
 				Type:          schema.TypeList,
 				Description:   "Used by the load balancer to initiate new connections to the servers to check their health. Active healthchecks are deactivated by default and can be activated using this setting",
@@ -153,69 +161,137 @@ func getPolicyPoolSnatSchema() *schema.Schema {
 
 }
 
+func getPolicyPoolMemberFromMap(data map[string]interface{}) model.LBPoolMember {
+	displayName := data["display_name"].(string)
+	adminState := data["admin_state"].(string)
+	backupMember := data["backup_member"].(bool)
+	port := data["port"].(string)
+	weight := int64(data["weight"].(int))
+	maxConnections := int64(data["max_concurrent_connections"].(int))
+	address := data["ip_address"].(string)
+	elem := model.LBPoolMember{
+		AdminState:   &adminState,
+		BackupMember: &backupMember,
+		DisplayName:  &displayName,
+		IpAddress:    &address,
+		Weight:       &weight,
+	}
+
+	if maxConnections > 0 {
+		elem.MaxConcurrentConnections = &maxConnections
+	}
+	if port != "" {
+		elem.Port = &port
+	}
+
+	return elem
+}
+
 func getPolicyPoolMembersFromSchema(d *schema.ResourceData) []model.LBPoolMember {
 	members := d.Get("member").([]interface{})
 	var memberList []model.LBPoolMember
 	for _, member := range members {
-		data := member.(map[string]interface{})
-		displayName := data["display_name"].(string)
-		adminState := data["admin_state"].(string)
-		backupMember := data["backup_member"].(bool)
-		port := data["port"].(string)
-		weight := int64(data["weight"].(int))
-		maxConnections := int64(data["max_concurrent_connections"].(int))
-		address := data["ip_address"].(string)
-		elem := model.LBPoolMember{
-			AdminState:   &adminState,
-			BackupMember: &backupMember,
-			DisplayName:  &displayName,
-			IpAddress:    &address,
-			Weight:       &weight,
-		}
+		memberList = append(memberList, getPolicyPoolMemberFromMap(member.(map[string]interface{})))
+	}
 
-		if maxConnections > 0 {
-			elem.MaxConcurrentConnections = &maxConnections
-		}
-		if port != "" {
-			elem.Port = &port
-		}
+	return memberList
+}
 
-		memberList = append(memberList, elem)
+func getPolicyPoolMemberMap(member model.LBPoolMember) map[string]interface{} {
+	elem := make(map[string]interface{})
+	if member.DisplayName != nil {
+		elem["display_name"] = *member.DisplayName
+	}
+	if member.AdminState != nil {
+		elem["admin_state"] = *member.AdminState
+	}
+	if member.BackupMember != nil {
+		elem["backup_member"] = *member.BackupMember
+	}
+	elem["ip_address"] = member.IpAddress
+	if member.MaxConcurrentConnections != nil {
+		elem["max_concurrent_connections"] = *member.MaxConcurrentConnections
+	}
+	if member.Port != nil {
+		elem["port"] = *member.Port
+	}
+	if member.Weight != nil {
+		elem["weight"] = *member.Weight
 	}
 
-	return memberList
+	return elem
+}
+
+// Pool member is identified by IP address and port
+func getPolicyPoolMemberKey(ipAddress string, port string) string {
+	if port == "" {
+		return ipAddress
+	}
+	return fmt.Sprintf("%s/%s", ipAddress, port)
+}
+
+func getPolicyPoolMemberKeyFromModel(member model.LBPoolMember) string {
+	ipAddress := ""
+	port := ""
+	if member.IpAddress != nil {
+		ipAddress = *member.IpAddress
+	}
+	if member.Port != nil {
+		port = *member.Port
+	}
+	return getPolicyPoolMemberKey(ipAddress, port)
+}
+
+func getPolicyPoolMemberKeysFromList(members []interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	for _, member := range members {
+		data := member.(map[string]interface{})
+		keys[getPolicyPoolMemberKey(data["ip_address"].(string), data["port"].(string))] = true
+	}
+	return keys
 }
 
 func setPolicyPoolMembersInSchema(d *schema.ResourceData, members []model.LBPoolMember) error {
 	var membersList []map[string]interface{}
+	var managedKeys map[string]bool
+	if d.Get("ignore_unmanaged_members").(bool) {
+		// Members not configured in this resource are expected to be
+		// managed by standalone pool member resources
+		managedKeys = getPolicyPoolMemberKeysFromList(d.Get("member").([]interface{}))
+	}
 	for _, member := range members {
-		elem := make(map[string]interface{})
-		if member.DisplayName != nil {
-			elem["display_name"] = *member.DisplayName
-		}
-		if member.AdminState != nil {
-			elem["admin_state"] = *member.AdminState
-		}
-		if member.BackupMember != nil {
-			elem["backup_member"] = *member.BackupMember
-		}
-		elem["ip_address"] = member.IpAddress
-		if member.MaxConcurrentConnections != nil {
-			elem["max_concurrent_connections"] = *member.MaxConcurrentConnections
-		}
-		if member.Port != nil {
-			elem["port"] = *member.Port
+		if managedKeys != nil && !managedKeys[getPolicyPoolMemberKeyFromModel(member)] {
+			continue
 		}
-		if member.Weight != nil {
-			elem["weight"] = *member.Weight
-		}
-
-		membersList = append(membersList, elem)
+		membersList = append(membersList, getPolicyPoolMemberMap(member))
 	}
 	err := d.Set("member", membersList)
 	return err
 }
 
+// Applies modification to current pool configuration and updates the pool. In case
+// the pool was modified concurrently, the modification is applied again on top of the
+// fresh configuration, so that concurrent changes to the pool are preserved.
+func updatePolicyLBPoolConfig(m interface{}, id string, modify func(pool *model.LBPool) error) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewLbPoolsClient(connector)
+
+	doUpdate := func() error {
+		pool, err := client.Get(id)
+		if err != nil {
+			return err
+		}
+		if err := modify(&pool); err != nil {
+			return err
+		}
+		_, err = client.Update(id, pool)
+		return err
+	}
+
+	commonProviderConfig := getCommonProviderConfig(m)
+	return retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+}
+
 func getPolicyPoolMemberGroupFromSchema(d *schema.ResourceData) *model.LBPoolMemberGroup {
 	members := d.Get("member_group").([]interface{})
 	for _, member := range members {
@@ -422,23 +498,23 @@ func resourceNsxtPolicyLBPoolExists(id string, connector client.Connector, isGlo
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPoolExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	activeMonitorPaths := interfaceListToStringList(d.Get("active_monitor_paths").([]interface{}))
 	if activeMonitorPaths == nil && d.Get("active_monitor_path") != "" {
 		activeMonitorPath := d.Get("active_monitor_path").(string)
@@ -451,7 +527,7 @@ func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error
 	passiveMonitorPath := d.Get("passive_monitor_path").(string)
 	snatTranslation, err := getPolicyPoolSnatFromSchema(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tcpMultiplexingEnabled := d.Get("tcp_multiplexing_enabled").(bool)
 	tcpMultiplexingNumber := int64(d.Get("tcp_multiplexing_number").(int))
@@ -474,31 +550,31 @@ func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Creating LBPool with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("LBPool", id, err)
+		return diag.FromErr(handleCreateError("LBPool", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLBPoolRead(d, m)
+	return resourceNsxtPolicyLBPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBPool ID")
+		return diag.Errorf("Error obtaining LBPool ID")
 	}
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "LBPool", id, err)
+		return diag.FromErr(handleReadError(d, "LBPool", id, err))
 	}
 
 	if _, ok := d.GetOk("active_monitor_path"); ok {
@@ -513,27 +589,27 @@ func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 	d.Set("algorithm", obj.Algorithm)
 	err = setPolicyPoolMemberGroupInSchema(d, obj.MemberGroup)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = setPolicyPoolMembersInSchema(d, obj.Members)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	err = setPolicyPoolMemberGroupInSchema(d, obj.MemberGroup)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = setPolicyPoolSnatInSchema(d, obj.SnatTranslation)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if obj.MinActiveMembers != nil {
@@ -548,22 +624,22 @@ func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBPool ID")
+		return diag.Errorf("Error obtaining LBPool ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	activeMonitorPaths := interfaceListToStringList(d.Get("active_monitor_paths").([]interface{}))
 	if activeMonitorPaths == nil && d.Get("active_monitor_path") != "" {
@@ -576,7 +652,7 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 	passiveMonitorPath := d.Get("passive_monitor_path").(string)
 	snatTranslation, err := getPolicyPoolSnatFromSchema(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	tcpMultiplexingEnabled := d.Get("tcp_multiplexing_enabled").(bool)
 	tcpMultiplexingNumber := int64(d.Get("tcp_multiplexing_number").(int))
@@ -599,30 +675,50 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 		Revision:               &revision,
 	}
 
-	_, err = client.Update(id, obj)
+	if d.Get("ignore_unmanaged_members").(bool) {
+		oldMembers, newMembers := d.GetChange("member")
+		managedKeys := getPolicyPoolMemberKeysFromList(oldMembers.([]interface{}))
+		for key := range getPolicyPoolMemberKeysFromList(newMembers.([]interface{})) {
+			managedKeys[key] = true
+		}
+		err = updatePolicyLBPoolConfig(m, id, func(pool *model.LBPool) error {
+			// Preserve members managed outside of this resource
+			obj.Members = members
+			for _, member := range pool.Members {
+				if !managedKeys[getPolicyPoolMemberKeyFromModel(member)] {
+					obj.Members = append(obj.Members, member)
+				}
+			}
+			obj.Revision = pool.Revision
+			*pool = obj
+			return nil
+		})
+	} else {
+		_, err = client.Update(id, obj)
+	}
 	if err != nil {
-		return handleUpdateError("LBPool", id, err)
+		return diag.FromErr(handleUpdateError("LBPool", id, err))
 	}
 
-	return resourceNsxtPolicyLBPoolRead(d, m)
+	return resourceNsxtPolicyLBPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBPool ID")
+		return diag.Errorf("Error obtaining LBPool ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	force := true
 	err := client.Delete(id, &force)
 	if err != nil {
-		return handleDeleteError("LBPool", id, err)
+		return diag.FromErr(handleDeleteError("LBPool", id, err))
 	}
 
 	return nil
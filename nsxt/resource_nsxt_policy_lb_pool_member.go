@@ -0,0 +1,197 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBPoolMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyLBPoolMemberCreate,
+		ReadContext:   resourceNsxtPolicyLBPoolMemberRead,
+		UpdateContext: resourceNsxtPolicyLBPoolMemberUpdate,
+		DeleteContext: resourceNsxtPolicyLBPoolMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyLBPoolMemberImporter,
+		},
+
+		Schema: getPolicyLBPoolMemberSchema(),
+	}
+}
+
+func getPolicyLBPoolMemberSchema() map[string]*schema.Schema {
+	memberSchema := getPoolMembersSchema().Elem.(*schema.Resource).Schema
+	// Member is identified by IP address and port
+	memberSchema["ip_address"].ForceNew = true
+	memberSchema["port"].ForceNew = true
+	memberSchema["pool_path"] = getPolicyPathSchema(true, true, "Policy path of the load balancer pool")
+	return memberSchema
+}
+
+func getPolicyLBPoolIDFromPath(poolPath string) (string, error) {
+	poolID := getResourceIDFromResourcePath(poolPath, "lb-pools")
+	if poolID == "" {
+		return "", fmt.Errorf("invalid load balancer pool path %s", poolPath)
+	}
+	return poolID, nil
+}
+
+func getPolicyLBPoolMemberData(d *schema.ResourceData) map[string]interface{} {
+	data := make(map[string]interface{})
+	for key := range getPoolMembersSchema().Elem.(*schema.Resource).Schema {
+		data[key] = d.Get(key)
+	}
+	return data
+}
+
+func findPolicyLBPoolMember(members []model.LBPoolMember, key string) int {
+	for i, member := range members {
+		if getPolicyPoolMemberKeyFromModel(member) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func resourceNsxtPolicyLBPoolMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	poolPath := d.Get("pool_path").(string)
+	poolID, err := getPolicyLBPoolIDFromPath(poolPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member := getPolicyPoolMemberFromMap(getPolicyLBPoolMemberData(d))
+	key := getPolicyPoolMemberKeyFromModel(member)
+
+	log.Printf("[INFO] Adding member %s to LBPool %s", key, poolID)
+	err = updatePolicyLBPoolConfig(m, poolID, func(pool *model.LBPool) error {
+		if findPolicyLBPoolMember(pool.Members, key) >= 0 {
+			return fmt.Errorf("member %s already exists in pool %s", key, poolPath)
+		}
+		pool.Members = append(pool.Members, member)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(handleCreateError("LBPool Member", key, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", poolPath, key))
+
+	return resourceNsxtPolicyLBPoolMemberRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyLBPoolMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	client := infra.NewLbPoolsClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining LBPool Member ID")
+	}
+	poolID, err := getPolicyLBPoolIDFromPath(d.Get("pool_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pool, err := client.Get(poolID)
+	if err != nil {
+		return diag.FromErr(handleReadError(d, "LBPool Member", id, err))
+	}
+
+	key := getPolicyPoolMemberKey(d.Get("ip_address").(string), d.Get("port").(string))
+	index := findPolicyLBPoolMember(pool.Members, key)
+	if index < 0 {
+		log.Printf("[DEBUG] Member %s not found in LBPool %s", key, poolID)
+		d.SetId("")
+		return nil
+	}
+
+	for attr, value := range getPolicyPoolMemberMap(pool.Members[index]) {
+		d.Set(attr, value)
+	}
+	if pool.Members[index].MaxConcurrentConnections == nil {
+		d.Set("max_concurrent_connections", 0)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyLBPoolMemberUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	poolID, err := getPolicyLBPoolIDFromPath(d.Get("pool_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member := getPolicyPoolMemberFromMap(getPolicyLBPoolMemberData(d))
+	key := getPolicyPoolMemberKeyFromModel(member)
+
+	err = updatePolicyLBPoolConfig(m, poolID, func(pool *model.LBPool) error {
+		index := findPolicyLBPoolMember(pool.Members, key)
+		if index < 0 {
+			return fmt.Errorf("member %s not found in pool %s", key, poolID)
+		}
+		pool.Members[index] = member
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(handleUpdateError("LBPool Member", id, err))
+	}
+
+	return resourceNsxtPolicyLBPoolMemberRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyLBPoolMemberDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	poolID, err := getPolicyLBPoolIDFromPath(d.Get("pool_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key := getPolicyPoolMemberKey(d.Get("ip_address").(string), d.Get("port").(string))
+	err = updatePolicyLBPoolConfig(m, poolID, func(pool *model.LBPool) error {
+		index := findPolicyLBPoolMember(pool.Members, key)
+		if index >= 0 {
+			pool.Members = append(pool.Members[:index], pool.Members[index+1:]...)
+		}
+		return nil
+	})
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(handleDeleteError("LBPool Member", id, err))
+	}
+
+	return nil
+}
+
+// Import ID is expected in format <pool path>/<ip address>[/<port>]
+func nsxtPolicyLBPoolMemberImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	poolIndex := strings.Index(importID, "/lb-pools/")
+	if poolIndex < 0 {
+		return nil, fmt.Errorf("expected import ID in format <pool path>/<ip address>[/<port>], got %s", importID)
+	}
+	segs := strings.Split(importID[poolIndex+len("/lb-pools/"):], "/")
+	if len(segs) < 2 || len(segs) > 3 || segs[0] == "" || segs[1] == "" {
+		return nil, fmt.Errorf("expected import ID in format <pool path>/<ip address>[/<port>], got %s", importID)
+	}
+	poolPath := importID[:poolIndex] + "/lb-pools/" + segs[0]
+	port := ""
+	if len(segs) == 3 {
+		port = segs[2]
+	}
+	d.Set("pool_path", poolPath)
+	d.Set("ip_address", segs[1])
+	d.Set("port", port)
+	d.SetId(fmt.Sprintf("%s/%s", poolPath, getPolicyPoolMemberKey(segs[1], port)))
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,242 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestAccResourceNsxtPolicyLBPoolMember_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_pool_member.test"
+	poolResourceName := "nsxt_policy_lb_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPoolMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolStandaloneMemberTemplate(5, "ENABLED", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPoolMemberExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "1.1.1.2"),
+					resource.TestCheckResourceAttr(testResourceName, "port", "80"),
+					resource.TestCheckResourceAttr(testResourceName, "weight", "5"),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "ENABLED"),
+					resource.TestCheckResourceAttr(testResourceName, "backup_member", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "max_concurrent_connections", "10"),
+					resource.TestCheckResourceAttrPair(testResourceName, "pool_path", poolResourceName, "path"),
+					resource.TestCheckResourceAttr(poolResourceName, "member.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBPoolStandaloneMemberTemplate(10, "DISABLED", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPoolMemberExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "weight", "10"),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "DISABLED"),
+					resource.TestCheckResourceAttr(testResourceName, "backup_member", "true"),
+					resource.TestCheckResourceAttr(poolResourceName, "member.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBPoolMember_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_pool_member.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPoolMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolStandaloneMemberTemplate(5, "ENABLED", false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBPoolMemberFind(state *terraform.State, resourceName string) (bool, error) {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	nsxClient := infra.NewLbPoolsClient(connector)
+
+	rs, ok := state.RootModule().Resources[resourceName]
+	if !ok {
+		return false, fmt.Errorf("Policy LBPool Member resource %s not found in resources", resourceName)
+	}
+
+	poolID, err := getPolicyLBPoolIDFromPath(rs.Primary.Attributes["pool_path"])
+	if err != nil {
+		return false, err
+	}
+	pool, err := nsxClient.Get(poolID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	key := getPolicyPoolMemberKey(rs.Primary.Attributes["ip_address"], rs.Primary.Attributes["port"])
+	return findPolicyLBPoolMember(pool.Members, key) >= 0, nil
+}
+
+func testAccNsxtPolicyLBPoolMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		exists, err := testAccNsxtPolicyLBPoolMemberFind(state, resourceName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LBPool Member %s not found in pool", resourceName)
+		}
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBPoolMemberCheckDestroy(state *terraform.State) error {
+	for name, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_lb_pool_member" {
+			continue
+		}
+
+		exists, err := testAccNsxtPolicyLBPoolMemberFind(state, name)
+		if err == nil && exists {
+			return fmt.Errorf("Policy LBPool Member %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBPoolStandaloneMemberTemplate(weight int, adminState string, backup bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_pool" "test" {
+  display_name             = "terraform-lb-pool-member-test"
+  algorithm                = "WEIGHTED_ROUND_ROBIN"
+  ignore_unmanaged_members = true
+
+  member {
+    ip_address = "1.1.1.1"
+    port       = "80"
+  }
+}
+
+resource "nsxt_policy_lb_pool_member" "test" {
+  pool_path                  = nsxt_policy_lb_pool.test.path
+  ip_address                 = "1.1.1.2"
+  port                       = "80"
+  weight                     = %d
+  admin_state                = "%s"
+  backup_member              = %t
+  max_concurrent_connections = 10
+}`, weight, adminState, backup)
+}
+
+func TestMockNsxPolicyLBPoolMember(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	poolResource := resourceNsxtPolicyLBPool()
+	pool := schema.TestResourceDataRaw(t, poolResource.Schema, map[string]interface{}{
+		"display_name":             "mock-pool",
+		"ignore_unmanaged_members": true,
+		"member": []interface{}{
+			map[string]interface{}{"ip_address": "10.0.0.1", "port": "80"},
+		},
+	})
+	if diags := poolResource.CreateContext(context.Background(), pool, m); diags.HasError() {
+		t.Fatalf("Pool create failed: %v", diags)
+	}
+	poolPath := pool.Get("path").(string)
+
+	memberResource := resourceNsxtPolicyLBPoolMember()
+	member := schema.TestResourceDataRaw(t, memberResource.Schema, map[string]interface{}{
+		"pool_path":  poolPath,
+		"ip_address": "10.0.0.2",
+		"port":       "80",
+		"weight":     5,
+	})
+	if diags := memberResource.CreateContext(context.Background(), member, m); diags.HasError() {
+		t.Fatalf("Member create failed: %v", diags)
+	}
+	if member.Id() != poolPath+"/10.0.0.2/80" {
+		t.Fatalf("Unexpected member ID %s", member.Id())
+	}
+
+	member.Set("weight", 10)
+	member.Set("admin_state", "GRACEFUL_DISABLED")
+	if diags := memberResource.UpdateContext(context.Background(), member, m); diags.HasError() {
+		t.Fatalf("Member update failed: %v", diags)
+	}
+	if member.Get("weight").(int) != 10 || member.Get("admin_state").(string) != "GRACEFUL_DISABLED" {
+		t.Fatalf("Member was not updated: weight %v, admin state %v", member.Get("weight"), member.Get("admin_state"))
+	}
+
+	// Pool is modified concurrently during member update
+	attempts := 0
+	err := updatePolicyLBPoolConfig(m, pool.Id(), func(obj *model.LBPool) error {
+		attempts++
+		if attempts == 1 {
+			server.lock.Lock()
+			data := server.objects[poolPath].data
+			members := append(data["members"].([]interface{}), map[string]interface{}{"ip_address": "10.0.0.3"})
+			data["members"] = members
+			server.storeObject(poolPath, data)
+			server.lock.Unlock()
+		}
+		address := "10.0.0.4"
+		obj.Members = append(obj.Members, model.LBPoolMember{IpAddress: &address})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Pool update failed: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected modification to be re-applied once, got %d attempts", attempts)
+	}
+
+	pool.Set("description", "updated")
+	if diags := poolResource.UpdateContext(context.Background(), pool, m); diags.HasError() {
+		t.Fatalf("Pool update failed: %v", diags)
+	}
+	if members := pool.Get("member").([]interface{}); len(members) != 1 {
+		t.Fatalf("Expected only configured member in pool, got %v", members)
+	}
+	server.lock.Lock()
+	members := server.objects[poolPath].data["members"].([]interface{})
+	server.lock.Unlock()
+	if len(members) != 4 {
+		t.Fatalf("Expected 4 members in pool after update, got %v", members)
+	}
+
+	if diags := memberResource.DeleteContext(context.Background(), member, m); diags.HasError() {
+		t.Fatalf("Member delete failed: %v", diags)
+	}
+	if diags := memberResource.ReadContext(context.Background(), member, m); diags.HasError() {
+		t.Fatalf("Member read after delete failed: %v", diags)
+	}
+	if member.Id() != "" {
+		t.Fatalf("Member still exists after delete")
+	}
+}
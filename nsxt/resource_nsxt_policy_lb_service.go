@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -34,12 +35,12 @@ var lBServiceSizeValues = []string{
 
 func resourceNsxtPolicyLBService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBServiceCreate,
-		Read:   resourceNsxtPolicyLBServiceRead,
-		Update: resourceNsxtPolicyLBServiceUpdate,
-		Delete: resourceNsxtPolicyLBServiceDelete,
+		CreateContext: resourceNsxtPolicyLBServiceCreate,
+		ReadContext:   resourceNsxtPolicyLBServiceRead,
+		UpdateContext: resourceNsxtPolicyLBServiceUpdate,
+		DeleteContext: resourceNsxtPolicyLBServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,29 +90,29 @@ func resourceNsxtPolicyLBServiceExists(id string, connector client.Connector, is
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyLBServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbServicesClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBServiceExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	connectivityPath := d.Get("connectivity_path").(string)
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
 	size := d.Get("size").(string)
-	if size == "XLARGE" && nsxVersionLower("3.0.0") {
-		return fmt.Errorf("XLARGE size is not supported before NSX version 3.0.0")
+	if size == "XLARGE" && nsxVersionLower(m, "3.0.0") {
+		return diag.Errorf("XLARGE size is not supported before NSX version 3.0.0")
 	}
 
 	obj := model.LBService{
@@ -128,36 +129,36 @@ func resourceNsxtPolicyLBServiceCreate(d *schema.ResourceData, m interface{}) er
 	log.Printf("[INFO] Creating LBService with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("LBService", id, err)
+		return diag.FromErr(handleCreateError("LBService", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLBServiceRead(d, m)
+	return resourceNsxtPolicyLBServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbServicesClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBService ID")
+		return diag.Errorf("Error obtaining LBService ID")
 	}
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "LBService", id, err)
+		return diag.FromErr(handleReadError(d, "LBService", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -170,29 +171,29 @@ func resourceNsxtPolicyLBServiceRead(d *schema.ResourceData, m interface{}) erro
 	return nil
 }
 
-func resourceNsxtPolicyLBServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbServicesClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBService ID")
+		return diag.Errorf("Error obtaining LBService ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	connectivityPath := d.Get("connectivity_path").(string)
 	enabled := d.Get("enabled").(bool)
 	errorLogLevel := d.Get("error_log_level").(string)
 	size := d.Get("size").(string)
-	if size == "XLARGE" && nsxVersionLower("3.0.0") {
-		return fmt.Errorf("XLARGE size is not supported before NSX version 3.0.0")
+	if size == "XLARGE" && nsxVersionLower(m, "3.0.0") {
+		return diag.Errorf("XLARGE size is not supported before NSX version 3.0.0")
 	}
 
 	obj := model.LBService{
@@ -208,28 +209,28 @@ func resourceNsxtPolicyLBServiceUpdate(d *schema.ResourceData, m interface{}) er
 	// Update the resource using PATCH
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("LBService", id, err)
+		return diag.FromErr(handleUpdateError("LBService", id, err))
 	}
 
-	return resourceNsxtPolicyLBServiceRead(d, m)
+	return resourceNsxtPolicyLBServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBService ID")
+		return diag.Errorf("Error obtaining LBService ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewLbServicesClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	force := true
 	err := client.Delete(id, &force)
 	if err != nil {
-		return handleDeleteError("LBService", id, err)
+		return diag.FromErr(handleDeleteError("LBService", id, err))
 	}
 
 	return nil
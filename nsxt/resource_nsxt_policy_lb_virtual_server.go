@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -135,12 +136,12 @@ var lbSslModeSelectionActionValues = []string{
 
 func resourceNsxtPolicyLBVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBVirtualServerCreate,
-		Read:   resourceNsxtPolicyLBVirtualServerRead,
-		Update: resourceNsxtPolicyLBVirtualServerUpdate,
-		Delete: resourceNsxtPolicyLBVirtualServerDelete,
+		CreateContext: resourceNsxtPolicyLBVirtualServerCreate,
+		ReadContext:   resourceNsxtPolicyLBVirtualServerRead,
+		UpdateContext: resourceNsxtPolicyLBVirtualServerUpdate,
+		DeleteContext: resourceNsxtPolicyLBVirtualServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1552,8 +1553,8 @@ func getPolicyLbRulesFromSchema(d *schema.ResourceData) []model.LBRule {
 	return ruleList
 }
 
-func policyLBVirtualServerVersionDependantSet(d *schema.ResourceData, obj *model.LBVirtualServer) {
-	if nsxVersionHigherOrEqual("3.0.0") {
+func policyLBVirtualServerVersionDependantSet(d *schema.ResourceData, obj *model.LBVirtualServer, m interface{}) {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		logSignificantOnly := d.Get("log_significant_event_only").(bool)
 		obj.LogSignificantEventOnly = &logSignificantOnly
 		obj.AccessListControl = getPolicyAccessListControlFromSchema(d)
@@ -1575,23 +1576,23 @@ func resourceNsxtPolicyLBVirtualServerExists(id string, connector client.Connect
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBVirtualServerExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	applicationProfilePath := d.Get("application_profile_path").(string)
 	clientSSLProfileBinding := getPolicyClientSSLBindingFromSchema(d)
@@ -1627,7 +1628,7 @@ func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface
 		Rules:                    rules,
 	}
 
-	policyLBVirtualServerVersionDependantSet(d, &obj)
+	policyLBVirtualServerVersionDependantSet(d, &obj, m)
 
 	if maxNewConnectionRate > 0 {
 		obj.MaxNewConnectionRate = &maxNewConnectionRate
@@ -1641,36 +1642,36 @@ func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface
 	log.Printf("[INFO] Creating LBVirtualServer with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("LBVirtualServer", id, err)
+		return diag.FromErr(handleCreateError("LBVirtualServer", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLBVirtualServerRead(d, m)
+	return resourceNsxtPolicyLBVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBVirtualServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBVirtualServer ID")
+		return diag.Errorf("Error obtaining LBVirtualServer ID")
 	}
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "LBVirtualServer", id, err)
+		return diag.FromErr(handleReadError(d, "LBVirtualServer", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -1711,22 +1712,22 @@ func resourceNsxtPolicyLBVirtualServerRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBVirtualServer ID")
+		return diag.Errorf("Error obtaining LBVirtualServer ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	accessLogEnabled := d.Get("access_log_enabled").(bool)
 	clientSSLProfileBinding := getPolicyClientSSLBindingFromSchema(d)
@@ -1763,7 +1764,7 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 		Rules:                    rules,
 	}
 
-	policyLBVirtualServerVersionDependantSet(d, &obj)
+	policyLBVirtualServerVersionDependantSet(d, &obj, m)
 
 	/*
 		This needs some explanation: we introduced the "rule" attribute in a later version, but we don't want
@@ -1784,7 +1785,7 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 		log.Printf("[INFO] No changes detected in rule section")
 		existingObj, err := client.Get(id)
 		if err != nil {
-			return handleUpdateError("LBVirtualServer", id, err)
+			return diag.FromErr(handleUpdateError("LBVirtualServer", id, err))
 		}
 
 		if len(existingObj.Rules) > 0 {
@@ -1805,28 +1806,28 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 	// Update the resource using PATCH
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("LBVirtualServer", id, err)
+		return diag.FromErr(handleUpdateError("LBVirtualServer", id, err))
 	}
 
-	return resourceNsxtPolicyLBVirtualServerRead(d, m)
+	return resourceNsxtPolicyLBVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBVirtualServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBVirtualServer ID")
+		return diag.Errorf("Error obtaining LBVirtualServer ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	force := true
 	err := client.Delete(id, &force)
 	if err != nil {
-		return handleDeleteError("LBVirtualServer", id, err)
+		return diag.FromErr(handleDeleteError("LBVirtualServer", id, err))
 	}
 
 	return nil
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -26,12 +28,12 @@ var ldapServerTypes = []string{activeDirectoryType, openLdapType}
 
 func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLdapIdentitySourceCreate,
-		Read:   resourceNsxtPolicyLdapIdentitySourceRead,
-		Update: resourceNsxtPolicyLdapIdentitySourceUpdate,
-		Delete: resourceNsxtPolicyLdapIdentitySourceDelete,
+		CreateContext: resourceNsxtPolicyLdapIdentitySourceCreate,
+		ReadContext:   resourceNsxtPolicyLdapIdentitySourceRead,
+		UpdateContext: resourceNsxtPolicyLdapIdentitySourceUpdate,
+		DeleteContext: resourceNsxtPolicyLdapIdentitySourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyAPIPathResourceImporter("/aaa/ldap-identity-sources"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -199,7 +201,7 @@ func resourceNsxtPolicyLdapIdentitySourceProbeAndUpdate(d *schema.ResourceData,
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	domainName := d.Get("domain_name").(string)
 	baseDn := d.Get("base_dn").(string)
 	altDomainNames := getStringListFromSchemaList(d, "alternative_domain_names")
@@ -265,40 +267,40 @@ func resourceNsxtPolicyLdapIdentitySourceProbeAndUpdate(d *schema.ResourceData,
 	return nil
 }
 
-func resourceNsxtPolicyLdapIdentitySourceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLdapIdentitySourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLdapIdentitySourceExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := resourceNsxtPolicyLdapIdentitySourceProbeAndUpdate(d, m, id); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLdapIdentitySourceRead(d, m)
+	return resourceNsxtPolicyLdapIdentitySourceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLdapIdentitySourceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLdapIdentitySourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	converter := bindings.NewTypeConverter()
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining LDAPIdentitySource ID")
+		return diag.Errorf("error obtaining LDAPIdentitySource ID")
 	}
 
 	ldapClient := aaa.NewLdapIdentitySourcesClient(connector)
 	structObj, err := ldapClient.Get(id)
 	if err != nil {
-		return handleReadError(d, "LDAPIdentitySource", id, err)
+		return diag.FromErr(handleReadError(d, "LDAPIdentitySource", id, err))
 	}
 
 	obj, errs := converter.ConvertToGolang(structObj, nsxModel.LdapIdentitySourceBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 
 	ldapObj := obj.(nsxModel.LdapIdentitySource)
@@ -309,7 +311,7 @@ func resourceNsxtPolicyLdapIdentitySourceRead(d *schema.ResourceData, m interfac
 	} else if resourceType == nsxModel.LdapIdentitySource_RESOURCE_TYPE_OPENLDAPIDENTITYSOURCE {
 		dServerType = openLdapType
 	} else {
-		return fmt.Errorf("unrecognized LdapIdentitySource Resource Type %s", resourceType)
+		return diag.Errorf("unrecognized LdapIdentitySource Resource Type %s", resourceType)
 	}
 
 	passwordMap := getLdapServerPasswordMap(d)
@@ -318,7 +320,7 @@ func resourceNsxtPolicyLdapIdentitySourceRead(d *schema.ResourceData, m interfac
 	d.Set("display_name", ldapObj.DisplayName)
 	d.Set("description", ldapObj.Description)
 	d.Set("revision", ldapObj.Revision)
-	setPolicyTagsInSchema(d, ldapObj.Tags)
+	setPolicyTagsInSchema(d, ldapObj.Tags, m)
 	d.Set("type", dServerType)
 	d.Set("domain_name", ldapObj.DomainName)
 	d.Set("base_dn", ldapObj.BaseDn)
@@ -327,29 +329,29 @@ func resourceNsxtPolicyLdapIdentitySourceRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyLdapIdentitySourceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLdapIdentitySourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("rrror obtaining LDAPIdentitySource ID")
+		return diag.Errorf("rrror obtaining LDAPIdentitySource ID")
 	}
 
 	if err := resourceNsxtPolicyLdapIdentitySourceProbeAndUpdate(d, m, id); err != nil {
-		return handleUpdateError("LDAPIdentitySource", id, err)
+		return diag.FromErr(handleUpdateError("LDAPIdentitySource", id, err))
 	}
 
-	return resourceNsxtPolicyLdapIdentitySourceRead(d, m)
+	return resourceNsxtPolicyLdapIdentitySourceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLdapIdentitySourceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLdapIdentitySourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining LDAPIdentitySource ID")
+		return diag.Errorf("error obtaining LDAPIdentitySource ID")
 	}
 
 	connector := getPolicyConnector(m)
 	ldapClient := aaa.NewLdapIdentitySourcesClient(connector)
 	if err := ldapClient.Delete(id); err != nil {
-		return handleDeleteError("LDAPIdentitySource", id, err)
+		return diag.FromErr(handleDeleteError("LDAPIdentitySource", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -23,10 +24,10 @@ var macDiscoveryProfileMacLimitPolicyValues = []string{
 
 func resourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyMacDiscoveryProfileCreate,
-		Read:   resourceNsxtPolicyMacDiscoveryProfileRead,
-		Update: resourceNsxtPolicyMacDiscoveryProfileUpdate,
-		Delete: resourceNsxtPolicyMacDiscoveryProfileDelete,
+		CreateContext: resourceNsxtPolicyMacDiscoveryProfileCreate,
+		ReadContext:   resourceNsxtPolicyMacDiscoveryProfileRead,
+		UpdateContext: resourceNsxtPolicyMacDiscoveryProfileUpdate,
+		DeleteContext: resourceNsxtPolicyMacDiscoveryProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -89,18 +90,18 @@ func resourceNsxtPolicyMacDiscoveryProfileExists(sessionContext utl.SessionConte
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyMacDiscoveryProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	macChangeEnabled := d.Get("mac_change_enabled").(bool)
 	macLearningEnabled := d.Get("mac_learning_enabled").(bool)
 	macLimit := int64(d.Get("mac_limit").(int))
@@ -126,32 +127,32 @@ func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m inter
 	client := infra.NewMacDiscoveryProfilesClient(getSessionContext(d, m), connector)
 	err = client.Patch(id, obj, &boolFalse)
 	if err != nil {
-		return handleCreateError("MacDiscoveryProfile", id, err)
+		return diag.FromErr(handleCreateError("MacDiscoveryProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyMacDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining MacDiscoveryProfile ID")
 	}
 
 	client := infra.NewMacDiscoveryProfilesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "MacDiscoveryProfile", id, err)
+		return diag.FromErr(handleReadError(d, "MacDiscoveryProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -166,18 +167,18 @@ func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining MacDiscoveryProfile ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	macChangeEnabled := d.Get("mac_change_enabled").(bool)
 	macLearningEnabled := d.Get("mac_learning_enabled").(bool)
@@ -205,16 +206,16 @@ func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m inter
 	client := infra.NewMacDiscoveryProfilesClient(getSessionContext(d, m), connector)
 	_, err := client.Update(id, obj, &boolFalse)
 	if err != nil {
-		return handleUpdateError("MacDiscoveryProfile", id, err)
+		return diag.FromErr(handleUpdateError("MacDiscoveryProfile", id, err))
 	}
 
-	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyMacDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining MacDiscoveryProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -223,7 +224,7 @@ func resourceNsxtPolicyMacDiscoveryProfileDelete(d *schema.ResourceData, m inter
 	err := client.Delete(id, &boolFalse)
 
 	if err != nil {
-		return handleDeleteError("MacDiscoveryProfile", id, err)
+		return diag.FromErr(handleDeleteError("MacDiscoveryProfile", id, err))
 	}
 
 	return nil
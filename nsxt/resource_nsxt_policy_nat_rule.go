@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -36,10 +38,10 @@ var policyNATRuleFirewallMatchTypeValues = []string{
 
 func resourceNsxtPolicyNATRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyNATRuleCreate,
-		Read:   resourceNsxtPolicyNATRuleRead,
-		Update: resourceNsxtPolicyNATRuleUpdate,
-		Delete: resourceNsxtPolicyNATRuleDelete,
+		CreateContext: resourceNsxtPolicyNATRuleCreate,
+		ReadContext:   resourceNsxtPolicyNATRuleRead,
+		UpdateContext: resourceNsxtPolicyNATRuleUpdate,
+		DeleteContext: resourceNsxtPolicyNATRuleDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyNATRuleImport,
 		},
@@ -144,27 +146,27 @@ func deleteNsxtPolicyNATRule(sessionContext utl.SessionContext, connector client
 	return client.Delete(gwID, natType, ruleID)
 }
 
-func resourceNsxtPolicyNATRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining NAT Rule ID")
+		return diag.Errorf("Error obtaining NAT Rule ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	action := d.Get("action").(string)
 	natType := getNatTypeByAction(action)
 	err := deleteNsxtPolicyNATRule(context, getPolicyConnector(m), gwID, isT0, natType, id)
 	if err != nil {
-		return handleDeleteError("NAT Rule", id, err)
+		return diag.FromErr(handleDeleteError("NAT Rule", id, err))
 	}
 
 	return nil
@@ -214,35 +216,35 @@ func getTranslatedNetworks(rule model.PolicyNatRule) (*string, error) {
 	return tNets, nil
 }
 
-func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining NAT Rule ID")
+		return diag.Errorf("Error obtaining NAT Rule ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	action := d.Get("action").(string)
 	natType := getNatTypeByAction(action)
 	obj, err := getNsxtPolicyNATRuleByID(context, connector, gwID, isT0, natType, id)
 	if err != nil {
-		return handleReadError(d, "NAT Rule", id, err)
+		return diag.FromErr(handleReadError(d, "NAT Rule", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -269,7 +271,7 @@ func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
@@ -277,12 +279,12 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 	natType := getNatTypeByAction(action)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	id := d.Get("nsx_id").(string)
@@ -291,9 +293,9 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 	} else {
 		_, err := getNsxtPolicyNATRuleByID(context, connector, gwID, isT0, natType, id)
 		if err == nil {
-			return fmt.Errorf("NAT Rule with nsx_id '%s' already exists", id)
+			return diag.Errorf("NAT Rule with nsx_id '%s' already exists", id)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -309,7 +311,7 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 	sNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("source_networks").([]interface{})))
 	tNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("translated_networks").([]interface{})))
 	scope := getStringListFromSchemaSet(d, "scope")
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	ruleStruct := model.PolicyNatRule{
 		Id:                 &id,
@@ -339,31 +341,31 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 
 	err := patchNsxtPolicyNATRule(getSessionContext(d, m), connector, gwID, ruleStruct, isT0)
 	if err != nil {
-		return handleCreateError("NAT Rule", id, err)
+		return diag.FromErr(handleCreateError("NAT Rule", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyNATRuleRead(d, m)
+	return resourceNsxtPolicyNATRuleRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining NAT Rule ID")
+		return diag.Errorf("Error obtaining NAT Rule ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -376,7 +378,7 @@ func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) erro
 	dNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("destination_networks").([]interface{})))
 	sNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("source_networks").([]interface{})))
 	tNets := stringListToCommaSeparatedString(interfaceListToStringList(d.Get("translated_networks").([]interface{})))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	scope := getStringListFromSchemaSet(d, "scope")
 
 	ruleStruct := model.PolicyNatRule{
@@ -408,13 +410,13 @@ func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) erro
 	log.Printf("[INFO] Updating NAT Rule with ID %s", id)
 	err := patchNsxtPolicyNATRule(context, connector, gwID, ruleStruct, isT0)
 	if err != nil {
-		return handleUpdateError("NAT Rule", id, err)
+		return diag.FromErr(handleUpdateError("NAT Rule", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyNATRuleRead(d, m)
+	return resourceNsxtPolicyNATRuleRead(ctx, d, m)
 }
 
 func resourceNsxtPolicyNATRuleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
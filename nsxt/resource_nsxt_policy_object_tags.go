@@ -0,0 +1,235 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyObjectTags() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyObjectTagsCreate,
+		ReadContext:   resourceNsxtPolicyObjectTagsRead,
+		UpdateContext: resourceNsxtPolicyObjectTagsUpdate,
+		DeleteContext: resourceNsxtPolicyObjectTagsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNsxtPolicyObjectTagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_path": getPolicyPathSchema(true, true, "Policy path of the object to be tagged"),
+			"tag":         getTagsSchema(),
+			"owned_tag":   getOwnedTagsSchema(),
+		},
+	}
+}
+
+func getOwnedTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Tags added to the object by this resource, that are removed from the object on destroy",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func containsPolicyTag(tags []model.Tag, tag model.Tag) bool {
+	for _, t := range tags {
+		if *t.Scope == *tag.Scope && *t.Tag == *tag.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Applies tags to the object and removes tags that are no longer managed,
+// keeping tags that are managed elsewhere intact. In case the object was
+// modified concurrently, the change is applied again on fresh object tags.
+// Returns tags that were not present on the object before the update.
+func updatePolicyObjectTags(m interface{}, objectPath string, tags []model.Tag, removeTags []model.Tag) ([]model.Tag, error) {
+	client := newPolicyObjectClient(getPolicyConnector(m))
+	var addedTags []model.Tag
+
+	doUpdate := func() error {
+		obj, err := client.Get(objectPath)
+		if err != nil {
+			return err
+		}
+
+		currentTags := normalizePolicyTags(obj.Tags)
+		addedTags = nil
+		for _, tag := range tags {
+			if !containsPolicyTag(currentTags, tag) {
+				addedTags = append(addedTags, tag)
+			}
+		}
+
+		objTags := make([]model.Tag, 0, len(obj.Tags)+len(tags))
+		for _, tag := range currentTags {
+			if !containsPolicyTag(removeTags, tag) || containsPolicyTag(tags, tag) {
+				objTags = append(objTags, tag)
+			}
+		}
+		for _, tag := range tags {
+			if !containsPolicyTag(objTags, tag) {
+				objTags = append(objTags, tag)
+			}
+		}
+
+		patchObj := model.PolicyResource{
+			ResourceType: obj.ResourceType,
+			Revision:     obj.Revision,
+			Tags:         objTags,
+		}
+		return client.Patch(objectPath, patchObj)
+	}
+
+	commonProviderConfig := getCommonProviderConfig(m)
+	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+	return addedTags, err
+}
+
+// NSX omits empty scope or tag value
+func normalizePolicyTags(tags []model.Tag) []model.Tag {
+	result := make([]model.Tag, 0, len(tags))
+	for _, tag := range tags {
+		scope := ""
+		if tag.Scope != nil {
+			scope = *tag.Scope
+		}
+		value := ""
+		if tag.Tag != nil {
+			value = *tag.Tag
+		}
+		result = append(result, model.Tag{Scope: &scope, Tag: &value})
+	}
+	return result
+}
+
+func resourceNsxtPolicyObjectTagsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	objectPath := d.Get("object_path").(string)
+	tags := getCustomizedPolicyTagsFromSchema(d, "tag")
+
+	log.Printf("[INFO] Applying tags to policy object %s", objectPath)
+	addedTags, err := updatePolicyObjectTags(m, objectPath, tags, nil)
+	if err != nil {
+		return diag.FromErr(handleCreateError("Object Tags", objectPath, err))
+	}
+
+	d.SetId(objectPath)
+	setCustomizedPolicyTagsInSchema(d, addedTags, "owned_tag")
+
+	return resourceNsxtPolicyObjectTagsRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyObjectTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := newPolicyObjectClient(getPolicyConnector(m))
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Object Tags ID")
+	}
+
+	obj, err := client.Get(d.Get("object_path").(string))
+	if err != nil {
+		return diag.FromErr(handleReadError(d, "Object Tags", id, err))
+	}
+
+	// Only tags managed by this resource are reflected in state
+	managedTags := getCustomizedPolicyTagsFromSchema(d, "tag")
+	ownedTags := getCustomizedPolicyTagsFromSchema(d, "owned_tag")
+	var tags []model.Tag
+	var presentOwnedTags []model.Tag
+	for _, tag := range normalizePolicyTags(obj.Tags) {
+		if containsPolicyTag(managedTags, tag) {
+			tags = append(tags, tag)
+		}
+		if containsPolicyTag(ownedTags, tag) {
+			presentOwnedTags = append(presentOwnedTags, tag)
+		}
+	}
+	setCustomizedPolicyTagsInSchema(d, tags, "tag")
+	setCustomizedPolicyTagsInSchema(d, presentOwnedTags, "owned_tag")
+
+	return nil
+}
+
+func resourceNsxtPolicyObjectTagsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	objectPath := d.Get("object_path").(string)
+	tags := getCustomizedPolicyTagsFromSchema(d, "tag")
+
+	// Tags removed from configuration are only removed from the object
+	// if they were added by this resource
+	var ownedTags []model.Tag
+	var removeTags []model.Tag
+	for _, tag := range getCustomizedPolicyTagsFromSchema(d, "owned_tag") {
+		if containsPolicyTag(tags, tag) {
+			ownedTags = append(ownedTags, tag)
+		} else {
+			removeTags = append(removeTags, tag)
+		}
+	}
+
+	addedTags, err := updatePolicyObjectTags(m, objectPath, tags, removeTags)
+	if err != nil {
+		return diag.FromErr(handleUpdateError("Object Tags", id, err))
+	}
+	setCustomizedPolicyTagsInSchema(d, append(ownedTags, addedTags...), "owned_tag")
+
+	return resourceNsxtPolicyObjectTagsRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyObjectTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	objectPath := d.Get("object_path").(string)
+	removeTags := getCustomizedPolicyTagsFromSchema(d, "owned_tag")
+	if len(removeTags) == 0 {
+		return nil
+	}
+
+	_, err := updatePolicyObjectTags(m, objectPath, nil, removeTags)
+	if err != nil && !isNotFoundError(err) {
+		return diag.FromErr(handleDeleteError("Object Tags", id, err))
+	}
+
+	return nil
+}
+
+// Import by policy path of the tagged object. Tags present on the object at
+// import time are considered managed by this resource, but not owned by it,
+// hence they are not removed from the object on destroy.
+func resourceNsxtPolicyObjectTagsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	objectPath := d.Id()
+	if !isPolicyPath(objectPath) {
+		return nil, fmt.Errorf("Policy path of the tagged object is expected for import, got %s", objectPath)
+	}
+
+	client := newPolicyObjectClient(getPolicyConnector(m))
+	obj, err := client.Get(objectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("object_path", objectPath)
+	setCustomizedPolicyTagsInSchema(d, normalizePolicyTags(obj.Tags), "tag")
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,257 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestAccResourceNsxtPolicyObjectTags_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_object_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyObjectTagsCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyObjectTagsTemplate(name, "netops"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyObjectTagsCheck(testResourceName, 3),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+					resource.TestCheckResourceAttrPair(testResourceName, "object_path", "nsxt_policy_group.test", "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyObjectTagsTemplate(name, "secops"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyObjectTagsCheck(testResourceName, 3),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyObjectTags_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_object_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyObjectTagsCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyObjectTagsTemplate(name, "netops"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported resource manages all tags present on the object
+				ImportStateVerifyIgnore: []string{"tag", "owned_tag"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyObjectTagsGet(objectPath string) (model.PolicyResource, error) {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	return newPolicyObjectClient(connector).Get(objectPath)
+}
+
+func testAccNsxtPolicyObjectTagsCheck(resourceName string, expectedCount int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Object Tags resource %s not found in resources", resourceName)
+		}
+
+		obj, err := testAccNsxtPolicyObjectTagsGet(rs.Primary.Attributes["object_path"])
+		if err != nil {
+			return err
+		}
+		if len(obj.Tags) != expectedCount {
+			return fmt.Errorf("Expected %d tags on object %s, got %d", expectedCount, rs.Primary.ID, len(obj.Tags))
+		}
+		return nil
+	}
+}
+
+func testAccNsxtPolicyObjectTagsCheckDestroy(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_object_tags" {
+			continue
+		}
+
+		obj, err := testAccNsxtPolicyObjectTagsGet(rs.Primary.Attributes["object_path"])
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		for _, tag := range obj.Tags {
+			if tag.Scope != nil && *tag.Scope == "team" {
+				return fmt.Errorf("Policy Object Tags %s still applied", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyObjectTagsTemplate(name string, team string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+
+  tag {
+    scope = "owner"
+    tag   = "apps"
+  }
+
+  lifecycle {
+    ignore_changes = [tag]
+  }
+}
+
+resource "nsxt_policy_object_tags" "test" {
+  object_path = nsxt_policy_group.test.path
+
+  tag {
+    scope = "team"
+    tag   = "%s"
+  }
+
+  tag {
+    scope = "env"
+    tag   = "test"
+  }
+}`, name, team)
+}
+
+func testMockGetObjectTags(t *testing.T, server *mockNsxServer, path string) []string {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	obj, ok := server.objects[path]
+	if !ok {
+		t.Fatalf("Object %s not found on server", path)
+	}
+	var result []string
+	tags, _ := obj.data["tags"].([]interface{})
+	for _, tag := range tags {
+		tagMap := tag.(map[string]interface{})
+		result = append(result, fmt.Sprintf("%v:%v", tagMap["scope"], tagMap["tag"]))
+	}
+	return result
+}
+
+func TestMockNsxPolicyObjectTags(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMetaWithConfig(t, server.Host(), map[string]interface{}{
+		"default_tags": []interface{}{
+			map[string]interface{}{"scope": "managed-by", "tag": "terraform"},
+		},
+	})
+
+	segmentPath := "/infra/segments/brownfield"
+	server.lock.Lock()
+	server.storeObject(segmentPath, map[string]interface{}{
+		"display_name": "brownfield",
+		"tags": []interface{}{
+			map[string]interface{}{"scope": "owner", "tag": "apps"},
+		},
+	})
+	server.lock.Unlock()
+
+	resource := Provider().ResourcesMap["nsxt_policy_object_tags"]
+	if _, ok := resource.Schema["tags_all"]; ok {
+		t.Fatalf("Provider default tags are not expected to be applied by object tags resource")
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"object_path": segmentPath,
+		"tag": []interface{}{
+			map[string]interface{}{"scope": "team", "tag": "netops"},
+			map[string]interface{}{"scope": "env", "tag": "prod"},
+			map[string]interface{}{"scope": "owner", "tag": "apps"},
+		},
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if tags := testMockGetObjectTags(t, server, segmentPath); len(tags) != 3 {
+		t.Fatalf("Expected managed tags merged with existing tag, got %v", tags)
+	}
+	if d.Get("tag").(*schema.Set).Len() != 3 {
+		t.Fatalf("Expected only managed tags in state, got %v", d.Get("tag"))
+	}
+	if d.Get("owned_tag").(*schema.Set).Len() != 2 {
+		t.Fatalf("Expected only added tags to be owned, got %v", d.Get("owned_tag"))
+	}
+
+	// Tag applied outside of terraform after creation is not managed
+	server.lock.Lock()
+	data := server.objects[segmentPath].data
+	data["tags"] = append(data["tags"].([]interface{}), map[string]interface{}{"scope": "backup", "tag": "daily"})
+	server.storeObject(segmentPath, data)
+	server.lock.Unlock()
+
+	d = resource.Data(d.State())
+	d.Set("tag", []interface{}{
+		map[string]interface{}{"scope": "team", "tag": "secops"},
+		map[string]interface{}{"scope": "env", "tag": "prod"},
+		map[string]interface{}{"scope": "owner", "tag": "apps"},
+	})
+	if diags := resource.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Update failed: %v", diags)
+	}
+	tags := testMockGetObjectTags(t, server, segmentPath)
+	expected := []string{"owner:apps", "env:prod", "backup:daily", "team:secops"}
+	if len(tags) != len(expected) {
+		t.Fatalf("Expected tags %v, got %v", expected, tags)
+	}
+	for _, tag := range expected {
+		if !strings.Contains(strings.Join(tags, ","), tag) {
+			t.Fatalf("Expected tag %s on object, got %v", tag, tags)
+		}
+	}
+
+	// Tags present on the object before creation are kept on destroy
+	if diags := resource.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+	tags = testMockGetObjectTags(t, server, segmentPath)
+	if len(tags) != 2 || tags[0] != "owner:apps" || tags[1] != "backup:daily" {
+		t.Fatalf("Expected only tags not added by resource after delete, got %v", tags)
+	}
+
+	imported, err := testMockImport(resource, m, segmentPath)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if imported.Get("object_path").(string) != segmentPath || imported.Get("tag").(*schema.Set).Len() != 2 {
+		t.Fatalf("Unexpected imported state: %v", imported.State())
+	}
+	if imported.Get("owned_tag").(*schema.Set).Len() != 0 {
+		t.Fatalf("Tags present at import are not expected to be owned, got %v", imported.Get("owned_tag"))
+	}
+	if _, err := testMockImport(resource, m, "brownfield"); err == nil {
+		t.Fatalf("Expected import by ID that is not a policy path to fail")
+	}
+}
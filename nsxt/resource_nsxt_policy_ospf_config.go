@@ -4,8 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
@@ -15,10 +16,10 @@ import (
 func resourceNsxtPolicyOspfConfig() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyOspfConfigCreate,
-		Read:   resourceNsxtPolicyOspfConfigRead,
-		Update: resourceNsxtPolicyOspfConfigUpdate,
-		Delete: resourceNsxtPolicyOspfConfigDelete,
+		CreateContext: resourceNsxtPolicyOspfConfigCreate,
+		ReadContext:   resourceNsxtPolicyOspfConfigRead,
+		UpdateContext: resourceNsxtPolicyOspfConfigUpdate,
+		DeleteContext: resourceNsxtPolicyOspfConfigDelete,
 
 		Schema: getPolicyOspfConfigSchema(),
 	}
@@ -91,7 +92,7 @@ func getPolicyOspfConfigSchema() map[string]*schema.Schema {
 func policyOspfConfigPatch(d *schema.ResourceData, m interface{}, gwID string, localeServiceID string) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	ecmp := d.Get("ecmp").(bool)
 	enabled := d.Get("enabled").(bool)
 	defaultOriginate := d.Get("default_originate").(bool)
@@ -129,40 +130,40 @@ func policyOspfConfigPatch(d *schema.ResourceData, m interface{}, gwID string, l
 	return err
 }
 
-func resourceNsxtPolicyOspfConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if isPolicyGlobalManager(m) {
-		return localManagerOnlyError()
+		return diag.FromErr(localManagerOnlyError())
 	}
 
 	connector := getPolicyConnector(m)
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	localeService, err := getPolicyTier0GatewayLocaleServiceWithEdgeCluster(getSessionContext(d, m), gwID, connector)
 	if err != nil {
-		return fmt.Errorf("Tier0 Gateway path with configured edge cluster expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path with configured edge cluster expected, got %s", gwPath)
 	}
 
 	err = policyOspfConfigPatch(d, m, gwID, *localeService.Id)
 	if err != nil {
-		return handleCreateError("Ospf Config", gwID, err)
+		return diag.FromErr(handleCreateError("Ospf Config", gwID, err))
 	}
 
 	d.SetId(newUUID())
 	d.Set("gateway_id", gwID)
 	d.Set("locale_service_id", localeService.Id)
 
-	return resourceNsxtPolicyOspfConfigRead(d, m)
+	return resourceNsxtPolicyOspfConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyOspfConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	if isPolicyGlobalManager(m) {
-		return localManagerOnlyError()
+		return diag.FromErr(localManagerOnlyError())
 	}
 
 	connector := getPolicyConnector(m)
@@ -170,7 +171,7 @@ func resourceNsxtPolicyOspfConfigRead(d *schema.ResourceData, m interface{}) err
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	localeServiceID := d.Get("locale_service_id").(string)
@@ -178,14 +179,14 @@ func resourceNsxtPolicyOspfConfigRead(d *schema.ResourceData, m interface{}) err
 	client := locale_services.NewOspfClient(connector)
 	obj, err := client.Get(gwID, localeServiceID)
 	if err != nil {
-		return handleReadError(d, "Ospf Config", gwID, err)
+		return diag.FromErr(handleReadError(d, "Ospf Config", gwID, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("enabled", obj.Enabled)
 	d.Set("ecmp", obj.Ecmp)
 	d.Set("default_originate", obj.DefaultOriginate)
@@ -204,20 +205,20 @@ func resourceNsxtPolicyOspfConfigRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtPolicyOspfConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 
 	err := policyOspfConfigPatch(d, m, gwID, localeServiceID)
 	if err != nil {
-		return handleCreateError("Ospf Config", gwID, err)
+		return diag.FromErr(handleCreateError("Ospf Config", gwID, err))
 	}
 
-	return resourceNsxtPolicyOspfConfigRead(d, m)
+	return resourceNsxtPolicyOspfConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyOspfConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// OSPF object can not be deleted as long as locale service exist
 	// Delete call on NSX should revert settings to default, but this is
 	// not supported on platform as of today
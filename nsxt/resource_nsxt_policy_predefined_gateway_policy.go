@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,10 +24,10 @@ import (
 
 func resourceNsxtPolicyPredefinedGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyPredefinedGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyPredefinedGatewayPolicyRead,
-		Update: resourceNsxtPolicyPredefinedGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyPredefinedGatewayPolicyDelete,
+		CreateContext: resourceNsxtPolicyPredefinedGatewayPolicyCreate,
+		ReadContext:   resourceNsxtPolicyPredefinedGatewayPolicyRead,
+		UpdateContext: resourceNsxtPolicyPredefinedGatewayPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyPredefinedGatewayPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPredefinedPolicyImporter,
 		},
@@ -308,7 +310,7 @@ func updatePolicyPredefinedGatewayPolicy(id string, d *schema.ResourceData, m in
 	}
 
 	if d.HasChange("tag") {
-		predefinedPolicy.Tags = getPolicyTagsFromSchema(d)
+		predefinedPolicy.Tags = getPolicyTagsFromSchema(d, m)
 	}
 
 	var childRules []*data.StructValue
@@ -384,30 +386,33 @@ func updatePolicyPredefinedGatewayPolicy(id string, d *schema.ResourceData, m in
 	return nil
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	path := d.Get("path").(string)
 	id := getPolicyIDFromPath(path)
 
 	if id == "" {
-		return fmt.Errorf("Failed to extract ID from Gateway Policy path %s", path)
+		return diag.Errorf("Failed to extract ID from Gateway Policy path %s", path)
 	}
 
-	err := updatePolicyPredefinedGatewayPolicy(id, d, m)
+	// Predefined policy is read again with each attempt
+	err := retryUponPreconditionFailed(func() error {
+		return updatePolicyPredefinedGatewayPolicy(id, d, m)
+	}, getCommonProviderConfig(m).MaxRetries)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceNsxtPolicyPredefinedGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -416,11 +421,11 @@ func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m int
 	client := domains.NewGatewayPoliciesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(domain, id)
 	if err != nil {
-		return handleReadError(d, "Predefined Gateway Policy", id, err)
+		return diag.FromErr(handleReadError(d, "Predefined Gateway Policy", id, err))
 	}
 
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
@@ -437,29 +442,32 @@ func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m int
 
 	err = setPolicyRulesInSchema(d, rules)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return setPolicyDefaultRulesInSchema(d, defaultRules)
+	return diag.FromErr(setPolicyDefaultRulesInSchema(d, defaultRules))
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Gateway Policy ID")
+		return diag.Errorf("Error obtaining Predefined Gateway Policy ID")
 	}
-	err := updatePolicyPredefinedGatewayPolicy(id, d, m)
+	// Predefined policy is read again with each attempt
+	err := retryUponPreconditionFailed(func() error {
+		return updatePolicyPredefinedGatewayPolicy(id, d, m)
+	}, getCommonProviderConfig(m).MaxRetries)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceNsxtPolicyPredefinedGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Delete means revert back to default
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Gateway Policy ID")
+		return diag.Errorf("Error obtaining Predefined Gateway Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -467,17 +475,17 @@ func resourceNsxtPolicyPredefinedGatewayPolicyDelete(d *schema.ResourceData, m i
 
 	predefinedPolicy, err := getGatewayPolicyInDomain(getSessionContext(d, m), id, domain, getPolicyConnector(m))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	revertedPolicy, err := revertPolicyPredefinedGatewayPolicy(predefinedPolicy, m)
 	if err != nil {
-		return fmt.Errorf("Failed to revert Predefined Gateway Policy %s: %s", id, err)
+		return diag.Errorf("Failed to revert Predefined Gateway Policy %s: %s", id, err)
 	}
 
 	err = gatewayPolicyInfraPatch(getSessionContext(d, m), revertedPolicy, domain, m)
 	if err != nil {
-		return handleUpdateError("Predefined Gateway Policy", id, err)
+		return diag.FromErr(handleUpdateError("Predefined Gateway Policy", id, err))
 	}
 	return nil
 }
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,10 +22,10 @@ import (
 
 func resourceNsxtPolicyPredefinedSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyPredefinedSecurityPolicyCreate,
-		Read:   resourceNsxtPolicyPredefinedSecurityPolicyRead,
-		Update: resourceNsxtPolicyPredefinedSecurityPolicyUpdate,
-		Delete: resourceNsxtPolicyPredefinedSecurityPolicyDelete,
+		CreateContext: resourceNsxtPolicyPredefinedSecurityPolicyCreate,
+		ReadContext:   resourceNsxtPolicyPredefinedSecurityPolicyRead,
+		UpdateContext: resourceNsxtPolicyPredefinedSecurityPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyPredefinedSecurityPolicyDelete,
 
 		Schema: getPolicyPredefinedSecurityPolicySchema(),
 	}
@@ -230,7 +232,7 @@ func updatePolicyPredefinedSecurityPolicy(id string, d *schema.ResourceData, m i
 	}
 
 	if d.HasChange("tag") {
-		predefinedPolicy.Tags = getPolicyTagsFromSchema(d)
+		predefinedPolicy.Tags = getPolicyTagsFromSchema(d, m)
 	}
 
 	var childRules []*data.StructValue
@@ -306,30 +308,33 @@ func updatePolicyPredefinedSecurityPolicy(id string, d *schema.ResourceData, m i
 	return nil
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	path := d.Get("path").(string)
 	id := getPolicyIDFromPath(path)
 
 	if id == "" {
-		return fmt.Errorf("Failed to extract ID from Security Policy path %s", path)
+		return diag.Errorf("Failed to extract ID from Security Policy path %s", path)
 	}
 
-	err := updatePolicyPredefinedSecurityPolicy(id, d, m)
+	// Predefined policy is read again with each attempt
+	err := retryUponPreconditionFailed(func() error {
+		return updatePolicyPredefinedSecurityPolicy(id, d, m)
+	}, getCommonProviderConfig(m).MaxRetries)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(id)
 
-	return resourceNsxtPolicyPredefinedSecurityPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedSecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy ID")
+		return diag.Errorf("Error obtaining Security Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -338,11 +343,11 @@ func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m in
 	client := domains.NewSecurityPoliciesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(domain, id)
 	if err != nil {
-		return handleReadError(d, "Predefined Security Policy", id, err)
+		return diag.FromErr(handleReadError(d, "Predefined Security Policy", id, err))
 	}
 
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
 
@@ -359,29 +364,32 @@ func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m in
 
 	err = setPolicyRulesInSchema(d, rules)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return setPolicyDefaultRulesInSchema(d, defaultRules)
+	return diag.FromErr(setPolicyDefaultRulesInSchema(d, defaultRules))
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Security Policy ID")
+		return diag.Errorf("Error obtaining Predefined Security Policy ID")
 	}
-	err := updatePolicyPredefinedSecurityPolicy(id, d, m)
+	// Predefined policy is read again with each attempt
+	err := retryUponPreconditionFailed(func() error {
+		return updatePolicyPredefinedSecurityPolicy(id, d, m)
+	}, getCommonProviderConfig(m).MaxRetries)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceNsxtPolicyPredefinedSecurityPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedSecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Delete means revert back to default
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Security Policy ID")
+		return diag.Errorf("Error obtaining Predefined Security Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -390,17 +398,17 @@ func resourceNsxtPolicyPredefinedSecurityPolicyDelete(d *schema.ResourceData, m
 
 	predefinedPolicy, err := getSecurityPolicyInDomain(context, id, domain, getPolicyConnector(m))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	revertedPolicy, err := revertPolicyPredefinedSecurityPolicy(predefinedPolicy, m)
 	if err != nil {
-		return fmt.Errorf("Failed to revert Predefined Security Policy %s: %s", id, err)
+		return diag.Errorf("Failed to revert Predefined Security Policy %s: %s", id, err)
 	}
 
 	err = securityPolicyInfraPatch(context, revertedPolicy, domain, m)
 	if err != nil {
-		return handleUpdateError("Predefined Security Policy", id, err)
+		return diag.FromErr(handleUpdateError("Predefined Security Policy", id, err))
 	}
 	return nil
 }
@@ -4,9 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -15,12 +18,12 @@ import (
 
 func resourceNsxtPolicyProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyProjectCreate,
-		Read:   resourceNsxtPolicyProjectRead,
-		Update: resourceNsxtPolicyProjectUpdate,
-		Delete: resourceNsxtPolicyProjectDelete,
+		CreateContext: resourceNsxtPolicyProjectCreate,
+		ReadContext:   resourceNsxtPolicyProjectRead,
+		UpdateContext: resourceNsxtPolicyProjectUpdate,
+		DeleteContext: resourceNsxtPolicyProjectDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyProjectImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -30,6 +33,7 @@ func resourceNsxtPolicyProject() *schema.Resource {
 			"description":  getDescriptionSchema(),
 			"revision":     getRevisionSchema(),
 			"tag":          getTagsSchema(),
+			"org_id":       getPolicyOrgIDSchema(),
 			"short_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -59,10 +63,33 @@ func resourceNsxtPolicyProject() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyProjectExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+func getPolicyOrgIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Id of the organization which the project belongs to. Default organization is used if not specified.",
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	}
+}
+
+func getPolicyProjectOrgID(d *schema.ResourceData) string {
+	if orgID := d.Get("org_id").(string); orgID != "" {
+		return orgID
+	}
+	return defaultOrgID
+}
+
+func resourceNsxtPolicyProjectExistsPartial(orgID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyProjectExists(orgID, id, connector)
+	}
+}
+
+func resourceNsxtPolicyProjectExists(orgID string, id string, connector client.Connector) (bool, error) {
 	var err error
 	client := infra.NewProjectsClient(connector)
-	_, err = client.Get(defaultOrgID, id)
+	_, err = client.Get(orgID, id)
 
 	if err == nil {
 		return true, nil
@@ -78,7 +105,7 @@ func resourceNsxtPolicyProjectExists(id string, connector client.Connector, isGl
 func resourceNsxtPolicyProjectPatch(connector client.Connector, d *schema.ResourceData, m interface{}, id string) error {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	shortID := d.Get("short_id").(string)
 	siteInfosList := d.Get("site_info").([]interface{})
 	var siteInfos []model.SiteInfo
@@ -112,51 +139,52 @@ func resourceNsxtPolicyProjectPatch(connector client.Connector, d *schema.Resour
 	log.Printf("[INFO] Patching Project with ID %s", id)
 
 	client := infra.NewProjectsClient(connector)
-	return client.Patch(defaultOrgID, id, obj)
+	return client.Patch(getPolicyProjectOrgID(d), id, obj)
 }
 
-func resourceNsxtPolicyProjectCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID(d, m, resourceNsxtPolicyProjectExists)
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyProjectExistsPartial(getPolicyProjectOrgID(d)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	connector := getPolicyConnector(m)
 	err = resourceNsxtPolicyProjectPatch(connector, d, m, id)
 	if err != nil {
-		return handleCreateError("Project", id, err)
+		return diag.FromErr(handleCreateError("Project", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyProjectRead(d, m)
+	return resourceNsxtPolicyProjectRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyProjectRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Project ID")
+		return diag.Errorf("Error obtaining Project ID")
 	}
 
 	var obj model.Project
 	client := infra.NewProjectsClient(connector)
 	var err error
-	obj, err = client.Get(defaultOrgID, id)
+	obj, err = client.Get(getPolicyProjectOrgID(d), id)
 	if err != nil {
-		return handleReadError(d, "Project", id, err)
+		return diag.FromErr(handleReadError(d, "Project", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
+	d.Set("org_id", getPolicyProjectOrgID(d))
 
 	d.Set("short_id", obj.ShortId)
 	var siteInfosList []map[string]interface{}
@@ -172,36 +200,52 @@ func resourceNsxtPolicyProjectRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtPolicyProjectUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Project ID")
+		return diag.Errorf("Error obtaining Project ID")
 	}
 
 	connector := getPolicyConnector(m)
 	err := resourceNsxtPolicyProjectPatch(connector, d, m, id)
 	if err != nil {
-		return handleUpdateError("Project", id, err)
+		return diag.FromErr(handleUpdateError("Project", id, err))
 	}
 
-	return resourceNsxtPolicyProjectRead(d, m)
+	return resourceNsxtPolicyProjectRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyProjectDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Project ID")
+		return diag.Errorf("Error obtaining Project ID")
 	}
 
 	connector := getPolicyConnector(m)
 	var err error
 	client := infra.NewProjectsClient(connector)
-	err = client.Delete(defaultOrgID, id)
+	err = client.Delete(getPolicyProjectOrgID(d), id)
 
 	if err != nil {
-		return handleDeleteError("Project", id, err)
+		return diag.FromErr(handleDeleteError("Project", id, err))
 	}
 
 	return nil
 }
+
+func nsxtPolicyProjectImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if !isPolicyPath(importID) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// Project path is in format /orgs/<org>/projects/<project>
+	pathSegs := strings.Split(importID, "/")
+	if len(pathSegs) != 5 || pathSegs[1] != "orgs" || pathSegs[3] != "projects" {
+		return nil, fmt.Errorf("invalid project path %s", importID)
+	}
+	d.Set("org_id", pathSegs[2])
+	d.SetId(pathSegs[4])
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -26,20 +28,7 @@ var accTestPolicyProjectUpdateAttributes = map[string]string{
 }
 
 func getExpectedSiteInfoCount(t *testing.T) string {
-	if nsxVersion == "" {
-		connector, err := testAccGetPolicyConnector()
-		if err != nil {
-			t.Errorf("Failed to get policy connector")
-			return "0"
-		}
-
-		err = initNSXVersion(connector)
-		if err != nil {
-			t.Errorf("Failed to retrieve NSX version")
-			return "0"
-		}
-	}
-	if nsxVersionHigherOrEqual("4.1.1") {
+	if testAccNSXVersionHigherOrEqual(t, "4.1.1") {
 		return "1"
 	}
 	return "0"
@@ -165,7 +154,7 @@ func testAccNsxtPolicyProjectExists(displayName string, resourceName string) res
 			return fmt.Errorf("Policy Project resource ID not set in resources")
 		}
 
-		exists, err := resourceNsxtPolicyProjectExists(resourceID, connector, testAccIsGlobalManager())
+		exists, err := resourceNsxtPolicyProjectExists(rs.Primary.Attributes["org_id"], resourceID, connector)
 		if err != nil {
 			return err
 		}
@@ -186,7 +175,7 @@ func testAccNsxtPolicyProjectCheckDestroy(state *terraform.State, displayName st
 		}
 
 		resourceID := rs.Primary.Attributes["id"]
-		exists, err := resourceNsxtPolicyProjectExists(resourceID, connector, testAccIsGlobalManager())
+		exists, err := resourceNsxtPolicyProjectExists(rs.Primary.Attributes["org_id"], resourceID, connector)
 		if err == nil {
 			return err
 		}
@@ -235,3 +224,60 @@ resource "nsxt_policy_project" "test" {
 
 }`, accTestPolicyProjectUpdateAttributes["display_name"])
 }
+
+func TestMockNsxProjectOrg(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	resource := resourceNsxtPolicyProject()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "mock-org-project",
+		"nsx_id":       "org-project",
+		"org_id":       "tenant1",
+	})
+	if diags := resource.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Create failed: %v", diags)
+	}
+	if path := d.Get("path").(string); path != "/orgs/tenant1/projects/org-project" {
+		t.Fatalf("Expected project to be created in organization tenant1, got path %s", path)
+	}
+
+	dataSource := dataSourceNsxtPolicyProject()
+	ds := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"display_name": "mock-org-project",
+		"org_id":       "tenant1",
+	})
+	if diags := dataSource.ReadContext(context.Background(), ds, m); diags.HasError() {
+		t.Fatalf("Data source read failed: %v", diags)
+	}
+	if ds.Id() != "org-project" {
+		t.Fatalf("Expected project org-project to be found in organization tenant1, got %s", ds.Id())
+	}
+
+	imported := resource.Data(nil)
+	imported.SetId("/orgs/tenant1/projects/org-project")
+	if _, err := resource.Importer.State(imported, m); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if diags := resource.ReadContext(context.Background(), imported, m); diags.HasError() {
+		t.Fatalf("Read failed: %v", diags)
+	}
+	if imported.Id() != "org-project" || imported.Get("org_id").(string) != "tenant1" {
+		t.Fatalf("Expected project to be imported with organization tenant1, got %s in %s", imported.Id(), imported.Get("org_id"))
+	}
+
+	segment := resourceNsxtPolicySegment()
+	importedSegment := segment.Data(nil)
+	importedSegment.SetId("/orgs/tenant1/projects/org-project/infra/segments/org-segment")
+	if _, err := segment.Importer.State(importedSegment, m); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if getProjectIDFromSchema(importedSegment) != "org-project" || getOrgIDFromSchema(importedSegment) != "tenant1" {
+		t.Fatalf("Expected context to be set from project path, got %v", importedSegment.Get("context"))
+	}
+
+	if diags := resource.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("Delete failed: %v", diags)
+	}
+}
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -22,10 +24,10 @@ var rateLimiterResourceTypes = []string{model.QosBaseRateLimiter_RESOURCE_TYPE_I
 
 func resourceNsxtPolicyQosProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyQosProfileCreate,
-		Read:   resourceNsxtPolicyQosProfileRead,
-		Update: resourceNsxtPolicyQosProfileUpdate,
-		Delete: resourceNsxtPolicyQosProfileDelete,
+		CreateContext: resourceNsxtPolicyQosProfileCreate,
+		ReadContext:   resourceNsxtPolicyQosProfileRead,
+		UpdateContext: resourceNsxtPolicyQosProfileUpdate,
+		DeleteContext: resourceNsxtPolicyQosProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -131,18 +133,18 @@ func setPolicyQosRateShaperInSchema(d *schema.ResourceData, shaperConf []*data.S
 	}
 }
 
-func resourceNsxtPolicyQosProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyQosProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	classOfService := int64(d.Get("class_of_service").(int))
 	dscpTrusted := "UNTRUSTED"
@@ -177,32 +179,32 @@ func resourceNsxtPolicyQosProfileCreate(d *schema.ResourceData, m interface{}) e
 	client := infra.NewQosProfilesClient(getSessionContext(d, m), connector)
 	err = client.Patch(id, obj, &boolFalse)
 	if err != nil {
-		return handleCreateError("QosProfile", id, err)
+		return diag.FromErr(handleCreateError("QosProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyQosProfileRead(d, m)
+	return resourceNsxtPolicyQosProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
+		return diag.Errorf("Error obtaining QosProfile ID")
 	}
 
 	client := infra.NewQosProfilesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "QosProfile", id, err)
+		return diag.FromErr(handleReadError(d, "QosProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -220,22 +222,22 @@ func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtPolicyQosProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewQosProfilesClient(getSessionContext(d, m), connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return diag.FromErr(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
+		return diag.Errorf("Error obtaining QosProfile ID")
 	}
 
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	classOfService := int64(d.Get("class_of_service").(int))
 	dscpTrusted := "UNTRUSTED"
@@ -269,16 +271,16 @@ func resourceNsxtPolicyQosProfileUpdate(d *schema.ResourceData, m interface{}) e
 	boolFalse := false
 	err := client.Patch(id, obj, &boolFalse)
 	if err != nil {
-		return handleUpdateError("QosProfile", id, err)
+		return diag.FromErr(handleUpdateError("QosProfile", id, err))
 	}
 
-	return resourceNsxtPolicyQosProfileRead(d, m)
+	return resourceNsxtPolicyQosProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyQosProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
+		return diag.Errorf("Error obtaining QosProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -287,7 +289,7 @@ func resourceNsxtPolicyQosProfileDelete(d *schema.ResourceData, m interface{}) e
 	err := client.Delete(id, &boolFalse)
 
 	if err != nil {
-		return handleDeleteError("QosProfile", id, err)
+		return diag.FromErr(handleDeleteError("QosProfile", id, err))
 	}
 
 	return nil
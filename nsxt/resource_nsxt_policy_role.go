@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -23,12 +25,12 @@ var featurePermissionTypes = [](string){
 
 func resourceNsxtPolicyUserManagementRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyUserManagementRoleCreate,
-		Read:   resourceNsxtPolicyUserManagementRoleRead,
-		Update: resourceNsxtPolicyUserManagementRoleUpdate,
-		Delete: resourceNsxtPolicyUserManagementRoleDelete,
+		CreateContext: resourceNsxtPolicyUserManagementRoleCreate,
+		ReadContext:   resourceNsxtPolicyUserManagementRoleRead,
+		UpdateContext: resourceNsxtPolicyUserManagementRoleUpdate,
+		DeleteContext: resourceNsxtPolicyUserManagementRoleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyAPIPathResourceImporter("/aaa/roles"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -141,7 +143,7 @@ func policyUserManagementRoleUpdate(id string, d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	role := d.Get("role").(string)
 	features := getFeaturePermissionFromSchema(d)
 	revision := int64(d.Get("revision").(int))
@@ -179,48 +181,48 @@ func policyUserManagementRoleUpdate(id string, d *schema.ResourceData, m interfa
 	return err
 }
 
-func resourceNsxtPolicyUserManagementRoleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("role").(string)
 	exists, err := resourceNsxtPolicyUserManagementRoleExists(id, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if exists {
-		return fmt.Errorf("resource with ID %s already exists", id)
+		return diag.Errorf("resource with ID %s already exists", id)
 	}
 
 	// Create the resource using PUT
 	log.Printf("[INFO] Creating RoleWithFeatures with ID %s", id)
 	err = policyUserManagementRoleUpdate(id, d, m)
 	if err != nil {
-		return handleCreateError("RoleWithFeatures", id, err)
+		return diag.FromErr(handleCreateError("RoleWithFeatures", id, err))
 	}
 
 	d.SetId(id)
 
-	return resourceNsxtPolicyUserManagementRoleRead(d, m)
+	return resourceNsxtPolicyUserManagementRoleRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyUserManagementRoleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	roleClient := aaa.NewRolesClient(connector)
 
 	id := d.Id()
 	if id == "" {
 		err := fmt.Errorf("error obtaining RoleWithFeatures ID")
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj, err := roleClient.Get(id)
 	if err != nil {
-		return handleReadError(d, "RoleWithFeatures", id, err)
+		return diag.FromErr(handleReadError(d, "RoleWithFeatures", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("revision", obj.Revision)
 	d.Set("role", obj.Role)
 	setFeaturePermissionInSchema(d, obj.Features)
@@ -228,36 +230,36 @@ func resourceNsxtPolicyUserManagementRoleRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyUserManagementRoleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
 		err := fmt.Errorf("error obtaining RoleWithFeatures ID")
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updateing RoleWithFeatures with ID %s", id)
 	err := policyUserManagementRoleUpdate(id, d, m)
 	if err != nil {
-		return handleUpdateError("RoleWithFeatures", id, err)
+		return diag.FromErr(handleUpdateError("RoleWithFeatures", id, err))
 	}
 
-	return resourceNsxtPolicyUserManagementRoleRead(d, m)
+	return resourceNsxtPolicyUserManagementRoleRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyUserManagementRoleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	roleClient := aaa.NewRolesClient(connector)
 
 	id := d.Id()
 	if id == "" {
 		err := fmt.Errorf("error obtaining RoleWithFeatures ID")
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting RoleWithFeatures with ID %s", id)
 	err := roleClient.Delete(id)
 	if err != nil {
-		return handleDeleteError("RoleWithFeatures", id, err)
+		return diag.FromErr(handleDeleteError("RoleWithFeatures", id, err))
 	}
 
 	return nil
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/aaa"
@@ -32,12 +34,12 @@ var defaultRoleName = "auditor"
 
 func resourceNsxtPolicyUserManagementRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyUserManagementRoleBindingCreate,
-		Read:   resourceNsxtPolicyUserManagementRoleBindingRead,
-		Update: resourceNsxtPolicyUserManagementRoleBindingUpdate,
-		Delete: resourceNsxtPolicyUserManagementRoleBindingDelete,
+		CreateContext: resourceNsxtPolicyUserManagementRoleBindingCreate,
+		ReadContext:   resourceNsxtPolicyUserManagementRoleBindingRead,
+		UpdateContext: resourceNsxtPolicyUserManagementRoleBindingUpdate,
+		DeleteContext: resourceNsxtPolicyUserManagementRoleBindingDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyAPIPathResourceImporter("/aaa/role-bindings"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -223,11 +225,11 @@ func getRolesForPathList(d *schema.ResourceData, rolesToRemove rolesForPath) []n
 	return nsxRolesForPaths
 }
 
-func getRoleBindingObject(d *schema.ResourceData, removeRoles rolesForPath) *nsxModel.RoleBinding {
+func getRoleBindingObject(d *schema.ResourceData, removeRoles rolesForPath, m interface{}) *nsxModel.RoleBinding {
 	boolTrue := true
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	name := d.Get("name").(string)
 	identitySrcID := d.Get("identity_source_id").(string)
 	identitySrcType := d.Get("identity_source_type").(string)
@@ -272,7 +274,7 @@ func getExistingRoleBinding(rbClient aaa.RoleBindingsClient, username, userType
 	return rbObj, nil
 }
 
-func overwriteRoleBinding(d *schema.ResourceData, m interface{}, existingRoleBinding *nsxModel.RoleBinding) error {
+func overwriteRoleBinding(ctx context.Context, d *schema.ResourceData, m interface{}, existingRoleBinding *nsxModel.RoleBinding) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	rbClient := aaa.NewRoleBindingsClient(connector)
 	id := d.Id()
@@ -287,13 +289,13 @@ func overwriteRoleBinding(d *schema.ResourceData, m interface{}, existingRoleBin
 	}
 
 	log.Printf("[INFO] Overwriting RoleBinding with ID %s", id)
-	obj := getRoleBindingObject(d, existingRoles)
+	obj := getRoleBindingObject(d, existingRoles, m)
 	_, err := rbClient.Update(id, *obj)
 	if err != nil {
-		return handleUpdateError("RoleBinding", id, err)
+		return diag.FromErr(handleUpdateError("RoleBinding", id, err))
 	}
 
-	return resourceNsxtPolicyUserManagementRoleBindingRead(d, m)
+	return resourceNsxtPolicyUserManagementRoleBindingRead(ctx, d, m)
 }
 
 func revertRoleBinding(d *schema.ResourceData, m interface{}) error {
@@ -337,7 +339,7 @@ func revertRoleBinding(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyUserManagementRoleBindingCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	rbClient := aaa.NewRoleBindingsClient(connector)
 
@@ -346,51 +348,51 @@ func resourceNsxtPolicyUserManagementRoleBindingCreate(d *schema.ResourceData, m
 	username := d.Get("name").(string)
 	if roleBindingType == nsxModel.RoleBinding_TYPE_LOCAL_USER {
 		if !overwriteLocaluser {
-			return fmt.Errorf(
+			return diag.Errorf(
 				"RoleBinding for %s owned by NSX. Import the binding, or set overwrite_local_user",
 				roleBindingType)
 		}
 		rbObj, err := getExistingRoleBinding(rbClient, username, nsxModel.RoleBinding_TYPE_LOCAL_USER)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(*rbObj.Id)
-		return overwriteRoleBinding(d, m, rbObj)
+		return overwriteRoleBinding(ctx, d, m, rbObj)
 	}
 
 	// Create the resource using POST
 	log.Printf("[INFO] Creating RoleBinding for %s %s", roleBindingType, username)
-	obj := getRoleBindingObject(d, rolesForPath{})
+	obj := getRoleBindingObject(d, rolesForPath{}, m)
 
 	rbObj, err := rbClient.Create(*obj)
 	if err != nil {
-		return handleCreateError("RoleBinding", username, err)
+		return diag.FromErr(handleCreateError("RoleBinding", username, err))
 	}
 
 	id := *rbObj.Id
 	d.SetId(id)
 
-	return resourceNsxtPolicyUserManagementRoleBindingRead(d, m)
+	return resourceNsxtPolicyUserManagementRoleBindingRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyUserManagementRoleBindingRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	rbClient := aaa.NewRoleBindingsClient(connector)
 
 	id := d.Id()
 	if id == "" {
 		err := fmt.Errorf("error obtaining RoleBinding ID")
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj, err := rbClient.Get(id, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
-		return handleReadError(d, "RoleBinding", id, err)
+		return diag.FromErr(handleReadError(d, "RoleBinding", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("revision", obj.Revision)
 	d.Set("name", obj.Name)
 	d.Set("type", obj.Type_)
@@ -408,46 +410,46 @@ func resourceNsxtPolicyUserManagementRoleBindingRead(d *schema.ResourceData, m i
 	return nil
 }
 
-func resourceNsxtPolicyUserManagementRoleBindingUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
 		err := fmt.Errorf("error obtaining RoleBinding ID")
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updateing RoleBinding with ID %s", id)
 	connector := getPolicyConnector(m)
 	rbClient := aaa.NewRoleBindingsClient(connector)
-	obj := getRoleBindingObject(d, rolesForPath{})
+	obj := getRoleBindingObject(d, rolesForPath{}, m)
 	_, err := rbClient.Update(id, *obj)
 	if err != nil {
-		return handleCreateError("RoleBinding", id, err)
+		return diag.FromErr(handleCreateError("RoleBinding", id, err))
 	}
 
-	return resourceNsxtPolicyUserManagementRoleBindingRead(d, m)
+	return resourceNsxtPolicyUserManagementRoleBindingRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyUserManagementRoleBindingDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyUserManagementRoleBindingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	rbClient := aaa.NewRoleBindingsClient(connector)
 
 	id := d.Id()
 	if id == "" {
 		err := fmt.Errorf("error obtaining RoleBinding ID")
-		return err
+		return diag.FromErr(err)
 	}
 	roleBindingType := d.Get("type").(string)
 	if roleBindingType == nsxModel.RoleBinding_TYPE_LOCAL_USER {
 		if d.Get("overwrite_local_user").(bool) {
-			return revertRoleBinding(d, m)
+			return diag.FromErr(revertRoleBinding(d, m))
 		}
-		return fmt.Errorf("role binding for %s %s can not be deleted", roleBindingType, id)
+		return diag.Errorf("role binding for %s %s can not be deleted", roleBindingType, id)
 	}
 
 	log.Printf("[INFO] Deleting RoleBinding with ID %s", id)
 	err := rbClient.Delete(id, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
-		return handleDeleteError("RoleBinding", id, err)
+		return diag.FromErr(handleDeleteError("RoleBinding", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -17,17 +18,23 @@ import (
 
 func resourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySecurityPolicyCreate,
-		Read:   resourceNsxtPolicySecurityPolicyRead,
-		Update: resourceNsxtPolicySecurityPolicyUpdate,
-		Delete: resourceNsxtPolicySecurityPolicyDelete,
+		CreateContext: resourceNsxtPolicySecurityPolicyCreate,
+		ReadContext:   resourceNsxtPolicySecurityPolicyRead,
+		UpdateContext: resourceNsxtPolicySecurityPolicyUpdate,
+		DeleteContext: resourceNsxtPolicySecurityPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
-		Schema: getPolicySecurityPolicySchema(false, true),
+		Schema: getPolicySecurityPolicyResourceSchema(),
 	}
 }
 
+func getPolicySecurityPolicyResourceSchema() map[string]*schema.Schema {
+	secPolicy := getPolicySecurityPolicySchema(false, true)
+	secPolicy["ignore_unmanaged_rules"] = getIgnoreUnmanagedRulesSchema()
+	return secPolicy
+}
+
 func getSecurityPolicyInDomain(sessionContext utl.SessionContext, id string, domainName string, connector client.Connector) (model.SecurityPolicy, error) {
 	client := domains.NewSecurityPoliciesClient(sessionContext, connector)
 	return client.Get(domainName, id)
@@ -60,7 +67,7 @@ func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, co
 	domain := d.Get("domain").(string)
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	category := d.Get("category").(string)
 	comments := d.Get("comments").(string)
 	locked := d.Get("locked").(bool)
@@ -108,42 +115,42 @@ func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, co
 	return securityPolicyInfraPatch(getSessionContext(d, m), obj, domain, m)
 }
 
-func resourceNsxtPolicySecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySecurityPolicyExistsPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = policySecurityPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id, true)
 
 	if err != nil {
-		return handleCreateError("Security Policy", id, err)
+		return diag.FromErr(handleCreateError("Security Policy", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicySecurityPolicyRead(d, m)
+	return resourceNsxtPolicySecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	domainName := d.Get("domain").(string)
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
 	client := domains.NewSecurityPoliciesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(domainName, id)
 	if err != nil {
-		return handleReadError(d, "SecurityPolicy", id, err)
+		return diag.FromErr(handleReadError(d, "SecurityPolicy", id, err))
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
@@ -159,28 +166,30 @@ func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{})
 	d.Set("stateful", obj.Stateful)
 	d.Set("tcp_strict", obj.TcpStrict)
 	d.Set("revision", obj.Revision)
-	return setPolicyRulesInSchema(d, obj.Rules)
+	return diag.FromErr(setPolicyRulesInSchema(d, obj.Rules))
 }
 
-func resourceNsxtPolicySecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
-	err := policySecurityPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id, false)
+	err := retryPolicyUpdateUponPreconditionFailed(d, m, func() error {
+		return policySecurityPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id, false)
+	})
 	if err != nil {
-		return handleUpdateError("Security Policy", id, err)
+		return diag.FromErr(handleUpdateError("Security Policy", id, err))
 	}
 
-	return resourceNsxtPolicySecurityPolicyRead(d, m)
+	return resourceNsxtPolicySecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
 
 	connector := getPolicyConnector(m)
@@ -189,7 +198,7 @@ func resourceNsxtPolicySecurityPolicyDelete(d *schema.ResourceData, m interface{
 	err := client.Delete(d.Get("domain").(string), id)
 
 	if err != nil {
-		return handleDeleteError("Security Policy", id, err)
+		return diag.FromErr(handleDeleteError("Security Policy", id, err))
 	}
 
 	return nil
@@ -0,0 +1,204 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	securitypolicies "github.com/vmware/terraform-provider-nsxt/api/infra/domains/security_policies"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicySecurityPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicySecurityPolicyRuleCreate,
+		ReadContext:   resourceNsxtPolicySecurityPolicyRuleRead,
+		UpdateContext: resourceNsxtPolicySecurityPolicyRuleUpdate,
+		DeleteContext: resourceNsxtPolicySecurityPolicyRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyRuleImporter,
+		},
+		Schema: getPolicyStandaloneRuleSchema(false),
+	}
+}
+
+// Schema for a rule managed as a standalone resource, outside of its parent policy
+func getPolicyStandaloneRuleSchema(scopeRequired bool) map[string]*schema.Schema {
+	ruleSchema := getPolicyRuleSchema(scopeRequired, false, false)
+	ruleSchema["nsx_id"] = getNsxIDSchema()
+	ruleSchema["path"] = getPathSchema()
+	ruleSchema["context"] = getContextSchema()
+	ruleSchema["policy_path"] = getPolicyPathSchema(true, true, "Policy path of the parent policy")
+	ruleSchema["sequence_number"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Sequence number of the this rule",
+		Required:    true,
+	}
+	return ruleSchema
+}
+
+// Builds rule data in the same format as a rule nested in policy resource,
+// in order to share conversion with policy resources
+func getPolicyStandaloneRuleData(d *schema.ResourceData) map[string]interface{} {
+	data := make(map[string]interface{})
+	for key := range getPolicyRuleSchema(false, false, false) {
+		data[key] = d.Get(key)
+	}
+	return data
+}
+
+func setPolicyStandaloneRuleInSchema(d *schema.ResourceData, rule model.Rule) error {
+	for key, value := range getPolicyRuleMap(rule) {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("error setting %s: %v", key, err)
+		}
+	}
+	d.Set("path", rule.Path)
+	return nil
+}
+
+func parseSecurityPolicyPath(policyPath string) (string, string, error) {
+	domain := getDomainFromResourcePath(policyPath)
+	policyID := getResourceIDFromResourcePath(policyPath, "security-policies")
+	if domain == "" || policyID == "" {
+		return "", "", fmt.Errorf("invalid security policy path %s", policyPath)
+	}
+	return domain, policyID, nil
+}
+
+func resourceNsxtPolicySecurityPolicyRuleExistsPartial(policyPath string) func(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return func(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+		domain, policyID, err := parseSecurityPolicyPath(policyPath)
+		if err != nil {
+			return false, err
+		}
+		client := securitypolicies.NewRulesClient(sessionContext, connector)
+		_, err = client.Get(domain, policyID, id)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Security Policy Rule", err)
+	}
+}
+
+func resourceNsxtPolicySecurityPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	policyPath := d.Get("policy_path").(string)
+	domain, policyID, err := parseSecurityPolicyPath(policyPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySecurityPolicyRuleExistsPartial(policyPath))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	obj := getPolicyRuleFromMap(getPolicyStandaloneRuleData(d), id, sequenceNumber)
+
+	log.Printf("[INFO] Creating Security Policy Rule with ID %s under policy %s", id, policyPath)
+	client := securitypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	if err := client.Patch(domain, policyID, id, obj); err != nil {
+		return diag.FromErr(handleCreateError("Security Policy Rule", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySecurityPolicyRuleRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySecurityPolicyRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Security Policy Rule ID")
+	}
+	domain, policyID, err := parseSecurityPolicyPath(d.Get("policy_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := securitypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(domain, policyID, id)
+	if err != nil {
+		return diag.FromErr(handleReadError(d, "Security Policy Rule", id, err))
+	}
+
+	return diag.FromErr(setPolicyStandaloneRuleInSchema(d, obj))
+}
+
+func resourceNsxtPolicySecurityPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Security Policy Rule ID")
+	}
+	domain, policyID, err := parseSecurityPolicyPath(d.Get("policy_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	client := securitypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	err = retryPolicyUpdateUponPreconditionFailed(d, m, func() error {
+		revision := int64(d.Get("revision").(int))
+		obj := getPolicyRuleFromMap(getPolicyStandaloneRuleData(d), id, sequenceNumber)
+		obj.Revision = &revision
+		_, err := client.Update(domain, policyID, id, obj)
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(handleUpdateError("Security Policy Rule", id, err))
+	}
+
+	return resourceNsxtPolicySecurityPolicyRuleRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySecurityPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Security Policy Rule ID")
+	}
+	domain, policyID, err := parseSecurityPolicyPath(d.Get("policy_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := securitypolicies.NewRulesClient(getSessionContext(d, m), connector)
+	if err := client.Delete(domain, policyID, id); err != nil {
+		return diag.FromErr(handleDeleteError("Security Policy Rule", id, err))
+	}
+
+	return nil
+}
+
+// Imports rule by its policy path, and populates parent policy path
+func nsxtPolicyRuleImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return rd, fmt.Errorf("policy path of the rule is expected for import, got %s", importID)
+	}
+	policyPath, err := getParameterFromPolicyPath("", "/rules/", importID)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("policy_path", policyPath)
+	return rd, nil
+}
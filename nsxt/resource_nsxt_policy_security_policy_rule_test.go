@@ -0,0 +1,184 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySecurityPolicyRule_basic(t *testing.T) {
+	testAccResourceNsxtPolicySecurityPolicyRuleBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccResourceNsxtPolicySecurityPolicyRule_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicySecurityPolicyRuleBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicySecurityPolicyRuleBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_security_policy_rule.test"
+	policyResourceName := "nsxt_policy_security_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleTemplate(name, "IN", "DROP", 10, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "direction", "IN"),
+					resource.TestCheckResourceAttr(testResourceName, "action", "DROP"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "10"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttrPair(testResourceName, "policy_path", policyResourceName, "path"),
+					resource.TestCheckResourceAttr(policyResourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleTemplate(updatedName, "OUT", "ALLOW", 20, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "direction", "OUT"),
+					resource.TestCheckResourceAttr(testResourceName, "action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "20"),
+					resource.TestCheckResourceAttr(policyResourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySecurityPolicyRule_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_security_policy_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleTemplate(name, "IN", "DROP", 10, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySecurityPolicyRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Security Policy Rule resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Security Policy Rule resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicySecurityPolicyRuleExistsPartial(rs.Primary.Attributes["policy_path"])(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Error while retrieving Policy Security Policy Rule ID %s", resourceID)
+		}
+		return nil
+	}
+}
+
+func testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_security_policy_rule" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicySecurityPolicyRuleExistsPartial(rs.Primary.Attributes["policy_path"])(testAccGetSessionContext(), resourceID, connector)
+		if err == nil && exists {
+			return fmt.Errorf("Policy Security Policy Rule %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySecurityPolicyRuleTemplate(name string, direction string, action string, sequenceNumber int, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "test" {
+%s
+  display_name           = "%s"
+  category               = "Application"
+  ignore_unmanaged_rules = true
+
+  rule {
+    display_name    = "inline"
+    sequence_number = 1
+    action          = "ALLOW"
+  }
+}
+
+resource "nsxt_policy_security_policy_rule" "test" {
+%s
+  display_name    = "%s"
+  description     = "Acceptance Test"
+  policy_path     = nsxt_policy_security_policy.test.path
+  sequence_number = %d
+  direction       = "%s"
+  action          = "%s"
+  logged          = true
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, context, name, context, name, sequenceNumber, direction, action)
+}
+
+func TestMockNsxPolicySecurityPolicyRule(t *testing.T) {
+	testMockResourceLifecycle(t, resourceNsxtPolicySecurityPolicyRule(), map[string]interface{}{
+		"display_name":    "mock-rule",
+		"policy_path":     "/infra/domains/default/security-policies/mock-policy",
+		"sequence_number": 10,
+		"action":          "DROP",
+	}, map[string]interface{}{
+		"description": "updated",
+	})
+}
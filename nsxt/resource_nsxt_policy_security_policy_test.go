@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -995,3 +997,78 @@ profiles = [nsxt_policy_context_profile.test.path]
 `
 	return testAccNsxtPolicyContextProfileTemplate("security-policy-test-profile", testAccNsxtPolicyContextProfileAttributeDomainNameTemplate(testSystemDomainName), withContext) + testAccNsxtPolicySecurityPolicyWithRule(name, direction, protocol, ruleTag, domainName, profiles, withContext)
 }
+
+func TestMockNsxPolicySecurityPolicy(t *testing.T) {
+	testMockResourceLifecycle(t, resourceNsxtPolicySecurityPolicy(), map[string]interface{}{
+		"display_name": "mock-policy",
+		"category":     "Application",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"display_name": "rule1",
+				"action":       "ALLOW",
+			},
+			map[string]interface{}{
+				"display_name": "rule2",
+				"action":       "DROP",
+			},
+		},
+	}, map[string]interface{}{
+		"description": "updated",
+	})
+}
+
+func TestMockNsxPolicySecurityPolicyIgnoreUnmanagedRules(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	policyResource := resourceNsxtPolicySecurityPolicy()
+	policy := schema.TestResourceDataRaw(t, policyResource.Schema, map[string]interface{}{
+		"display_name":           "mock-policy",
+		"category":               "Application",
+		"ignore_unmanaged_rules": true,
+		"rule": []interface{}{
+			map[string]interface{}{
+				"display_name":    "inline",
+				"sequence_number": 1,
+			},
+		},
+	})
+	if diags := policyResource.CreateContext(context.Background(), policy, m); diags.HasError() {
+		t.Fatalf("Policy create failed: %v", diags)
+	}
+
+	ruleResource := resourceNsxtPolicySecurityPolicyRule()
+	rule := schema.TestResourceDataRaw(t, ruleResource.Schema, map[string]interface{}{
+		"display_name":    "standalone",
+		"policy_path":     policy.Get("path").(string),
+		"sequence_number": 20,
+	})
+	if diags := ruleResource.CreateContext(context.Background(), rule, m); diags.HasError() {
+		t.Fatalf("Rule create failed: %v", diags)
+	}
+
+	policy.Set("description", "updated")
+	if diags := policyResource.UpdateContext(context.Background(), policy, m); diags.HasError() {
+		t.Fatalf("Policy update failed: %v", diags)
+	}
+	rules := policy.Get("rule").([]interface{})
+	if len(rules) != 1 || rules[0].(map[string]interface{})["display_name"] != "inline" {
+		t.Fatalf("Expected only inline rule in policy, got %v", rules)
+	}
+
+	if diags := ruleResource.ReadContext(context.Background(), rule, m); diags.HasError() {
+		t.Fatalf("Rule read failed: %v", diags)
+	}
+	if rule.Id() == "" {
+		t.Fatalf("Standalone rule was removed by policy update")
+	}
+
+	policy.Set("ignore_unmanaged_rules", false)
+	if diags := policyResource.ReadContext(context.Background(), policy, m); diags.HasError() {
+		t.Fatalf("Policy read failed: %v", diags)
+	}
+	if rules := policy.Get("rule").([]interface{}); len(rules) != 2 {
+		t.Fatalf("Expected 2 rules in policy, got %d", len(rules))
+	}
+}
@@ -4,15 +4,17 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNsxtPolicySegment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySegmentCreate,
-		Read:   resourceNsxtPolicySegmentRead,
-		Update: resourceNsxtPolicySegmentUpdate,
-		Delete: resourceNsxtPolicySegmentDelete,
+		CreateContext: resourceNsxtPolicySegmentCreate,
+		ReadContext:   resourceNsxtPolicySegmentRead,
+		UpdateContext: resourceNsxtPolicySegmentUpdate,
+		DeleteContext: resourceNsxtPolicySegmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -21,18 +23,18 @@ func resourceNsxtPolicySegment() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicySegmentCreate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentCreate(d, m, false, false)
+func resourceNsxtPolicySegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentCreate(d, m, false, false))
 }
 
-func resourceNsxtPolicySegmentRead(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentRead(d, m, false, false)
+func resourceNsxtPolicySegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentRead(d, m, false, false))
 }
 
-func resourceNsxtPolicySegmentUpdate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentUpdate(d, m, false, false)
+func resourceNsxtPolicySegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentUpdate(d, m, false, false))
 }
 
-func resourceNsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentDelete(d, m, false)
+func resourceNsxtPolicySegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentDelete(d, m, false))
 }
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,10 +19,10 @@ import (
 
 func resourceNsxtPolicySegmentSecurityProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySegmentSecurityProfileCreate,
-		Read:   resourceNsxtPolicySegmentSecurityProfileRead,
-		Update: resourceNsxtPolicySegmentSecurityProfileUpdate,
-		Delete: resourceNsxtPolicySegmentSecurityProfileDelete,
+		CreateContext: resourceNsxtPolicySegmentSecurityProfileCreate,
+		ReadContext:   resourceNsxtPolicySegmentSecurityProfileRead,
+		UpdateContext: resourceNsxtPolicySegmentSecurityProfileUpdate,
+		DeleteContext: resourceNsxtPolicySegmentSecurityProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -134,7 +135,7 @@ func resourceNsxtPolicySegmentSecurityProfilePatch(d *schema.ResourceData, m int
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	bpduFilterAllow := getStringListFromSchemaSet(d, "bpdu_filter_allow")
 	bpduFilterEnable := d.Get("bpdu_filter_enable").(bool)
 	dhcpClientBlockEnabled := d.Get("dhcp_client_block_enabled").(bool)
@@ -183,42 +184,42 @@ func resourceNsxtPolicySegmentSecurityProfilePatch(d *schema.ResourceData, m int
 	return client.Patch(id, obj, nil)
 }
 
-func resourceNsxtPolicySegmentSecurityProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySegmentSecurityProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = resourceNsxtPolicySegmentSecurityProfilePatch(d, m, id)
 	if err != nil {
-		return handleCreateError("SegmentSecurityProfile", id, err)
+		return diag.FromErr(handleCreateError("SegmentSecurityProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
+	return resourceNsxtPolicySegmentSecurityProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+		return diag.Errorf("Error obtaining SegmentSecurityProfile ID")
 	}
 
 	client := infra.NewSegmentSecurityProfilesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "SegmentSecurityProfile", id, err)
+		return diag.FromErr(handleReadError(d, "SegmentSecurityProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -248,25 +249,25 @@ func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m inte
 	return nil
 }
 
-func resourceNsxtPolicySegmentSecurityProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+		return diag.Errorf("Error obtaining SegmentSecurityProfile ID")
 	}
 
 	err := resourceNsxtPolicySegmentSecurityProfilePatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("SegmentSecurityProfile", id, err)
+		return diag.FromErr(handleUpdateError("SegmentSecurityProfile", id, err))
 	}
 
-	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
+	return resourceNsxtPolicySegmentSecurityProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySegmentSecurityProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+		return diag.Errorf("Error obtaining SegmentSecurityProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -274,7 +275,7 @@ func resourceNsxtPolicySegmentSecurityProfileDelete(d *schema.ResourceData, m in
 	err := client.Delete(id, nil)
 
 	if err != nil {
-		return handleDeleteError("SegmentSecurityProfile", id, err)
+		return diag.FromErr(handleDeleteError("SegmentSecurityProfile", id, err))
 	}
 
 	return nil
@@ -883,3 +883,15 @@ resource "nsxt_policy_segment" "test" {
 }
 `, context, context, name, cidr)
 }
+
+func TestMockNsxPolicySegment(t *testing.T) {
+	testMockResourceLifecycle(t, resourceNsxtPolicySegment(), map[string]interface{}{
+		"display_name":        "mock-segment",
+		"transport_zone_path": "/infra/sites/default/enforcement-points/default/transport-zones/overlay-tz",
+		"subnet": []interface{}{
+			map[string]interface{}{"cidr": "12.12.2.1/24"},
+		},
+	}, map[string]interface{}{
+		"description": "updated",
+	})
+}
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -21,10 +22,10 @@ import (
 
 func resourceNsxtPolicyService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyServiceCreate,
-		Read:   resourceNsxtPolicyServiceRead,
-		Update: resourceNsxtPolicyServiceUpdate,
-		Delete: resourceNsxtPolicyServiceDelete,
+		CreateContext: resourceNsxtPolicyServiceCreate,
+		ReadContext:   resourceNsxtPolicyServiceRead,
+		UpdateContext: resourceNsxtPolicyServiceUpdate,
+		DeleteContext: resourceNsxtPolicyServiceDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -443,21 +444,21 @@ func filterServiceEntryDisplayName(entryDisplayName string, entryID string) stri
 	return entryDisplayName
 }
 
-func resourceNsxtPolicyServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyServiceExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	serviceEntries, errc := resourceNsxtPolicyServiceGetEntriesFromSchema(d)
 	if errc != nil {
-		return fmt.Errorf("Error during Service entries conversion: %v", errc)
+		return diag.Errorf("Error during Service entries conversion: %v", errc)
 	}
 
 	obj := model.Service{
@@ -473,31 +474,31 @@ func resourceNsxtPolicyServiceCreate(d *schema.ResourceData, m interface{}) erro
 	client := infra.NewServicesClient(getSessionContext(d, m), connector)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("Service", id, err)
+		return diag.FromErr(handleCreateError("Service", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyServiceRead(d, m)
+	return resourceNsxtPolicyServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining service id")
+		return diag.Errorf("Error obtaining service id")
 	}
 
 	client := infra.NewServicesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "Service", id, err)
+		return diag.FromErr(handleReadError(d, "Service", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -517,13 +518,13 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		base, errs := converter.ConvertToGolang(entry, model.ServiceEntryBindingType())
 		resourceType := base.(model.ServiceEntry).ResourceType
 		if errs != nil {
-			return errs[0]
+			return diag.FromErr(errs[0])
 		}
 
 		if resourceType == model.ServiceEntry_RESOURCE_TYPE_ICMPTYPESERVICEENTRY {
 			icmpEntry, errs := converter.ConvertToGolang(entry, model.ICMPTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 
 			serviceEntry := icmpEntry.(model.ICMPTypeServiceEntry)
@@ -544,7 +545,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_L4PORTSETSERVICEENTRY {
 			l4Entry, errs := converter.ConvertToGolang(entry, model.L4PortSetServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 
 			serviceEntry := l4Entry.(model.L4PortSetServiceEntry)
@@ -557,7 +558,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_ETHERTYPESERVICEENTRY {
 			etherEntry, errs := converter.ConvertToGolang(entry, model.EtherTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 
 			serviceEntry := etherEntry.(model.EtherTypeServiceEntry)
@@ -568,7 +569,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_IPPROTOCOLSERVICEENTRY {
 			ipProtEntry, errs := converter.ConvertToGolang(entry, model.IPProtocolServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 
 			serviceEntry := ipProtEntry.(model.IPProtocolServiceEntry)
@@ -579,7 +580,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_ALGTYPESERVICEENTRY {
 			algEntry, errs := converter.ConvertToGolang(entry, model.ALGTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 
 			serviceEntry := algEntry.(model.ALGTypeServiceEntry)
@@ -592,7 +593,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_IGMPTYPESERVICEENTRY {
 			igmpEntry, errs := converter.ConvertToGolang(entry, model.IGMPTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 
 			serviceEntry := igmpEntry.(model.IGMPTypeServiceEntry)
@@ -602,7 +603,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_NESTEDSERVICESERVICEENTRY {
 			nestedEntry, errs := converter.ConvertToGolang(entry, model.NestedServiceServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return diag.FromErr(errs[0])
 			}
 
 			serviceEntry := nestedEntry.(model.NestedServiceServiceEntry)
@@ -612,64 +613,64 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 			nestedServiceEntriesList = append(nestedServiceEntriesList, elem)
 
 		} else {
-			return fmt.Errorf("Unrecognized Service Entry Type %s", resourceType)
+			return diag.Errorf("Unrecognized Service Entry Type %s", resourceType)
 		}
 	}
 
 	err = d.Set("icmp_entry", icmpEntriesList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("l4_port_set_entry", l4EntriesList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("igmp_entry", igmpEntriesList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("ether_type_entry", etherEntriesList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("ip_protocol_entry", ipProtEntriesList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("algorithm_entry", algEntriesList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("nested_service_entry", nestedServiceEntriesList)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining service id")
+		return diag.Errorf("Error obtaining service id")
 	}
 
 	// Read the rest of the configured parameters
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	revision := int64(d.Get("revision").(int))
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	serviceEntries, errc := resourceNsxtPolicyServiceGetEntriesFromSchema(d)
 	if errc != nil {
-		return fmt.Errorf("Error during Service entries conversion: %v", errc)
+		return diag.Errorf("Error during Service entries conversion: %v", errc)
 	}
 	obj := model.Service{
 		DisplayName:    &displayName,
@@ -684,15 +685,15 @@ func resourceNsxtPolicyServiceUpdate(d *schema.ResourceData, m interface{}) erro
 	_, err := client.Update(id, obj)
 
 	if err != nil {
-		return handleUpdateError("Service", id, err)
+		return diag.FromErr(handleUpdateError("Service", id, err))
 	}
-	return resourceNsxtPolicyServiceRead(d, m)
+	return resourceNsxtPolicyServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining service id")
+		return diag.Errorf("Error obtaining service id")
 	}
 
 	connector := getPolicyConnector(m)
@@ -705,7 +706,7 @@ func resourceNsxtPolicyServiceDelete(d *schema.ResourceData, m interface{}) erro
 	err := doDelete()
 
 	if err != nil {
-		return handleDeleteError("Service", id, err)
+		return diag.FromErr(handleDeleteError("Service", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -17,10 +18,10 @@ import (
 
 func resourceNsxtPolicySpoofGuardProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySpoofGuardProfileCreate,
-		Read:   resourceNsxtPolicySpoofGuardProfileRead,
-		Update: resourceNsxtPolicySpoofGuardProfileUpdate,
-		Delete: resourceNsxtPolicySpoofGuardProfileDelete,
+		CreateContext: resourceNsxtPolicySpoofGuardProfileCreate,
+		ReadContext:   resourceNsxtPolicySpoofGuardProfileRead,
+		UpdateContext: resourceNsxtPolicySpoofGuardProfileUpdate,
+		DeleteContext: resourceNsxtPolicySpoofGuardProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -60,7 +61,7 @@ func resourceNsxtPolicySpoofGuardProfilePatch(d *schema.ResourceData, m interfac
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	addressBindingAllowlist := d.Get("address_binding_allowlist").(bool)
 
 	obj := model.SpoofGuardProfile{
@@ -75,42 +76,42 @@ func resourceNsxtPolicySpoofGuardProfilePatch(d *schema.ResourceData, m interfac
 	return client.Patch(id, obj, nil)
 }
 
-func resourceNsxtPolicySpoofGuardProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySpoofGuardProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = resourceNsxtPolicySpoofGuardProfilePatch(d, m, id)
 	if err != nil {
-		return handleCreateError("SpoofGuardProfile", id, err)
+		return diag.FromErr(handleCreateError("SpoofGuardProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicySpoofGuardProfileRead(d, m)
+	return resourceNsxtPolicySpoofGuardProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SpoofGuardProfile ID")
+		return diag.Errorf("Error obtaining SpoofGuardProfile ID")
 	}
 
 	client := infra.NewSpoofguardProfilesClient(getSessionContext(d, m), connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "SpoofGuardProfile", id, err)
+		return diag.FromErr(handleReadError(d, "SpoofGuardProfile", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -120,25 +121,25 @@ func resourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interface
 	return nil
 }
 
-func resourceNsxtPolicySpoofGuardProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SpoofGuardProfile ID")
+		return diag.Errorf("Error obtaining SpoofGuardProfile ID")
 	}
 
 	err := resourceNsxtPolicySpoofGuardProfilePatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("SpoofGuardProfile", id, err)
+		return diag.FromErr(handleUpdateError("SpoofGuardProfile", id, err))
 	}
 
-	return resourceNsxtPolicySpoofGuardProfileRead(d, m)
+	return resourceNsxtPolicySpoofGuardProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySpoofGuardProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SpoofGuardProfile ID")
+		return diag.Errorf("Error obtaining SpoofGuardProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -146,7 +147,7 @@ func resourceNsxtPolicySpoofGuardProfileDelete(d *schema.ResourceData, m interfa
 	err := client.Delete(id, nil)
 
 	if err != nil {
-		return handleDeleteError("SpoofGuardProfile", id, err)
+		return diag.FromErr(handleDeleteError("SpoofGuardProfile", id, err))
 	}
 
 	return nil
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -22,10 +24,10 @@ import (
 
 func resourceNsxtPolicyStaticRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyStaticRouteCreate,
-		Read:   resourceNsxtPolicyStaticRouteRead,
-		Update: resourceNsxtPolicyStaticRouteUpdate,
-		Delete: resourceNsxtPolicyStaticRouteDelete,
+		CreateContext: resourceNsxtPolicyStaticRouteCreate,
+		ReadContext:   resourceNsxtPolicyStaticRouteRead,
+		UpdateContext: resourceNsxtPolicyStaticRouteUpdate,
+		DeleteContext: resourceNsxtPolicyStaticRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyStaticRouteImport,
 		},
@@ -106,17 +108,17 @@ func getNsxtPolicyStaticRouteByID(sessionContext utl.SessionContext, connector c
 	return routeClient.Get(gwID, routeID)
 }
 
-func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not a valid")
+		return diag.Errorf("gateway_path is not a valid")
 	}
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	id := d.Get("nsx_id").(string)
@@ -125,15 +127,15 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	} else {
 		_, err := getNsxtPolicyStaticRouteByID(context, connector, gwID, isT0, id)
 		if err == nil {
-			return fmt.Errorf("Static Route with nsx_id '%s' already exists", id)
+			return diag.Errorf("Static Route with nsx_id '%s' already exists", id)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 
 	var nextHopsStructs []model.RouterNexthop
@@ -173,41 +175,41 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	log.Printf("[INFO] Creating Static Route with ID %s", id)
 	err := patchNsxtPolicyStaticRoute(getSessionContext(d, m), connector, gwID, routeStruct, isT0)
 	if err != nil {
-		return handleCreateError("Static Route", id, err)
+		return diag.FromErr(handleCreateError("Static Route", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyStaticRouteRead(d, m)
+	return resourceNsxtPolicyStaticRouteRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyStaticRouteRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route ID")
+		return diag.Errorf("Error obtaining Static Route ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not a valid")
+		return diag.Errorf("gateway_path is not a valid")
 	}
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	obj, err := getNsxtPolicyStaticRouteByID(context, connector, gwID, isT0, id)
 	if err != nil {
-		return handleReadError(d, "Static Route", id, err)
+		return diag.FromErr(handleReadError(d, "Static Route", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -240,27 +242,27 @@ func resourceNsxtPolicyStaticRouteRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route ID")
+		return diag.Errorf("Error obtaining Static Route ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 
 	var nextHopsStructs []model.RouterNexthop
@@ -299,34 +301,34 @@ func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{})
 	log.Printf("[INFO] Updating Static Route with ID %s", id)
 	err := patchNsxtPolicyStaticRoute(context, connector, gwID, routeStruct, isT0)
 	if err != nil {
-		return handleUpdateError("Static Route", id, err)
+		return diag.FromErr(handleUpdateError("Static Route", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyStaticRouteRead(d, m)
+	return resourceNsxtPolicyStaticRouteRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyStaticRouteDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route ID")
+		return diag.Errorf("Error obtaining Static Route ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return diag.Errorf("gateway_path is not valid")
 	}
 	context := getSessionContext(d, m)
 	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+		return diag.FromErr(handleMultitenancyTier0Error())
 	}
 
 	err := deleteNsxtPolicyStaticRoute(context, getPolicyConnector(m), gwID, isT0, id)
 	if err != nil {
-		return handleDeleteError("Static Route", id, err)
+		return diag.FromErr(handleDeleteError("Static Route", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,10 +19,10 @@ import (
 
 func resourceNsxtPolicyStaticRouteBfdPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyStaticRouteBfdPeerCreate,
-		Read:   resourceNsxtPolicyStaticRouteBfdPeerRead,
-		Update: resourceNsxtPolicyStaticRouteBfdPeerUpdate,
-		Delete: resourceNsxtPolicyStaticRouteBfdPeerDelete,
+		CreateContext: resourceNsxtPolicyStaticRouteBfdPeerCreate,
+		ReadContext:   resourceNsxtPolicyStaticRouteBfdPeerRead,
+		UpdateContext: resourceNsxtPolicyStaticRouteBfdPeerUpdate,
+		DeleteContext: resourceNsxtPolicyStaticRouteBfdPeerDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayImporter,
 		},
@@ -85,7 +86,7 @@ func policyStaticRouteBfdPeerPatch(d *schema.ResourceData, m interface{}, gwID s
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	enabled := d.Get("enabled").(bool)
 	bfdProfilePath := d.Get("bfd_profile_path").(string)
 	peerAddress := d.Get("peer_address").(string)
@@ -126,42 +127,42 @@ func resourceNsxtPolicyStaticRouteBfdPeerExistsOnGateway(gwID string) func(id st
 	}
 }
 
-func resourceNsxtPolicyStaticRouteBfdPeerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteBfdPeerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyStaticRouteBfdPeerExistsOnGateway(gwID))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = policyStaticRouteBfdPeerPatch(d, m, gwID, id)
 	if err != nil {
-		return handleCreateError("BFD Peer", id, err)
+		return diag.FromErr(handleCreateError("BFD Peer", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyStaticRouteBfdPeerRead(d, m)
+	return resourceNsxtPolicyStaticRouteBfdPeerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyStaticRouteBfdPeerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteBfdPeerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway BFD Peer ID")
+		return diag.Errorf("Error obtaining Gateway BFD Peer ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	var obj model.StaticRouteBfdPeer
@@ -169,12 +170,12 @@ func resourceNsxtPolicyStaticRouteBfdPeerRead(d *schema.ResourceData, m interfac
 		client := gm_static_routes.NewBfdPeersClient(connector)
 		gmObj, err := client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway BFD Peer", id, err)
+			return diag.FromErr(handleReadError(d, "Gateway BFD Peer", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.StaticRouteBfdPeerBindingType(), model.StaticRouteBfdPeerBindingType())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		obj = lmObj.(model.StaticRouteBfdPeer)
 	} else {
@@ -182,13 +183,13 @@ func resourceNsxtPolicyStaticRouteBfdPeerRead(d *schema.ResourceData, m interfac
 		var err error
 		obj, err = client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway BFD Peer", id, err)
+			return diag.FromErr(handleReadError(d, "Gateway BFD Peer", id, err))
 		}
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -200,27 +201,27 @@ func resourceNsxtPolicyStaticRouteBfdPeerRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyStaticRouteBfdPeerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteBfdPeerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route Bfd Peer ID")
+		return diag.Errorf("Error obtaining Static Route Bfd Peer ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPath)
 
 	err := policyStaticRouteBfdPeerPatch(d, m, gwID, id)
 	if err != nil {
-		return handleUpdateError("BFD Peer", id, err)
+		return diag.FromErr(handleUpdateError("BFD Peer", id, err))
 	}
 
-	return resourceNsxtPolicyStaticRouteBfdPeerRead(d, m)
+	return resourceNsxtPolicyStaticRouteBfdPeerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyStaticRouteBfdPeerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteBfdPeerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route Bfd Peer ID")
+		return diag.Errorf("Error obtaining Static Route Bfd Peer ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPath)
@@ -236,7 +237,7 @@ func resourceNsxtPolicyStaticRouteBfdPeerDelete(d *schema.ResourceData, m interf
 	}
 
 	if err != nil {
-		return handleDeleteError("Static Route Bfd Peer", id, err)
+		return diag.FromErr(handleDeleteError("Static Route Bfd Peer", id, err))
 	}
 
 	return nil
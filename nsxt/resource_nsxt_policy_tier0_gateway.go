@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -43,12 +45,12 @@ var policyBGPGracefulRestartStaleRouteTimerDefault = 600
 func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayCreate,
-		Read:   resourceNsxtPolicyTier0GatewayRead,
-		Update: resourceNsxtPolicyTier0GatewayUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayDelete,
+		CreateContext: resourceNsxtPolicyTier0GatewayCreate,
+		ReadContext:   resourceNsxtPolicyTier0GatewayRead,
+		UpdateContext: resourceNsxtPolicyTier0GatewayUpdate,
+		DeleteContext: resourceNsxtPolicyTier0GatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: nsxtPolicyPathResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -436,9 +438,9 @@ func resourceNsxtPolicyTier0GatewayReadBGPConfig(d *schema.ResourceData, connect
 	return d.Set("bgp_config", bgpConfigs)
 }
 
-func getPolicyVRFConfigFromSchema(d *schema.ResourceData) *model.Tier0VrfConfig {
+func getPolicyVRFConfigFromSchema(d *schema.ResourceData, m interface{}) *model.Tier0VrfConfig {
 
-	if nsxVersionLower("3.0.0") {
+	if nsxVersionLower(m, "3.0.0") {
 		// VRF Lite is supported from 3.0.0 onwards
 		return nil
 	}
@@ -648,7 +650,7 @@ func resourceNsxtPolicyTier0GatewayBGPConfigSchemaToStruct(cfg interface{}, isVr
 	return routeStruct
 }
 
-func initSingleTier0GatewayLocaleService(context utl.SessionContext, d *schema.ResourceData, children []*data.StructValue, connector client.Connector) (*data.StructValue, error) {
+func initSingleTier0GatewayLocaleService(context utl.SessionContext, d *schema.ResourceData, children []*data.StructValue, connector client.Connector, m interface{}) (*data.StructValue, error) {
 
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	var serviceStruct *model.LocaleServices
@@ -671,7 +673,7 @@ func initSingleTier0GatewayLocaleService(context utl.SessionContext, d *schema.R
 	}
 
 	redistributionConfigs := d.Get("redistribution_config").([]interface{})
-	setLocaleServiceRedistributionConfig(redistributionConfigs, serviceStruct)
+	setLocaleServiceRedistributionConfig(redistributionConfigs, serviceStruct, m)
 
 	serviceStruct.EdgeClusterPath = &edgeClusterPath
 	if len(children) > 0 {
@@ -714,7 +716,7 @@ func initPolicyTier0ChildBgpConfig(config *model.BgpRoutingConfig) (*data.Struct
 	return dataValue.(*data.StructValue), nil
 }
 
-func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string) (model.Infra, error) {
+func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string, m interface{}) (model.Infra, error) {
 	var infraChildren, gwChildren, lsChildren []*data.StructValue
 	var infraStruct model.Infra
 	converter := bindings.NewTypeConverter()
@@ -722,7 +724,7 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	failoverMode := d.Get("failover_mode").(string)
 	defaultRuleLogging := d.Get("default_rule_logging").(bool)
 	disableFirewall := !d.Get("enable_firewall").(bool)
@@ -733,7 +735,7 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	transitSubnets := interfaceListToStringList(d.Get("transit_subnets").([]interface{}))
 	vrfTransitSubnets := interfaceListToStringList(d.Get("vrf_transit_subnets").([]interface{}))
 	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
-	vrfConfig := getPolicyVRFConfigFromSchema(d)
+	vrfConfig := getPolicyVRFConfigFromSchema(d, m)
 	dhcpPath := d.Get("dhcp_config_path").(string)
 	rdAdminAddress := d.Get("rd_admin_address").(string)
 	rdAdminField := &rdAdminAddress
@@ -759,11 +761,11 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		VrfConfig:              vrfConfig,
 	}
 
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		t0Struct.RdAdminField = rdAdminField
 	}
 
-	if nsxVersionHigherOrEqual("4.1.0") {
+	if nsxVersionHigherOrEqual(m, "4.1.0") {
 		t0Struct.VrfTransitSubnets = vrfTransitSubnets
 	}
 
@@ -799,7 +801,7 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	// The user can either define locale_service (GL or LM) or edge_cluster_path (LM only)
 	if d.HasChange("locale_service") {
 		// Update locale services only if configuration changed
-		localeServices, err := initGatewayLocaleServices(context, d, connector, listPolicyTier0GatewayLocaleServices)
+		localeServices, err := initGatewayLocaleServices(context, d, connector, listPolicyTier0GatewayLocaleServices, m)
 		if err != nil {
 			return infraStruct, err
 		}
@@ -820,7 +822,7 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 			}
 
 			var err error
-			dataValue, err := initSingleTier0GatewayLocaleService(context, d, lsChildren, connector)
+			dataValue, err := initSingleTier0GatewayLocaleService(context, d, lsChildren, connector, m)
 			if err != nil {
 				return infraStruct, err
 			}
@@ -848,46 +850,46 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	return infraStruct, nil
 }
 
-func resourceNsxtPolicyTier0GatewayCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	connector := getPolicyConnector(m)
 	context := getSessionContext(d, m)
 	isGlobalManager := context.ClientType == utl.Global
 	err := verifyPolicyTier0GatewayConfig(d, isGlobalManager)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTier0GatewayExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
+	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Using H-API to create Tier0 with ID %s", id)
 
 	err = policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), false)
 	if err != nil {
-		return handleCreateError("Tier0", id, err)
+		return diag.FromErr(handleCreateError("Tier0", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyTier0GatewayRead(d, m)
+	return resourceNsxtPolicyTier0GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier0 ID")
+		return diag.Errorf("Error obtaining Tier0 ID")
 	}
 
 	var obj model.Tier0
@@ -896,12 +898,12 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 		client := gm_infra.NewTier0sClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Tier0", id, err)
+			return diag.FromErr(handleReadError(d, "Tier0", id, err))
 		}
 
 		convertedObj, err := convertModelBindingType(gmObj, model.Tier0BindingType(), model.Tier0BindingType())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		obj = convertedObj.(model.Tier0)
@@ -911,13 +913,13 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 		client := infra.NewTier0sClient(connector)
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Tier0", id, err)
+			return diag.FromErr(handleReadError(d, "Tier0", id, err))
 		}
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("failover_mode", obj.FailoverMode)
@@ -929,12 +931,12 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	d.Set("transit_subnets", obj.TransitSubnets)
 	d.Set("vrf_transit_subnets", obj.VrfTransitSubnets)
 	d.Set("revision", obj.Revision)
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		d.Set("rd_admin_address", obj.RdAdminField)
 	}
 	vrfErr := setPolicyVRFConfigInSchema(d, obj.VrfConfig)
 	if vrfErr != nil {
-		return vrfErr
+		return diag.FromErr(vrfErr)
 	}
 
 	dhcpPaths := obj.DhcpConfigPaths
@@ -944,7 +946,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	// Get the edge cluster Id or locale services
 	localeServices, err := listPolicyTier0GatewayLocaleServices(getSessionContext(d, m), connector, id)
 	if err != nil {
-		return handleReadError(d, "Locale Service for T0", id, err)
+		return diag.FromErr(handleReadError(d, "Locale Service for T0", id, err))
 	}
 	var services []map[string]interface{}
 	intentServices, shouldSetLS := d.GetOk("locale_service")
@@ -972,7 +974,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 				if _, ok := nsxIDMap[*service.Id]; ok {
 					cfgMap["nsx_id"] = service.Id
 				}
-				redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i])
+				redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i], m)
 				if d.Get("redistribution_set").(bool) {
 					// redistribution_config is deprecated and should be
 					// assigned only if actively set by customer
@@ -987,10 +989,10 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 					d.Set("edge_cluster_path", service.EdgeClusterPath)
 					err = resourceNsxtPolicyTier0GatewayReadBGPConfig(d, connector, service)
 					if err != nil {
-						return handleReadError(d, "BGP Configuration for T0", id, err)
+						return diag.FromErr(handleReadError(d, "BGP Configuration for T0", id, err))
 					}
 
-					redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i])
+					redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i], m)
 					if d.Get("redistribution_set").(bool) {
 						d.Set("redistribution_config", redistributionConfigs)
 					} else {
@@ -1013,54 +1015,56 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	err = setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier0 %s ipv6 profiles: %v", *obj.Id, err)
+		return diag.Errorf("Failed to get Tier0 %s ipv6 profiles: %v", *obj.Id, err)
 	}
 
 	if isGlobalManager {
 		err = setPolicyGatewayIntersiteConfigInSchema(d, obj.IntersiteConfig)
 		if err != nil {
-			return fmt.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
+			return diag.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
 		}
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyTier0GatewayUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 	err := verifyPolicyTier0GatewayConfig(d, isGlobalManager)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier0 ID")
+		return diag.Errorf("Error obtaining Tier0 ID")
 	}
 
-	obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
-	if err != nil {
-		return handleUpdateError("Tier0", id, err)
-	}
-
-	log.Printf("[INFO] Using H-API to update Tier0 with ID %s", id)
+	// Locale services are listed again with each attempt, refreshing their revisions
+	err = retryPolicyUpdateUponPreconditionFailed(d, m, func() error {
+		obj, err := policyTier0GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
+		if err != nil {
+			return err
+		}
 
-	err = policyInfraPatch(getSessionContext(d, m), obj, connector, true)
+		log.Printf("[INFO] Using H-API to update Tier0 with ID %s", id)
+		return policyInfraPatch(getSessionContext(d, m), obj, connector, true)
+	})
 	if err != nil {
-		return handleUpdateError("Tier0", id, err)
+		return diag.FromErr(handleUpdateError("Tier0", id, err))
 	}
 
-	return resourceNsxtPolicyTier0GatewayRead(d, m)
+	return resourceNsxtPolicyTier0GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var infraChildren []*data.StructValue
 	converter := bindings.NewTypeConverter()
 	boolTrue := true
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier0 ID")
+		return diag.Errorf("Error obtaining Tier0 ID")
 	}
 
 	t0Type := "Tier0"
@@ -1076,7 +1080,7 @@ func resourceNsxtPolicyTier0GatewayDelete(d *schema.ResourceData, m interface{})
 	}
 	dataValue, errors := converter.ConvertToVapi(childT0, model.ChildTier0BindingType())
 	if errors != nil {
-		return fmt.Errorf("Error converting Child Tier0: %v", errors[0])
+		return diag.Errorf("Error converting Child Tier0: %v", errors[0])
 	}
 	infraChildren = append(infraChildren, dataValue.(*data.StructValue))
 
@@ -1089,7 +1093,7 @@ func resourceNsxtPolicyTier0GatewayDelete(d *schema.ResourceData, m interface{})
 	log.Printf("[DEBUG] Using H-API to delete Tier0 with ID %s", id)
 	err := policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), false)
 	if err != nil {
-		return handleDeleteError("Tier0", id, err)
+		return diag.FromErr(handleDeleteError("Tier0", id, err))
 	}
 	log.Printf("[DEBUG] Success deleting Tier0 with ID %s", id)
 
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
@@ -32,10 +34,10 @@ var gatewayInterfaceOspfNetworkTypeValues = []string{
 
 func resourceNsxtPolicyTier0GatewayInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayInterfaceCreate,
-		Read:   resourceNsxtPolicyTier0GatewayInterfaceRead,
-		Update: resourceNsxtPolicyTier0GatewayInterfaceUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayInterfaceDelete,
+		CreateContext: resourceNsxtPolicyTier0GatewayInterfaceCreate,
+		ReadContext:   resourceNsxtPolicyTier0GatewayInterfaceRead,
+		UpdateContext: resourceNsxtPolicyTier0GatewayInterfaceUpdate,
+		DeleteContext: resourceNsxtPolicyTier0GatewayInterfaceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayInterfaceImport,
 		},
@@ -144,7 +146,7 @@ func getGatewayInterfaceOspfSchema() *schema.Schema {
 }
 
 func gatewayInterfaceVersionDepenantSet(d *schema.ResourceData, m interface{}, obj *model.Tier0Interface) error {
-	if nsxVersionLower("3.0.0") {
+	if nsxVersionLower(m, "3.0.0") {
 		return nil
 	}
 	interfaceType := d.Get("type").(string)
@@ -230,7 +232,7 @@ func policyTier0GatewayInterfaceOspfSetInSchema(d *schema.ResourceData, obj *mod
 	return d.Set("ospf", ospfConfigs)
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("nsx_id").(string)
@@ -242,7 +244,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	ifType := d.Get("type").(string)
 	if len(segmentPath) == 0 && ifType != model.Tier0Interface_TYPE_LOOPBACK {
 		// segment_path in required for all interfaces other than loopback
-		return fmt.Errorf("segment_path is mandatory for interface of type %s", ifType)
+		return diag.Errorf("segment_path is mandatory for interface of type %s", ifType)
 	}
 
 	localeServiceID := ""
@@ -250,29 +252,29 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	if isPolicyGlobalManager(m) {
 		enablePIM := d.Get("enable_pim").(bool)
 		if enablePIM {
-			return fmt.Errorf("enable_pim configuration is only supported with NSX Local Manager")
+			return diag.Errorf("enable_pim configuration is only supported with NSX Local Manager")
 		}
 		if objSitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier0_gateway_interface")
+			return diag.FromErr(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier0_gateway_interface"))
 		}
 		localeServices, err := listPolicyTier0GatewayLocaleServices(context, connector, tier0ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		localeServiceID, err = getGlobalPolicyGatewayLocaleServiceIDWithSite(localeServices, objSitePath, tier0ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else {
 		if objSitePath != "" {
-			return globalManagerOnlyError()
+			return diag.FromErr(globalManagerOnlyError())
 		}
 		localeService, err := getPolicyTier0GatewayLocaleServiceWithEdgeCluster(context, tier0ID, connector)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if localeService == nil {
-			return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier0ID)
+			return diag.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier0ID)
 		}
 		localeServiceID = *localeService.Id
 	}
@@ -290,15 +292,15 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 			_, err = client.Get(tier0ID, localeServiceID, id)
 		}
 		if err == nil {
-			return fmt.Errorf("Interface with ID '%s' already exists on Tier0 Gateway %s", id, tier0ID)
+			return diag.Errorf("Interface with ID '%s' already exists on Tier0 Gateway %s", id, tier0ID)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	var ipv6ProfilePaths []string
 	if d.Get("ipv6_ndra_profile_path").(string) != "" {
@@ -330,7 +332,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 
 	err := gatewayInterfaceVersionDepenantSet(d, m, &obj)
 	if err != nil {
-		return handleCreateError("Tier0 Interface", id, err)
+		return diag.FromErr(handleCreateError("Tier0 Interface", id, err))
 	}
 
 	// Create the resource using PATCH
@@ -338,7 +340,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.Tier0InterfaceBindingType(), gm_model.Tier0InterfaceBindingType())
 		if err1 != nil {
-			return err1
+			return diag.FromErr(err1)
 		}
 		client := gm_locale_services.NewInterfacesClient(connector)
 		err = client.Patch(tier0ID, localeServiceID, id, gmObj.(gm_model.Tier0Interface), nil)
@@ -348,17 +350,17 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	}
 
 	if err != nil {
-		return handleCreateError("Tier0 Interface", id, err)
+		return diag.FromErr(handleCreateError("Tier0 Interface", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier0GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -366,7 +368,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 	tier0ID := getPolicyIDFromPath(tier0Path)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier0ID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Interface id")
+		return diag.Errorf("Error obtaining Tier0 Interface id")
 	}
 
 	var obj model.Tier0Interface
@@ -375,17 +377,17 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 		client := gm_locale_services.NewInterfacesClient(connector)
 		gmObj, err1 := client.Get(tier0ID, localeServiceID, id)
 		if err1 != nil {
-			return handleReadError(d, "Tier0 Interface", id, err1)
+			return diag.FromErr(handleReadError(d, "Tier0 Interface", id, err1))
 		}
 		lmObj, err2 := convertModelBindingType(gmObj, model.Tier0InterfaceBindingType(), model.Tier0InterfaceBindingType())
 		if err2 != nil {
-			return err2
+			return diag.FromErr(err2)
 		}
 		obj = lmObj.(model.Tier0Interface)
 		tier0Client := gm_tier0s.NewLocaleServicesClient(connector)
 		localeService, err := tier0Client.Get(tier0ID, localeServiceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		sitePath := getSitePathFromEdgePath(*localeService.EdgeClusterPath)
 		d.Set("site_path", sitePath)
@@ -394,12 +396,12 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 		obj, err = client.Get(tier0ID, localeServiceID, id)
 	}
 	if err != nil {
-		return handleReadError(d, "Tier0 Interface", id, err)
+		return diag.FromErr(handleReadError(d, "Tier0 Interface", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -443,10 +445,10 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 		d.Set("urpf_mode", model.Tier0Interface_URPF_MODE_STRICT)
 	}
 
-	return policyTier0GatewayInterfaceOspfSetInSchema(d, &obj)
+	return diag.FromErr(policyTier0GatewayInterfaceOspfSetInSchema(d, &obj))
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -454,19 +456,19 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	localeServiceID := d.Get("locale_service_id").(string)
 	tier0ID := getPolicyIDFromPath(tier0Path)
 	if id == "" || tier0ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	if isPolicyGlobalManager(m) {
 		enablePIM := d.Get("enable_pim").(bool)
 		if enablePIM {
-			return fmt.Errorf("enable_pim configuration is only supported with NSX Local Manager")
+			return diag.Errorf("enable_pim configuration is only supported with NSX Local Manager")
 		}
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	segmentPath := d.Get("segment_path").(string)
 	var ipv6ProfilePaths []string
@@ -501,13 +503,13 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 
 	err := gatewayInterfaceVersionDepenantSet(d, m, &obj)
 	if err != nil {
-		return handleUpdateError("Tier0 Interface", id, err)
+		return diag.FromErr(handleUpdateError("Tier0 Interface", id, err))
 	}
 
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.Tier0InterfaceBindingType(), gm_model.Tier0InterfaceBindingType())
 		if err1 != nil {
-			return err1
+			return diag.FromErr(err1)
 		}
 		client := gm_locale_services.NewInterfacesClient(connector)
 		_, err = client.Update(tier0ID, localeServiceID, id, gmObj.(gm_model.Tier0Interface), nil)
@@ -516,13 +518,13 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		_, err = client.Update(tier0ID, localeServiceID, id, obj, nil)
 	}
 	if err != nil {
-		return handleUpdateError("Tier0 Interface", id, err)
+		return diag.FromErr(handleUpdateError("Tier0 Interface", id, err))
 	}
 
-	return resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier0GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -530,7 +532,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m int
 	tier0ID := getPolicyIDFromPath(tier0Path)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier0ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 	var err error
 	if isPolicyGlobalManager(m) {
@@ -541,7 +543,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m int
 		err = client.Delete(tier0ID, localeServiceID, id, nil)
 	}
 	if err != nil {
-		return handleDeleteError("Tier0 Interface", id, err)
+		return diag.FromErr(handleDeleteError("Tier0 Interface", id, err))
 	}
 
 	return nil
@@ -550,8 +552,15 @@ func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m int
 func resourceNsxtPolicyTier0GatewayInterfaceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
+	if isPolicyPath(importID) {
+		isT0, gwID, localeServiceID, interfaceID := parseGatewayInterfacePolicyPath(importID)
+		if !isT0 || gwID == "" {
+			return nil, fmt.Errorf("Invalid Tier0 interface path %s", importID)
+		}
+		s = []string{gwID, localeServiceID, interfaceID}
+	}
 	if len(s) != 3 {
-		return nil, fmt.Errorf("Please provide <gateway-id>/<locale-service-id>/<interface-id> as an input")
+		return nil, fmt.Errorf("Please provide <gateway-id>/<locale-service-id>/<interface-id> or policy path as an input")
 	}
 
 	gwID := s[0]
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -56,10 +58,10 @@ var t1TypeValues = []string{
 
 func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier1GatewayCreate,
-		Read:   resourceNsxtPolicyTier1GatewayRead,
-		Update: resourceNsxtPolicyTier1GatewayUpdate,
-		Delete: resourceNsxtPolicyTier1GatewayDelete,
+		CreateContext: resourceNsxtPolicyTier1GatewayCreate,
+		ReadContext:   resourceNsxtPolicyTier1GatewayRead,
+		UpdateContext: resourceNsxtPolicyTier1GatewayUpdate,
+		DeleteContext: resourceNsxtPolicyTier1GatewayDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -317,8 +319,8 @@ func resourceNsxtPolicyTier1GatewaySetQos(d *schema.ResourceData, obj *model.Tie
 
 }
 
-func resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d *schema.ResourceData, obj *model.Tier1) {
-	if nsxVersionLower("3.0.0") {
+func resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d *schema.ResourceData, obj *model.Tier1, m interface{}) {
+	if nsxVersionLower(m, "3.0.0") {
 		return
 	}
 
@@ -360,14 +362,14 @@ func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.R
 	return initChildLocaleService(serviceStruct, false)
 }
 
-func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string) (model.Infra, error) {
+func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string, m interface{}) (model.Infra, error) {
 	var infraChildren, gwChildren []*data.StructValue
 	var infraStruct model.Infra
 	converter := bindings.NewTypeConverter()
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	failoverMode := d.Get("failover_mode").(string)
 	defaultRuleLogging := d.Get("default_rule_logging").(bool)
 	disableFirewall := !d.Get("enable_firewall").(bool)
@@ -382,7 +384,7 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	connectivityType := d.Get("type").(string)
 	revision := int64(d.Get("revision").(int))
 
-	if haMode == model.Tier1_HA_MODE_ACTIVE && nsxVersionLower("4.0.0") {
+	if haMode == model.Tier1_HA_MODE_ACTIVE && nsxVersionLower(m, "4.0.0") {
 		return infraStruct, fmt.Errorf("ACTIVE_ACTIVE HA mode is not supported in NSX versions lower than 4.0.0. Use ACTIVE_BACKUP instead")
 	}
 
@@ -404,7 +406,7 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		ResourceType:            &t1Type,
 	}
 
-	if nsxVersionHigherOrEqual("3.2.0") {
+	if nsxVersionHigherOrEqual(m, "3.2.0") {
 		if haMode != "NONE" && haMode != "" {
 			obj.HaMode = &haMode
 		}
@@ -424,7 +426,7 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		obj.Revision = &revision
 	}
 
-	resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d, &obj)
+	resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d, &obj, m)
 
 	if context.ClientType == utl.Global {
 		intersiteConfig := getPolicyGatewayIntersiteConfigFromSchema(d)
@@ -443,7 +445,7 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 
 	if d.HasChange("locale_service") {
 		// Update locale services only if configuration changed
-		localeServices, err := initGatewayLocaleServices(context, d, connector, listPolicyTier1GatewayLocaleServices)
+		localeServices, err := initGatewayLocaleServices(context, d, connector, listPolicyTier1GatewayLocaleServices, m)
 		if err != nil {
 			return infraStruct, err
 		}
@@ -487,45 +489,45 @@ func validateTier1Type(d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceNsxtPolicyTier1GatewayCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyTier1GatewayExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = validateTier1Type(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
+	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Using H-API to create Tier1 with ID %s", id)
 	err = policyInfraPatch(getSessionContext(d, m), obj, connector, false)
 	if err != nil {
-		return handleCreateError("Tier1", id, err)
+		return diag.FromErr(handleCreateError("Tier1", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyTier1GatewayRead(d, m)
+	return resourceNsxtPolicyTier1GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier1 id")
+		return diag.Errorf("Error obtaining Tier1 id")
 	}
 
 	context := getSessionContext(d, m)
@@ -533,12 +535,12 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 	obj, err := client.Get(id)
 
 	if err != nil {
-		return handleReadError(d, "Tier1", id, err)
+		return diag.FromErr(handleReadError(d, "Tier1", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("failover_mode", obj.FailoverMode)
@@ -546,7 +548,7 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 	d.Set("enable_firewall", !(*obj.DisableFirewall))
 	d.Set("enable_standby_relocation", obj.EnableStandbyRelocation)
 	d.Set("force_whitelisting", obj.ForceWhitelisting)
-	if nsxVersionHigherOrEqual("3.2.0") {
+	if nsxVersionHigherOrEqual(m, "3.2.0") {
 		if obj.HaMode == nil {
 			d.Set("ha_mode", "NONE")
 		} else {
@@ -579,7 +581,7 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 	// Get the edge cluster Id or locale services
 	localeServices, err := listPolicyTier1GatewayLocaleServices(context, connector, id)
 	if err != nil {
-		return handleReadError(d, "Locale Service for T1", id, err)
+		return diag.FromErr(handleReadError(d, "Locale Service for T1", id, err))
 	}
 	var services []map[string]interface{}
 	intentServices, shouldSetLS := d.GetOk("locale_service")
@@ -625,50 +627,53 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	err = setAdvRulesInSchema(d, obj.RouteAdvertisementRules)
 	if err != nil {
-		return fmt.Errorf("Error during Tier1 advertisement rules set in schema: %v", err)
+		return diag.Errorf("Error during Tier1 advertisement rules set in schema: %v", err)
 	}
 
 	err = setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier1 %s ipv6 profiles: %v", *obj.Id, err)
+		return diag.Errorf("Failed to get Tier1 %s ipv6 profiles: %v", *obj.Id, err)
 	}
 
 	if context.ClientType == utl.Global {
 		err = setPolicyGatewayIntersiteConfigInSchema(d, obj.IntersiteConfig)
 		if err != nil {
-			return fmt.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
+			return diag.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
 		}
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyTier1GatewayUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier1 id")
+		return diag.Errorf("Error obtaining Tier1 id")
 	}
 
-	obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id)
-	if err != nil {
-		return err
-	}
+	// Locale services are listed again with each attempt, refreshing their revisions
+	err := retryPolicyUpdateUponPreconditionFailed(d, m, func() error {
+		obj, err := policyTier1GatewayResourceToInfraStruct(getSessionContext(d, m), d, connector, id, m)
+		if err != nil {
+			return err
+		}
 
-	log.Printf("[INFO] Using H-API to update Tier1 with ID %s", id)
-	err = policyInfraPatch(getSessionContext(d, m), obj, connector, true)
+		log.Printf("[INFO] Using H-API to update Tier1 with ID %s", id)
+		return policyInfraPatch(getSessionContext(d, m), obj, connector, true)
+	})
 	if err != nil {
-		return handleUpdateError("Tier1", id, err)
+		return diag.FromErr(handleUpdateError("Tier1", id, err))
 	}
 
-	return resourceNsxtPolicyTier1GatewayRead(d, m)
+	return resourceNsxtPolicyTier1GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier1 id")
+		return diag.Errorf("Error obtaining Tier1 id")
 	}
 
 	var infraChildren []*data.StructValue
@@ -686,7 +691,7 @@ func resourceNsxtPolicyTier1GatewayDelete(d *schema.ResourceData, m interface{})
 	}
 	dataValue, errors := converter.ConvertToVapi(childT1, model.ChildTier1BindingType())
 	if errors != nil {
-		return fmt.Errorf("Error converting Child Tier1: %v", errors[0])
+		return diag.Errorf("Error converting Child Tier1: %v", errors[0])
 	}
 	infraChildren = append(infraChildren, dataValue.(*data.StructValue))
 	infraType := "Infra"
@@ -698,7 +703,7 @@ func resourceNsxtPolicyTier1GatewayDelete(d *schema.ResourceData, m interface{})
 	log.Printf("[DEBUG] Using H-API to delete Tier1 with ID %s", id)
 	err := policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), false)
 	if err != nil {
-		return handleDeleteError("Tier1", id, err)
+		return diag.FromErr(handleDeleteError("Tier1", id, err))
 	}
 	log.Printf("[DEBUG] Success deleting Tier1 with ID %s", id)
 
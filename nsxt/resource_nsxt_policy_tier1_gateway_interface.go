@@ -4,12 +4,14 @@
 package nsxt
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gm_tier1s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s"
 	gm_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s/locale_services"
@@ -22,10 +24,10 @@ import (
 
 func resourceNsxtPolicyTier1GatewayInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier1GatewayInterfaceCreate,
-		Read:   resourceNsxtPolicyTier1GatewayInterfaceRead,
-		Update: resourceNsxtPolicyTier1GatewayInterfaceUpdate,
-		Delete: resourceNsxtPolicyTier1GatewayInterfaceDelete,
+		CreateContext: resourceNsxtPolicyTier1GatewayInterfaceCreate,
+		ReadContext:   resourceNsxtPolicyTier1GatewayInterfaceRead,
+		UpdateContext: resourceNsxtPolicyTier1GatewayInterfaceUpdate,
+		DeleteContext: resourceNsxtPolicyTier1GatewayInterfaceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier1GatewayInterfaceImport,
 		},
@@ -79,7 +81,7 @@ func getGatewayInterfaceSubnetList(d *schema.ResourceData) []model.InterfaceSubn
 	return interfaceSubnetList
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("nsx_id").(string)
@@ -90,27 +92,27 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 	context := getSessionContext(d, m)
 	if isPolicyGlobalManager(m) {
 		if sitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier1_gateway_interface")
+			return diag.FromErr(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier1_gateway_interface"))
 		}
 		localeServices, err := listPolicyTier1GatewayLocaleServices(context, connector, tier1ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		localeServiceID, err = getGlobalPolicyGatewayLocaleServiceIDWithSite(localeServices, sitePath, tier1ID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 	} else {
 		if sitePath != "" {
-			return globalManagerOnlyError()
+			return diag.FromErr(globalManagerOnlyError())
 		}
 		localeService, err := getPolicyTier1GatewayLocaleServiceEntry(context, tier1ID, connector)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if localeService == nil {
-			return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier1ID)
+			return diag.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier1ID)
 		}
 		localeServiceID = *localeService.Id
 	}
@@ -123,16 +125,16 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 		_, err = client.Get(tier1ID, localeServiceID, id)
 
 		if err == nil {
-			return fmt.Errorf("Interface with ID '%s' already exists on Tier1 Gateway %s", id, tier1ID)
+			return diag.Errorf("Interface with ID '%s' already exists on Tier1 Gateway %s", id, tier1ID)
 		} else if !isNotFoundError(err) {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	segmentPath := d.Get("segment_path").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	var ipv6ProfilePaths []string
 	if d.Get("ipv6_ndra_profile_path").(string) != "" {
@@ -153,7 +155,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 		obj.Mtu = &mtu
 	}
 
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		urpfMode := d.Get("urpf_mode").(string)
 		obj.UrpfMode = &urpfMode
 	}
@@ -164,24 +166,24 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 	err := client.Patch(tier1ID, localeServiceID, id, obj)
 
 	if err != nil {
-		return handleCreateError("Tier1 Interface", id, err)
+		return diag.FromErr(handleCreateError("Tier1 Interface", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier1GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	tier1Path := d.Get("gateway_path").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	tier1ID := getPolicyIDFromPath(tier1Path)
 	if id == "" || tier1ID == "" {
-		return fmt.Errorf("Error obtaining Tier1 Interface id")
+		return diag.Errorf("Error obtaining Tier1 Interface id")
 	}
 
 	var obj model.Tier1Interface
@@ -189,17 +191,17 @@ func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m inter
 		client := gm_locale_services.NewInterfacesClient(connector)
 		gmObj, err := client.Get(tier1ID, localeServiceID, id)
 		if err != nil {
-			return handleReadError(d, "Tier1 Interface", id, err)
+			return diag.FromErr(handleReadError(d, "Tier1 Interface", id, err))
 		}
 		lmObj, err1 := convertModelBindingType(gmObj, gm_model.Tier1InterfaceBindingType(), model.Tier1InterfaceBindingType())
 		if err1 != nil {
-			return err1
+			return diag.FromErr(err1)
 		}
 		obj = lmObj.(model.Tier1Interface)
 		tier1Client := gm_tier1s.NewLocaleServicesClient(connector)
 		localeService, err := tier1Client.Get(tier1ID, localeServiceID)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		sitePath := getSitePathFromEdgePath(*localeService.EdgeClusterPath)
 		d.Set("site_path", sitePath)
@@ -208,13 +210,13 @@ func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m inter
 		client := localeservices.NewInterfacesClient(getSessionContext(d, m), connector)
 		obj, err = client.Get(tier1ID, localeServiceID, id)
 		if err != nil {
-			return handleReadError(d, "Tier1 Interface", id, err)
+			return diag.FromErr(handleReadError(d, "Tier1 Interface", id, err))
 		}
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -243,7 +245,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -251,12 +253,12 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	localeServiceID := d.Get("locale_service_id").(string)
 	tier1ID := getPolicyIDFromPath(tier1Path)
 	if id == "" || tier1ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier1 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier1 id or Locale Service id")
 	}
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	segmentPath := d.Get("segment_path").(string)
 	var ipv6ProfilePaths []string
@@ -279,7 +281,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		obj.Mtu = &mtu
 	}
 
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		urpfMode := d.Get("urpf_mode").(string)
 		obj.UrpfMode = &urpfMode
 	}
@@ -287,13 +289,13 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	client := localeservices.NewInterfacesClient(getSessionContext(d, m), connector)
 	_, err = client.Update(tier1ID, localeServiceID, id, obj)
 	if err != nil {
-		return handleUpdateError("Tier1 Interface", id, err)
+		return diag.FromErr(handleUpdateError("Tier1 Interface", id, err))
 	}
 
-	return resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier1GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -301,14 +303,14 @@ func resourceNsxtPolicyTier1GatewayInterfaceDelete(d *schema.ResourceData, m int
 	tier1ID := getPolicyIDFromPath(tier1Path)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier1ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier1 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier1 id or Locale Service id")
 	}
 
 	var err error
 	client := localeservices.NewInterfacesClient(getSessionContext(d, m), connector)
 	err = client.Delete(tier1ID, localeServiceID, id)
 	if err != nil {
-		return handleDeleteError("Tier1 Interface", id, err)
+		return diag.FromErr(handleDeleteError("Tier1 Interface", id, err))
 	}
 
 	return nil
@@ -829,3 +829,12 @@ resource "nsxt_policy_tier1_gateway" "test" {
   display_name             = "%s"
 }`, profileName, name)
 }
+
+func TestMockNsxPolicyTier1Gateway(t *testing.T) {
+	testMockResourceLifecycle(t, resourceNsxtPolicyTier1Gateway(), map[string]interface{}{
+		"display_name": "mock-tier1",
+		"tier0_path":   "/infra/tier-0s/" + getTier0RouterName(),
+	}, map[string]interface{}{
+		"description": "updated",
+	})
+}
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -20,10 +22,10 @@ var defaultInfraSitePath = "/infra/sites/default"
 
 func resourceNsxtPolicyTransportZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTransportZoneCreate,
-		Read:   resourceNsxtPolicyTransportZoneRead,
-		Update: resourceNsxtPolicyTransportZoneUpdate,
-		Delete: resourceNsxtPolicyTransportZoneDelete,
+		CreateContext: resourceNsxtPolicyTransportZoneCreate,
+		ReadContext:   resourceNsxtPolicyTransportZoneRead,
+		UpdateContext: resourceNsxtPolicyTransportZoneUpdate,
+		DeleteContext: resourceNsxtPolicyTransportZoneDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTransportZoneImporter,
 		},
@@ -122,7 +124,7 @@ func policyTransportZonePatch(siteID, epID, tzID string, d *schema.ResourceData,
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	isDefault := d.Get("is_default").(bool)
 	transportType := d.Get("transport_type").(string)
 	uplinkTeamingNames := getStringListFromSchemaList(d, "uplink_teaming_policy_names")
@@ -166,7 +168,7 @@ func policyIDSiteEPTuple(d *schema.ResourceData, m interface{}) (id, siteID, epI
 	return
 }
 
-func resourceNsxtPolicyTransportZoneCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTransportZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("nsx_id").(string)
@@ -176,7 +178,7 @@ func resourceNsxtPolicyTransportZoneCreate(d *schema.ResourceData, m interface{}
 	sitePath := d.Get("site_path").(string)
 	siteID := getResourceIDFromResourcePath(sitePath, "sites")
 	if siteID == "" {
-		return fmt.Errorf("error obtaining Site ID from site path %s", sitePath)
+		return diag.Errorf("error obtaining Site ID from site path %s", sitePath)
 	}
 	epID := d.Get("enforcement_point").(string)
 	if epID == "" {
@@ -184,48 +186,48 @@ func resourceNsxtPolicyTransportZoneCreate(d *schema.ResourceData, m interface{}
 	}
 	exists, err := resourceNsxtPolicyTransportZoneExists(siteID, epID, id, connector)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if exists {
-		return fmt.Errorf("resource with ID %s already exists", id)
+		return diag.Errorf("resource with ID %s already exists", id)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating TransportZone with ID %s under site %s enforcement point %s", id, siteID, epID)
 	err = policyTransportZonePatch(siteID, epID, id, d, m)
 	if err != nil {
-		return handleCreateError("TransportZone", id, err)
+		return diag.FromErr(handleCreateError("TransportZone", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyTransportZoneRead(d, m)
+	return resourceNsxtPolicyTransportZoneRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTransportZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	tzClient := enforcement_points.NewTransportZonesClient(connector)
 
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj, err := tzClient.Get(siteID, epID, id)
 	if err != nil {
-		return handleReadError(d, "TransportZone", id, err)
+		return diag.FromErr(handleReadError(d, "TransportZone", id, err))
 	}
 	sitePath, err := getSitePathFromChildResourcePath(*obj.ParentPath)
 	if err != nil {
-		return handleReadError(d, "TransportZone", id, err)
+		return diag.FromErr(handleReadError(d, "TransportZone", id, err))
 	}
 
 	d.Set("site_path", sitePath)
 	d.Set("enforcement_point", epID)
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -237,34 +239,34 @@ func resourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNsxtPolicyTransportZoneUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTransportZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Updating TransportZone with ID %s", id)
 	err = policyTransportZonePatch(siteID, epID, id, d, m)
 	if err != nil {
-		return handleUpdateError("TransportZone", id, err)
+		return diag.FromErr(handleUpdateError("TransportZone", id, err))
 	}
 
-	return resourceNsxtPolicyTransportZoneRead(d, m)
+	return resourceNsxtPolicyTransportZoneRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTransportZoneDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTransportZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	tzClient := enforcement_points.NewTransportZonesClient(connector)
 
 	id, siteID, epID, err := policyIDSiteEPTuple(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Deleting TransportZone with ID %s", id)
 	err = tzClient.Delete(siteID, epID, id)
 	if err != nil {
-		return handleDeleteError("TransportZone", id, err)
+		return diag.FromErr(handleDeleteError("TransportZone", id, err))
 	}
 
 	return nil
@@ -4,8 +4,9 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -51,10 +52,10 @@ var overlayEncapModes = []string{
 
 func resourceNsxtUplinkHostSwitchProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtUplinkHostSwitchProfileCreate,
-		Read:   resourceNsxtUplinkHostSwitchProfileRead,
-		Update: resourceNsxtUplinkHostSwitchProfileUpdate,
-		Delete: resourceNsxtUplinkHostSwitchProfileDelete,
+		CreateContext: resourceNsxtUplinkHostSwitchProfileCreate,
+		ReadContext:   resourceNsxtUplinkHostSwitchProfileRead,
+		UpdateContext: resourceNsxtUplinkHostSwitchProfileUpdate,
+		DeleteContext: resourceNsxtUplinkHostSwitchProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -295,39 +296,39 @@ func resourceNsxtUplinkHostSwitchProfileExists(id string, connector client.Conne
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtUplinkHostSwitchProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUplinkHostSwitchProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining UplinkHostSwitchProfile ID")
+		return diag.Errorf("Error obtaining UplinkHostSwitchProfile ID")
 	}
 	client := infra.NewHostSwitchProfilesClient(connector)
 	structValue, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "UplinkHostSwitchProfile", id, err)
+		return diag.FromErr(handleReadError(d, "UplinkHostSwitchProfile", id, err))
 	}
 
 	converter := bindings.NewTypeConverter()
 	baseInterface, errs := converter.ConvertToGolang(structValue, model.PolicyBaseHostSwitchProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 	base := baseInterface.(model.PolicyBaseHostSwitchProfile)
 
 	resourceType := base.ResourceType
 	if resourceType != infra.HostSwitchProfiles_LIST_HOSTSWITCH_PROFILE_TYPE_POLICYUPLINKHOSTSWITCHPROFILE {
-		return handleReadError(d, "UplinkHostSwitchProfile", id, err)
+		return diag.FromErr(handleReadError(d, "UplinkHostSwitchProfile", id, err))
 	}
 
 	uplinkInterface, errs := converter.ConvertToGolang(structValue, model.PolicyUplinkHostSwitchProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 	uplinkHostSwitchProfile := uplinkInterface.(model.PolicyUplinkHostSwitchProfile)
 	d.Set("display_name", uplinkHostSwitchProfile.DisplayName)
 	d.Set("description", uplinkHostSwitchProfile.Description)
-	setPolicyTagsInSchema(d, uplinkHostSwitchProfile.Tags)
+	setPolicyTagsInSchema(d, uplinkHostSwitchProfile.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", uplinkHostSwitchProfile.Path)
 	d.Set("revision", uplinkHostSwitchProfile.Revision)
@@ -352,10 +353,10 @@ func uplinkSchemaToModelList(schema interface{}) []model.Uplink {
 	return uplinkList
 }
 
-func uplinkHostSwitchProfileSchemaToModel(d *schema.ResourceData) model.PolicyUplinkHostSwitchProfile {
+func uplinkHostSwitchProfileSchemaToModel(d *schema.ResourceData, m interface{}) model.PolicyUplinkHostSwitchProfile {
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 
 	var lags []model.Lag
 	lagsList := d.Get("lag").([]interface{})
@@ -425,70 +426,70 @@ func uplinkHostSwitchProfileSchemaToModel(d *schema.ResourceData) model.PolicyUp
 	return uplinkHostSwitchProfile
 }
 
-func resourceNsxtUplinkHostSwitchProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUplinkHostSwitchProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtUplinkHostSwitchProfileExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewHostSwitchProfilesClient(connector)
 	converter := bindings.NewTypeConverter()
 
-	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d)
+	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d, m)
 	profileValue, errs := converter.ConvertToVapi(uplinkHostSwitchProfile, model.PolicyUplinkHostSwitchProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 	profileStruct := profileValue.(*data.StructValue)
 	_, err = client.Patch(id, profileStruct)
 	if err != nil {
-		return handleCreateError("UplinkHostSwitchProfile", id, err)
+		return diag.FromErr(handleCreateError("UplinkHostSwitchProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtUplinkHostSwitchProfileRead(d, m)
+	return resourceNsxtUplinkHostSwitchProfileRead(ctx, d, m)
 }
 
-func resourceNsxtUplinkHostSwitchProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUplinkHostSwitchProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining UplinkHostSwitchProfile ID")
+		return diag.Errorf("Error obtaining UplinkHostSwitchProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewHostSwitchProfilesClient(connector)
 	converter := bindings.NewTypeConverter()
 
-	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d)
+	uplinkHostSwitchProfile := uplinkHostSwitchProfileSchemaToModel(d, m)
 	revision := int64(d.Get("revision").(int))
 	uplinkHostSwitchProfile.Revision = &revision
 	profileValue, errs := converter.ConvertToVapi(uplinkHostSwitchProfile, model.PolicyUplinkHostSwitchProfileBindingType())
 	if errs != nil {
-		return errs[0]
+		return diag.FromErr(errs[0])
 	}
 	profileStruct := profileValue.(*data.StructValue)
 	_, err := client.Update(id, profileStruct)
 	if err != nil {
-		return handleUpdateError("UplinkHostSwitchProfile", id, err)
+		return diag.FromErr(handleUpdateError("UplinkHostSwitchProfile", id, err))
 	}
 
-	return resourceNsxtUplinkHostSwitchProfileRead(d, m)
+	return resourceNsxtUplinkHostSwitchProfileRead(ctx, d, m)
 }
 
-func resourceNsxtUplinkHostSwitchProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtUplinkHostSwitchProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining UplinkHostSwitchProfile ID")
+		return diag.Errorf("error obtaining UplinkHostSwitchProfile ID")
 	}
 	connector := getPolicyConnector(m)
 	client := infra.NewHostSwitchProfilesClient(connector)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("UplinkHostSwitchProfile", id, err)
+		return diag.FromErr(handleDeleteError("UplinkHostSwitchProfile", id, err))
 	}
 	return nil
 }
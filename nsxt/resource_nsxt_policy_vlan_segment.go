@@ -4,6 +4,8 @@
 package nsxt
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,10 +15,10 @@ func resourceNsxtPolicyVlanSegment() *schema.Resource {
 	delete(segSchema, "connectivity_path")
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVlanSegmentCreate,
-		Read:   resourceNsxtPolicyVlanSegmentRead,
-		Update: resourceNsxtPolicyVlanSegmentUpdate,
-		Delete: resourceNsxtPolicyVlanSegmentDelete,
+		CreateContext: resourceNsxtPolicyVlanSegmentCreate,
+		ReadContext:   resourceNsxtPolicyVlanSegmentRead,
+		UpdateContext: resourceNsxtPolicyVlanSegmentUpdate,
+		DeleteContext: resourceNsxtPolicyVlanSegmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -25,18 +27,18 @@ func resourceNsxtPolicyVlanSegment() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyVlanSegmentCreate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentCreate(d, m, true, false)
+func resourceNsxtPolicyVlanSegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentCreate(d, m, true, false))
 }
 
-func resourceNsxtPolicyVlanSegmentRead(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentRead(d, m, true, false)
+func resourceNsxtPolicyVlanSegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentRead(d, m, true, false))
 }
 
-func resourceNsxtPolicyVlanSegmentUpdate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentUpdate(d, m, true, false)
+func resourceNsxtPolicyVlanSegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentUpdate(d, m, true, false))
 }
 
-func resourceNsxtPolicyVlanSegmentDelete(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentDelete(d, m, false)
+func resourceNsxtPolicyVlanSegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return diag.FromErr(nsxtPolicySegmentDelete(d, m, false))
 }
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -16,10 +17,10 @@ import (
 
 func resourceNsxtPolicyVniPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVniPoolCreate,
-		Read:   resourceNsxtPolicyVniPoolRead,
-		Update: resourceNsxtPolicyVniPoolUpdate,
-		Delete: resourceNsxtPolicyVniPoolDelete,
+		CreateContext: resourceNsxtPolicyVniPoolCreate,
+		ReadContext:   resourceNsxtPolicyVniPoolRead,
+		UpdateContext: resourceNsxtPolicyVniPoolUpdate,
+		DeleteContext: resourceNsxtPolicyVniPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -67,7 +68,7 @@ func policyVniPoolPatch(id string, d *schema.ResourceData, m interface{}) error
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	start := int64(d.Get("start").(int))
 	end := int64(d.Get("end").(int))
 
@@ -84,42 +85,42 @@ func policyVniPoolPatch(id string, d *schema.ResourceData, m interface{}) error
 	return client.Patch(id, obj)
 }
 
-func resourceNsxtPolicyVniPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyVniPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyVniPoolExists)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating VNI Pool with ID %s", id)
 	err = policyVniPoolPatch(id, d, m)
 	if err != nil {
-		return handleCreateError("VNI Pool", id, err)
+		return diag.FromErr(handleCreateError("VNI Pool", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyVniPoolRead(d, m)
+	return resourceNsxtPolicyVniPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyVniPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining VNI Pool ID")
+		return diag.Errorf("Error obtaining VNI Pool ID")
 	}
 
 	client := infra.NewVniPoolsClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "VNI Pool", id, err)
+		return diag.FromErr(handleReadError(d, "VNI Pool", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 
 	d.Set("start", obj.Start)
 	d.Set("end", obj.End)
@@ -131,25 +132,25 @@ func resourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtPolicyVniPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyVniPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining VNI Pool ID")
+		return diag.Errorf("Error obtaining VNI Pool ID")
 	}
 
 	log.Printf("[INFO] Creating VNI POOL with ID %s", id)
 	err := policyVniPoolPatch(id, d, m)
 	if err != nil {
-		return handleUpdateError("VNI pool", id, err)
+		return diag.FromErr(handleUpdateError("VNI pool", id, err))
 	}
 
-	return resourceNsxtPolicyVniPoolRead(d, m)
+	return resourceNsxtPolicyVniPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyVniPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyVniPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining VNI Pool ID")
+		return diag.Errorf("Error obtaining VNI Pool ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -157,7 +158,7 @@ func resourceNsxtPolicyVniPoolDelete(d *schema.ResourceData, m interface{}) erro
 	err := client.Delete(id)
 
 	if err != nil {
-		return handleDeleteError("VNI Pool", id, err)
+		return diag.FromErr(handleDeleteError("VNI Pool", id, err))
 	}
 
 	return nil
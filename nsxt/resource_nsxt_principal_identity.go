@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mpModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/trust_management"
@@ -16,9 +18,9 @@ import (
 
 func resourceNsxtPrincipleIdentity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPrincipleIdentityCreate,
-		Read:   resourceNsxtPrincipleIdentityRead,
-		Delete: resourceNsxtPrincipleIdentityDelete,
+		CreateContext: resourceNsxtPrincipleIdentityCreate,
+		ReadContext:   resourceNsxtPrincipleIdentityRead,
+		DeleteContext: resourceNsxtPrincipleIdentityDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -113,11 +115,11 @@ func convertToPolicyRolesForPath(mpRolesForPath []mpModel.RolesForPath) []policy
 	return pRolesForPath
 }
 
-func resourceNsxtPrincipleIdentityCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPrincipleIdentityCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := principal_identities.NewWithCertificateClient(connector)
 
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	isProtected := d.Get("is_protected").(bool)
 	name := d.Get("name").(string)
 	nodeID := d.Get("node_id").(string)
@@ -135,26 +137,26 @@ func resourceNsxtPrincipleIdentityCreate(d *schema.ResourceData, m interface{})
 
 	pi, err := client.Create(piObj)
 	if err != nil {
-		return handleCreateError("PrincipalIdentity", name, err)
+		return diag.FromErr(handleCreateError("PrincipalIdentity", name, err))
 	}
 	d.SetId(*pi.Id)
 
-	return resourceNsxtPrincipleIdentityRead(d, m)
+	return resourceNsxtPrincipleIdentityRead(ctx, d, m)
 }
 
-func resourceNsxtPrincipleIdentityRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPrincipleIdentityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := trust_management.NewPrincipalIdentitiesClient(connector)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 	piObj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "PrincipalIdentity", id, err)
+		return diag.FromErr(handleReadError(d, "PrincipalIdentity", id, err))
 	}
 
-	setMPTagsInSchema(d, piObj.Tags)
+	setMPTagsInSchema(d, piObj.Tags, m)
 	d.Set("is_protected", piObj.IsProtected)
 	d.Set("name", piObj.Name)
 	d.Set("node_id", piObj.NodeId)
@@ -163,19 +165,19 @@ func resourceNsxtPrincipleIdentityRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtPrincipleIdentityDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPrincipleIdentityDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	piClient := trust_management.NewPrincipalIdentitiesClient(connector)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 	certID := d.Get("certificate_id").(string)
 	err := piClient.Delete(id)
 	if err != nil {
 		// In case PI is already deleted, do not return here to attempt cert deletion.
 		if handledErr := handleDeleteError("PrincipalIdentity", id, err); handledErr != nil {
-			return handledErr
+			return diag.FromErr(handledErr)
 		}
 	}
 
@@ -184,9 +186,9 @@ func resourceNsxtPrincipleIdentityDelete(d *schema.ResourceData, m interface{})
 		certClient := trust_management.NewCertificatesClient(connector)
 		err := certClient.Delete(certID)
 		if err != nil {
-			return handleDeleteError("Certificate", certID, err)
+			return diag.FromErr(handleDeleteError("Certificate", certID, err))
 		}
 	}
 
-	return err
+	return diag.FromErr(err)
 }
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -23,10 +25,10 @@ var rateShaperResourceTypes = []string{"IngressRateShaper", "IngressBroadcastRat
 
 func resourceNsxtQosSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtQosSwitchingProfileCreate,
-		Read:   resourceNsxtQosSwitchingProfileRead,
-		Update: resourceNsxtQosSwitchingProfileUpdate,
-		Delete: resourceNsxtQosSwitchingProfileDelete,
+		CreateContext: resourceNsxtQosSwitchingProfileCreate,
+		ReadContext:   resourceNsxtQosSwitchingProfileRead,
+		UpdateContext: resourceNsxtQosSwitchingProfileUpdate,
+		DeleteContext: resourceNsxtQosSwitchingProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -173,15 +175,15 @@ func setQosRateShaperInSchema(d *schema.ResourceData, shaperConf []manager.QosBa
 
 }
 
-func resourceNsxtQosSwitchingProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	classOfService := int32(d.Get("class_of_service").(int))
 	dscpTrusted := "UNTRUSTED"
 	if d.Get("dscp_trusted").(bool) {
@@ -212,26 +214,26 @@ func resourceNsxtQosSwitchingProfileCreate(d *schema.ResourceData, m interface{}
 	qosSwitchingProfile, resp, err := nsxClient.LogicalSwitchingApi.CreateQosSwitchingProfile(nsxClient.Context, qosSwitchingProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during QosSwitchingProfile create: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during QosSwitchingProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during QosSwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(qosSwitchingProfile.Id)
 
-	return resourceNsxtQosSwitchingProfileRead(d, m)
+	return resourceNsxtQosSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtQosSwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	qosSwitchingProfile, resp, err := nsxClient.LogicalSwitchingApi.GetQosSwitchingProfile(nsxClient.Context, id)
@@ -241,13 +243,13 @@ func resourceNsxtQosSwitchingProfileRead(d *schema.ResourceData, m interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during QosSwitchingProfile read: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile read: %v", err)
 	}
 
 	d.Set("revision", qosSwitchingProfile.Revision)
 	d.Set("description", qosSwitchingProfile.Description)
 	d.Set("display_name", qosSwitchingProfile.DisplayName)
-	setTagsInSchema(d, qosSwitchingProfile.Tags)
+	setTagsInSchema(d, qosSwitchingProfile.Tags, m)
 	d.Set("class_of_service", qosSwitchingProfile.ClassOfService)
 	if qosSwitchingProfile.Dscp.Mode == "TRUSTED" {
 		d.Set("dscp_trusted", true)
@@ -262,20 +264,20 @@ func resourceNsxtQosSwitchingProfileRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNsxtQosSwitchingProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	classOfService := int32(d.Get("class_of_service").(int))
 	dscpTrusted := "UNTRUSTED"
@@ -307,26 +309,26 @@ func resourceNsxtQosSwitchingProfileUpdate(d *schema.ResourceData, m interface{}
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateQosSwitchingProfile(nsxClient.Context, id, qosSwitchingProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during QosSwitchingProfile update: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile update: %v", err)
 	}
 
-	return resourceNsxtQosSwitchingProfileRead(d, m)
+	return resourceNsxtQosSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtQosSwitchingProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteSwitchingProfile(nsxClient.Context, id, nil)
 	if err != nil {
-		return fmt.Errorf("Error during QosSwitchingProfile delete: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/common"
@@ -17,10 +19,10 @@ import (
 
 func resourceNsxtStaticRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtStaticRouteCreate,
-		Read:   resourceNsxtStaticRouteRead,
-		Update: resourceNsxtStaticRouteUpdate,
-		Delete: resourceNsxtStaticRouteDelete,
+		CreateContext: resourceNsxtStaticRouteCreate,
+		ReadContext:   resourceNsxtStaticRouteRead,
+		UpdateContext: resourceNsxtStaticRouteUpdate,
+		DeleteContext: resourceNsxtStaticRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtStaticRouteImport,
 		},
@@ -134,20 +136,20 @@ func setNextHopsInSchema(d *schema.ResourceData, nextHops []manager.StaticRouteN
 	return err
 }
 
-func resourceNsxtStaticRouteCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtStaticRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical router id during static route creation")
+		return diag.Errorf("Error obtaining logical router id during static route creation")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 	nextHops := getNextHopsFromSchema(d)
 	staticRoute := manager.StaticRoute{
@@ -162,31 +164,31 @@ func resourceNsxtStaticRouteCreate(d *schema.ResourceData, m interface{}) error
 	staticRoute, resp, err := nsxClient.LogicalRoutingAndServicesApi.AddStaticRoute(nsxClient.Context, logicalRouterID, staticRoute)
 
 	if err != nil {
-		return fmt.Errorf("Error during StaticRoute create on router %s: %v", logicalRouterID, err)
+		return diag.Errorf("Error during StaticRoute create on router %s: %v", logicalRouterID, err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during StaticRoute create on router %s: %v", logicalRouterID, resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during StaticRoute create on router %s: %v", logicalRouterID, resp.StatusCode)
 	}
 	d.SetId(staticRoute.Id)
 
-	return resourceNsxtStaticRouteRead(d, m)
+	return resourceNsxtStaticRouteRead(ctx, d, m)
 }
 
-func resourceNsxtStaticRouteRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtStaticRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical router id during static route read")
+		return diag.Errorf("Error obtaining logical router id during static route read")
 	}
 
 	staticRoute, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadStaticRoute(nsxClient.Context, logicalRouterID, id)
@@ -196,43 +198,43 @@ func resourceNsxtStaticRouteRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during StaticRoute read: %v", err)
+		return diag.Errorf("Error during StaticRoute read: %v", err)
 	}
 
 	d.Set("revision", staticRoute.Revision)
 	d.Set("description", staticRoute.Description)
 	d.Set("display_name", staticRoute.DisplayName)
-	setTagsInSchema(d, staticRoute.Tags)
+	setTagsInSchema(d, staticRoute.Tags, m)
 	d.Set("logical_router_id", staticRoute.LogicalRouterId)
 	d.Set("network", staticRoute.Network)
 	err = setNextHopsInSchema(d, staticRoute.NextHops)
 	if err != nil {
-		return fmt.Errorf("Error during StaticRoute set in schema: %v", err)
+		return diag.Errorf("Error during StaticRoute set in schema: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtStaticRouteUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical router id during static route update")
+		return diag.Errorf("Error obtaining logical router id during static route update")
 	}
 
 	revision := int64(d.Get("revision").(int))
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	network := d.Get("network").(string)
 	nextHops := getNextHopsFromSchema(d)
 	staticRoute := manager.StaticRoute{
@@ -248,31 +250,31 @@ func resourceNsxtStaticRouteUpdate(d *schema.ResourceData, m interface{}) error
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateStaticRoute(nsxClient.Context, logicalRouterID, id, staticRoute)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during StaticRoute update: %v", err)
+		return diag.Errorf("Error during StaticRoute update: %v", err)
 	}
 
-	return resourceNsxtStaticRouteRead(d, m)
+	return resourceNsxtStaticRouteRead(ctx, d, m)
 }
 
-func resourceNsxtStaticRouteDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtStaticRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical router id during static route deletion")
+		return diag.Errorf("Error obtaining logical router id during static route deletion")
 	}
 
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteStaticRoute(nsxClient.Context, logicalRouterID, id)
 	if err != nil {
-		return fmt.Errorf("Error during StaticRoute delete: %v", err)
+		return diag.Errorf("Error during StaticRoute delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
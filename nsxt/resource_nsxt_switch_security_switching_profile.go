@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -28,10 +29,10 @@ var switchSecurityProfileBpduMacs = []string{
 
 func resourceNsxtSwitchSecuritySwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtSwitchSecuritySwitchingProfileCreate,
-		Read:   resourceNsxtSwitchSecuritySwitchingProfileRead,
-		Update: resourceNsxtSwitchSecuritySwitchingProfileUpdate,
-		Delete: resourceNsxtSwitchSecuritySwitchingProfileDelete,
+		CreateContext: resourceNsxtSwitchSecuritySwitchingProfileCreate,
+		ReadContext:   resourceNsxtSwitchSecuritySwitchingProfileRead,
+		UpdateContext: resourceNsxtSwitchSecuritySwitchingProfileUpdate,
+		DeleteContext: resourceNsxtSwitchSecuritySwitchingProfileDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -167,15 +168,15 @@ func setSwitchSecurityRateLimitsInSchema(d *schema.ResourceData, rateLimitConf *
 	}
 }
 
-func resourceNsxtSwitchSecuritySwitchingProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSwitchSecuritySwitchingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	blockNonIP := d.Get("block_non_ip").(bool)
 	blockClientDHCP := d.Get("block_client_dhcp").(bool)
 	blockServerDHCP := d.Get("block_server_dhcp").(bool)
@@ -202,26 +203,26 @@ func resourceNsxtSwitchSecuritySwitchingProfileCreate(d *schema.ResourceData, m
 	switchSecurityProfile, resp, err := nsxClient.LogicalSwitchingApi.CreateSwitchSecuritySwitchingProfile(nsxClient.Context, switchSecurityProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during SwitchSecurityProfile create: %v", err)
+		return diag.Errorf("Error during SwitchSecurityProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during SwitchSecurityProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during SwitchSecurityProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(switchSecurityProfile.Id)
 
-	return resourceNsxtSwitchSecuritySwitchingProfileRead(d, m)
+	return resourceNsxtSwitchSecuritySwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtSwitchSecuritySwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSwitchSecuritySwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	switchSecurityProfile, resp, err := nsxClient.LogicalSwitchingApi.GetSwitchSecuritySwitchingProfile(nsxClient.Context, id)
@@ -231,13 +232,13 @@ func resourceNsxtSwitchSecuritySwitchingProfileRead(d *schema.ResourceData, m in
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during SwitchSecurityProfile read: %v", err)
+		return diag.Errorf("Error during SwitchSecurityProfile read: %v", err)
 	}
 
 	d.Set("revision", switchSecurityProfile.Revision)
 	d.Set("description", switchSecurityProfile.Description)
 	d.Set("display_name", switchSecurityProfile.DisplayName)
-	setTagsInSchema(d, switchSecurityProfile.Tags)
+	setTagsInSchema(d, switchSecurityProfile.Tags, m)
 	d.Set("block_non_ip", switchSecurityProfile.BlockNonIpTraffic)
 	if switchSecurityProfile.DhcpFilter != nil {
 		d.Set("block_client_dhcp", switchSecurityProfile.DhcpFilter.ClientBlockEnabled)
@@ -252,20 +253,20 @@ func resourceNsxtSwitchSecuritySwitchingProfileRead(d *schema.ResourceData, m in
 	return nil
 }
 
-func resourceNsxtSwitchSecuritySwitchingProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSwitchSecuritySwitchingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getTagsFromSchema(d)
+	tags := getTagsFromSchema(d, m)
 	revision := int64(d.Get("revision").(int))
 	blockNonIP := d.Get("block_non_ip").(bool)
 	blockClientDHCP := d.Get("block_client_dhcp").(bool)
@@ -294,26 +295,26 @@ func resourceNsxtSwitchSecuritySwitchingProfileUpdate(d *schema.ResourceData, m
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateSwitchSecuritySwitchingProfile(nsxClient.Context, id, switchSecurityProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during SwitchSecurityProfile update: %v", err)
+		return diag.Errorf("Error during SwitchSecurityProfile update: %v", err)
 	}
 
-	return resourceNsxtSwitchSecuritySwitchingProfileRead(d, m)
+	return resourceNsxtSwitchSecuritySwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtSwitchSecuritySwitchingProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSwitchSecuritySwitchingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return diag.FromErr(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteSwitchingProfile(nsxClient.Context, id, nil)
 	if err != nil {
-		return fmt.Errorf("Error during SwitchSecurityProfile delete: %v", err)
+		return diag.Errorf("Error during SwitchSecurityProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -84,10 +86,10 @@ var hostNodeOsTypeValues = []string{
 
 func resourceNsxtTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtTransportNodeCreate,
-		Read:   resourceNsxtTransportNodeRead,
-		Update: resourceNsxtTransportNodeUpdate,
-		Delete: resourceNsxtTransportNodeDelete,
+		CreateContext: resourceNsxtTransportNodeCreate,
+		ReadContext:   resourceNsxtTransportNodeRead,
+		UpdateContext: resourceNsxtTransportNodeUpdate,
+		DeleteContext: resourceNsxtTransportNodeDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -875,16 +877,16 @@ func getIPAssignmentSchema() *schema.Schema {
 	}
 }
 
-func getTransportNodeFromSchema(d *schema.ResourceData) (*model.TransportNode, error) {
+func getTransportNodeFromSchema(d *schema.ResourceData, m interface{}) (*model.TransportNode, error) {
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getMPTagsFromSchema(d)
+	tags := getMPTagsFromSchema(d, m)
 	failureDomain := d.Get("failure_domain").(string)
 	hostSwitchSpec, err := getHostSwitchSpecFromSchema(d)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Transport Node: %v", err)
 	}
-	nodeDeploymentInfo, err := getNodeDeploymentInfoFromSchema(d)
+	nodeDeploymentInfo, err := getNodeDeploymentInfoFromSchema(d, m)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Transport Node: %v", err)
 	}
@@ -905,24 +907,24 @@ func getTransportNodeFromSchema(d *schema.ResourceData) (*model.TransportNode, e
 	return &obj, nil
 }
 
-func resourceNsxtTransportNodeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtTransportNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := nsx.NewTransportNodesClient(connector)
 
-	obj, err := getTransportNodeFromSchema(d)
+	obj, err := getTransportNodeFromSchema(d, m)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating Transport Node with name %s", *obj.DisplayName)
 
 	obj1, err := client.Create(*obj)
 	if err != nil {
-		return handleCreateError("TransportNode", *obj.DisplayName, err)
+		return diag.FromErr(handleCreateError("TransportNode", *obj.DisplayName, err))
 	}
 
 	d.SetId(*obj1.Id)
-	return resourceNsxtTransportNodeRead(d, m)
+	return resourceNsxtTransportNodeRead(ctx, d, m)
 }
 
 func getRemoteTunnelEndpointFromSchema(d *schema.ResourceData) (*model.TransportNodeRemoteTunnelEndpointConfig, error) {
@@ -1052,7 +1054,7 @@ func getEdgeNodeDeploymentConfigFromSchema(cfg interface{}) (*model.EdgeNodeDepl
 	return nil, nil
 }
 
-func getNodeDeploymentInfoFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+func getNodeDeploymentInfoFromSchema(d *schema.ResourceData, m interface{}) (*data.StructValue, error) {
 	converter := bindings.NewTypeConverter()
 	var dataValue data.DataValue
 	var errs []error
@@ -1071,7 +1073,7 @@ func getNodeDeploymentInfoFromSchema(d *schema.ResourceData) (*data.StructValue,
 				if err != nil {
 					return nil, err
 				}
-				nodeSettings, err := getEdgeNodeSettingsFromSchema(nodeInfo["node_settings"])
+				nodeSettings, err := getEdgeNodeSettingsFromSchema(nodeInfo["node_settings"], m)
 				if err != nil {
 					return nil, err
 				}
@@ -1131,7 +1133,7 @@ func getNodeDeploymentInfoFromSchema(d *schema.ResourceData) (*data.StructValue,
 				if err != nil {
 					return nil, err
 				}
-				nodeSettings, err := getEdgeNodeSettingsFromSchema(nodeInfo["node_settings"])
+				nodeSettings, err := getEdgeNodeSettingsFromSchema(nodeInfo["node_settings"], m)
 				if err != nil {
 					return nil, err
 				}
@@ -1157,7 +1159,7 @@ func getNodeDeploymentInfoFromSchema(d *schema.ResourceData) (*data.StructValue,
 	return nil, nil
 }
 
-func getEdgeNodeSettingsFromSchema(s interface{}) (*model.EdgeNodeSettings, error) {
+func getEdgeNodeSettingsFromSchema(s interface{}, m interface{}) (*model.EdgeNodeSettings, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -1204,7 +1206,7 @@ func getEdgeNodeSettingsFromSchema(s interface{}) (*model.EdgeNodeSettings, erro
 			SearchDomains:         searchDomains,
 			SyslogServers:         syslogServers,
 		}
-		if nsxVersionHigherOrEqual("4.0.0") {
+		if nsxVersionHigherOrEqual(m, "4.0.0") {
 			obj.EnableUptMode = &enableUptMode
 		}
 		return obj, nil
@@ -1541,31 +1543,31 @@ func getTransportNodeSubProfileCfg(iface interface{}) []model.TransportNodeProfi
 	return cfgList
 }
 
-func resourceNsxtTransportNodeRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtTransportNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewTransportNodesClient(connector)
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "TransportNode", id, err)
+		return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 	}
 
 	d.Set("revision", obj.Revision)
 	d.Set("description", obj.Description)
 	d.Set("display_name", obj.DisplayName)
-	setMPTagsInSchema(d, obj.Tags)
+	setMPTagsInSchema(d, obj.Tags, m)
 	d.Set("failure_domain", obj.FailureDomainId)
 	err = setHostSwitchSpecInSchema(d, obj.HostSwitchSpec)
 	if err != nil {
-		return handleReadError(d, "TransportNode", id, err)
+		return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 	}
 	err = setNodeDeploymentInfoInSchema(d, obj.NodeDeploymentInfo)
 	if err != nil {
-		return handleReadError(d, "TransportNode", id, err)
+		return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 	}
 
 	if obj.RemoteTunnelEndpoint != nil {
@@ -1573,7 +1575,7 @@ func resourceNsxtTransportNodeRead(d *schema.ResourceData, m interface{}) error
 		rtep["host_switch_name"] = obj.RemoteTunnelEndpoint.HostSwitchName
 		rtep["ip_assignment"], err = setIPAssignmentInSchema(obj.RemoteTunnelEndpoint.IpAssignmentSpec)
 		if err != nil {
-			return handleReadError(d, "TransportNode", id, err)
+			return diag.FromErr(handleReadError(d, "TransportNode", id, err))
 		}
 		rtep["named_teaming_policy"] = obj.RemoteTunnelEndpoint.NamedTeamingPolicy
 		rtep["rtep_vlan"] = obj.RemoteTunnelEndpoint.RtepVlan
@@ -1980,43 +1982,43 @@ func setHostSwitchProfileIDsInSchema(hspIDs []model.HostSwitchProfileTypeIdEntry
 	return hostSwitchProfileIDs
 }
 
-func resourceNsxtTransportNodeUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtTransportNodeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewTransportNodesClient(connector)
 
-	obj, err := getTransportNodeFromSchema(d)
+	obj, err := getTransportNodeFromSchema(d, m)
 	if err != nil {
-		return handleUpdateError("TransportNode", id, err)
+		return diag.FromErr(handleUpdateError("TransportNode", id, err))
 	}
 	revision := int64(d.Get("revision").(int))
 	obj.Revision = &revision
 
 	_, err = client.Update(id, *obj, nil, nil, nil, nil, nil, nil, nil)
 	if err != nil {
-		return handleUpdateError("TransportNode", id, err)
+		return diag.FromErr(handleUpdateError("TransportNode", id, err))
 	}
 
-	return resourceNsxtTransportNodeRead(d, m)
+	return resourceNsxtTransportNodeRead(ctx, d, m)
 }
 
-func resourceNsxtTransportNodeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtTransportNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("error obtaining logical object id")
+		return diag.Errorf("error obtaining logical object id")
 	}
 
 	client := nsx.NewTransportNodesClient(connector)
 
 	err := client.Delete(id, nil, nil)
 	if err != nil {
-		return handleDeleteError("TransportNode", id, err)
+		return diag.FromErr(handleDeleteError("TransportNode", id, err))
 	}
 	return nil
 }
@@ -481,8 +481,8 @@ func getDhcpOptsFromMap(dhcpConfig map[string]interface{}) *model.DhcpV4Options
 
 }
 
-func getSegmentSubnetDhcpConfigFromSchema(schemaConfig map[string]interface{}) (*data.StructValue, error) {
-	if nsxVersionLower("3.0.0") {
+func getSegmentSubnetDhcpConfigFromSchema(schemaConfig map[string]interface{}, m interface{}) (*data.StructValue, error) {
+	if nsxVersionLower(m, "3.0.0") {
 		return nil, nil
 	}
 
@@ -683,13 +683,13 @@ func nsxtPolicySegmentAddGatewayToInfraStruct(d *schema.ResourceData, dataValue
 	return dataValue1.(*data.StructValue), nil
 }
 
-func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d *schema.ResourceData, isVlan bool, isFixed bool) (model.Infra, error) {
+func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d *schema.ResourceData, isVlan bool, isFixed bool, m interface{}) (model.Infra, error) {
 	// Read the rest of the configured parameters
 	var infraChildren []*data.StructValue
 
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+	tags := getPolicyTagsFromSchema(d, m)
 	domainName := d.Get("domain_name").(string)
 	tzPath := d.Get("transport_zone_path").(string)
 	replicationMode := d.Get("replication_mode").(string)
@@ -718,7 +718,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 	if tzPath != "" {
 		obj.TransportZonePath = &tzPath
 	}
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		obj.ReplicationMode = &replicationMode
 		if dhcpConfigPath != "" {
 			obj.DhcpConfigPath = &dhcpConfigPath
@@ -766,7 +766,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 				subnetStruct.GatewayAddress = &gwAddr
 			}
 
-			config, err := getSegmentSubnetDhcpConfigFromSchema(subnetMap)
+			config, err := getSegmentSubnetDhcpConfigFromSchema(subnetMap, m)
 			if err != nil {
 				return model.Infra{}, err
 			}
@@ -798,7 +798,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 			advConfigStruct.Connectivity = &connectivity
 		}
 
-		if nsxVersionHigherOrEqual("3.0.0") {
+		if nsxVersionHigherOrEqual(m, "3.0.0") {
 			teamingPolicy := advConfigMap["uplink_teaming_policy"].(string)
 			if teamingPolicy != "" {
 				advConfigStruct.UplinkTeamingPolicyName = &teamingPolicy
@@ -809,7 +809,7 @@ func policySegmentResourceToInfraStruct(context utl.SessionContext, id string, d
 				advConfigStruct.AddressPoolPaths = append(advConfigStruct.AddressPoolPaths, poolPath)
 			}
 
-			if nsxVersionHigherOrEqual("3.1.0") {
+			if nsxVersionHigherOrEqual(m, "3.1.0") {
 				urpfMode := advConfigMap["urpf_mode"].(string)
 				advConfigStruct.UrpfMode = &urpfMode
 			}
@@ -1339,7 +1339,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
+	setPolicyTagsInSchema(d, obj.Tags, m)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("revision", obj.Revision)
@@ -1359,7 +1359,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		}
 	}
 
-	if nsxVersionHigherOrEqual("3.0.0") {
+	if nsxVersionHigherOrEqual(m, "3.0.0") {
 		d.Set("replication_mode", obj.ReplicationMode)
 	}
 
@@ -1378,7 +1378,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		if obj.AdvancedConfig.UrpfMode != nil {
 			advConfig["urpf_mode"] = *obj.AdvancedConfig.UrpfMode
 		} else {
-			if nsxVersionLower("3.1.0") {
+			if nsxVersionLower(m, "3.1.0") {
 				// set to default in early versions
 				advConfig["urpf_mode"] = model.SegmentAdvancedConfig_URPF_MODE_STRICT
 			}
@@ -1444,7 +1444,7 @@ func nsxtPolicySegmentCreate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return err
 	}
 
-	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed)
+	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed, m)
 	if err != nil {
 		return err
 	}
@@ -1467,7 +1467,7 @@ func nsxtPolicySegmentUpdate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return fmt.Errorf("Error obtaining Segment ID")
 	}
 
-	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed)
+	obj, err := policySegmentResourceToInfraStruct(getSessionContext(d, m), id, d, isVlan, isFixed, m)
 	if err != nil {
 		return err
 	}
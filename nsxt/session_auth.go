@@ -0,0 +1,214 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const sessionCreatePath = "/api/session/create"
+
+var sessionCookieRegexp = regexp.MustCompile("JSESSIONID=.*?;")
+
+// nsxtSession holds NSX session headers shared by policy connector and
+// MP client. The session is re-created on demand when NSX reports that
+// it is expired.
+type nsxtSession struct {
+	lock       sync.Mutex
+	cookie     string
+	xsrf       string
+	generation int
+	username   string
+	password   string
+	remoteAuth bool
+	transport  http.RoundTripper
+}
+
+func newNsxtSession(username string, password string, remoteAuth bool, transport http.RoundTripper) *nsxtSession {
+	return &nsxtSession{
+		username:   username,
+		password:   password,
+		remoteAuth: remoteAuth,
+		transport:  transport,
+	}
+}
+
+func (session *nsxtSession) setHeaders(cookie string, xsrf string) {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	session.cookie = cookie
+	session.xsrf = xsrf
+	session.generation++
+}
+
+func (session *nsxtSession) getHeaders() (string, string, int) {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	return session.cookie, session.xsrf, session.generation
+}
+
+// Re-create the session, unless this was already done by concurrent request
+// since the failed request was issued
+func (session *nsxtSession) renew(reqURL *url.URL, failedGeneration int) error {
+	session.lock.Lock()
+	defer session.lock.Unlock()
+
+	if session.generation != failedGeneration {
+		return nil
+	}
+
+	cookie, xsrf, err := session.create(reqURL)
+	if err != nil {
+		return err
+	}
+	session.cookie = cookie
+	session.xsrf = xsrf
+	session.generation++
+	log.Printf("[INFO] NSX session was re-created")
+	return nil
+}
+
+func (session *nsxtSession) create(reqURL *url.URL) (string, string, error) {
+	sessionURL := fmt.Sprintf("%s://%s%s", reqURL.Scheme, reqURL.Host, sessionCreatePath)
+	form := url.Values{}
+	form.Set("j_username", session.username)
+	form.Set("j_password", session.password)
+	req, err := http.NewRequest(http.MethodPost, sessionURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if session.remoteAuth {
+		auth := base64.StdEncoding.EncodeToString([]byte(session.username + ":" + session.password))
+		req.Header.Set("Authorization", "Remote "+auth)
+	}
+
+	resp, err := session.transport.RoundTrip(req)
+	if err != nil {
+		return "", "", fmt.Errorf("Failed to create session: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("Failed to create session: status code %d", resp.StatusCode)
+	}
+
+	cookie := ""
+	for _, value := range resp.Header.Values("Set-Cookie") {
+		if result := sessionCookieRegexp.FindString(value); result != "" {
+			cookie = result
+		}
+	}
+	return cookie, resp.Header.Get("X-XSRF-TOKEN"), nil
+}
+
+// sessionAuthTransport injects current session headers into NSX requests, and
+// transparently re-authenticates and retries the request if session has expired
+type sessionAuthTransport struct {
+	transport http.RoundTripper
+	session   *nsxtSession
+}
+
+func newSessionAuthTransport(transport http.RoundTripper, session *nsxtSession) *sessionAuthTransport {
+	return &sessionAuthTransport{
+		transport: transport,
+		session:   session,
+	}
+}
+
+// NSX error codes that indicate invalid session rather than lack of permissions
+var sessionExpiredErrorCodes = map[int]bool{
+	98:  true, // XSRF token is missing or invalid
+	403: true, // The credentials were incorrect or the session has expired
+}
+
+// Returns true if the response indicates that session has expired. Forbidden
+// response is only considered as such if NSX error code refers to the session,
+// since otherwise it reports missing permissions.
+func isSessionExpiredResponse(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if resp.StatusCode != http.StatusForbidden || resp.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	var nsxError struct {
+		ErrorCode int `json:"error_code"`
+	}
+	if err := json.Unmarshal(body, &nsxError); err != nil {
+		return false
+	}
+	return sessionExpiredErrorCodes[nsxError.ErrorCode]
+}
+
+func (t *sessionAuthTransport) roundTripWithSession(req *http.Request, body []byte) (*http.Response, int, error) {
+	cookie, xsrf, generation := t.session.getHeaders()
+	sessionReq := req.Clone(req.Context())
+	if body != nil {
+		sessionReq.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if cookie != "" {
+		sessionReq.Header.Set("Cookie", cookie)
+		sessionReq.Header.Set("X-XSRF-TOKEN", xsrf)
+	}
+	resp, err := t.transport.RoundTrip(sessionReq)
+	return resp, generation, err
+}
+
+func (t *sessionAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, sessionCreatePath) {
+		return t.transport.RoundTrip(req)
+	}
+
+	// Keep the body in order to be able to re-issue the request
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, generation, err := t.roundTripWithSession(req, body)
+	if err != nil || !isSessionExpiredResponse(resp) {
+		return resp, err
+	}
+
+	// Release the failed response before re-creating the session, since
+	// open response may hold resources of underlying transports
+	failedBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(failedBody))
+
+	log.Printf("[DEBUG] NSX responded with status %d, re-creating session", resp.StatusCode)
+	if err := t.session.renew(req.URL, generation); err != nil {
+		log.Printf("[WARNING] Failed to re-create NSX session: %v", err)
+		return resp, nil
+	}
+
+	resp, _, err = t.roundTripWithSession(req, body)
+	return resp, err
+}
@@ -0,0 +1,58 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+func TestMockNsxSessionRenewal(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+	nsxClient := getPolicyConnector(m)
+	mpClient := m.(nsxtClients).NsxtClient
+	if server.sessionCount() != 1 {
+		t.Fatalf("Expected single session to be created, got %d", server.sessionCount())
+	}
+
+	lswitch, _, err := mpClient.LogicalSwitchingApi.CreateLogicalSwitch(mpClient.Context, manager.LogicalSwitch{DisplayName: "mock-switch"})
+	if err != nil {
+		t.Fatalf("Failed to create logical switch: %v", err)
+	}
+
+	server.expireSessions()
+	if _, err := infra.NewDomainsClient(nsxClient).Get("default"); err != nil {
+		t.Fatalf("Policy request failed after session expiry: %v", err)
+	}
+
+	server.expireSessions()
+	if _, _, err := mpClient.LogicalSwitchingApi.GetLogicalSwitch(mpClient.Context, lswitch.Id); err != nil {
+		t.Fatalf("MP request failed after session expiry: %v", err)
+	}
+	if server.sessionCount() != 1 {
+		t.Fatalf("Expected single session after renewal, got %d", server.sessionCount())
+	}
+}
+
+func TestMockNsxSessionPermissionDenied(t *testing.T) {
+	server := newMockNsxServer()
+	defer server.Close()
+	m := testMockGetProviderMeta(t, server)
+
+	server.denyAccess(mockPolicyPrefix + "/infra/domains/default")
+	requests, _ := server.requestStats()
+	if _, err := infra.NewDomainsClient(getPolicyConnector(m)).Get("default"); err == nil {
+		t.Fatalf("Expected request to be denied")
+	}
+	if after, _ := server.requestStats(); after != requests+1 {
+		t.Fatalf("Expected denied request not to be retried, got %d requests", after-requests)
+	}
+	if server.sessionCount() != 1 {
+		t.Fatalf("Expected session not to be re-created on permission error, got %d sessions", server.sessionCount())
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +23,6 @@ import (
 )
 
 var adminStateValues = []string{"UP", "DOWN"}
-var nsxVersion = ""
 
 func interface2StringList(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
@@ -169,12 +169,12 @@ func setCustomizedTagsInSchema(d *schema.ResourceData, tags []common.Tag, schema
 	}
 }
 
-func getTagsFromSchema(d *schema.ResourceData) []common.Tag {
-	return getCustomizedTagsFromSchema(d, "tag")
+func getTagsFromSchema(d *schema.ResourceData, m interface{}) []common.Tag {
+	return getAllTagsFromSchema(d, m)
 }
 
-func setTagsInSchema(d *schema.ResourceData, tags []common.Tag) {
-	setCustomizedTagsInSchema(d, tags, "tag")
+func setTagsInSchema(d *schema.ResourceData, tags []common.Tag, m interface{}) {
+	setAllTagsInSchema(d, tags, m)
 }
 
 // utilities to define & handle switching profiles
@@ -561,17 +561,48 @@ func getNSXVersion(connector client.Connector) (string, error) {
 	return *version.NodeVersion, nil
 }
 
-func initNSXVersion(connector client.Connector) error {
-	var err error
-	nsxVersion, err = getNSXVersion(connector)
-	return err
+// NSX version is discovered during provider configuration, or on demand with
+// on_demand_connection. Since nsxtClients is passed around by value, version is
+// kept behind a pointer in order to be shared by all copies of provider meta.
+type nsxtVersionInfo struct {
+	lock    sync.RWMutex
+	version string
+}
+
+func getProviderNSXVersion(clients interface{}) string {
+	c, ok := clients.(nsxtClients)
+	if !ok || c.NsxVersion == nil {
+		return ""
+	}
+	c.NsxVersion.lock.RLock()
+	defer c.NsxVersion.lock.RUnlock()
+	return c.NsxVersion.version
+}
+
+func setProviderNSXVersion(clients interface{}, version string) {
+	c, ok := clients.(nsxtClients)
+	if !ok || c.NsxVersion == nil {
+		return
+	}
+	c.NsxVersion.lock.Lock()
+	defer c.NsxVersion.lock.Unlock()
+	c.NsxVersion.version = version
+}
+
+func initNSXVersion(clients interface{}, connector client.Connector) error {
+	version, err := getNSXVersion(connector)
+	if err != nil {
+		return err
+	}
+	setProviderNSXVersion(clients, version)
+	return nil
 }
 
 func initNSXVersionVMC(clients interface{}) {
 	// TODO: find a ireliable way to retrieve NSX version on VMC
 	// For now, we need to determine whether the deployment is 3.0.0 and up, or below
 	// For this purpose, we fire indicator search API (introduced in 3.0.0)
-	nsxVersion = "3.0.0"
+	setProviderNSXVersion(clients, "3.0.0")
 
 	connector := getPolicyConnector(clients)
 	client := search.NewQueryClient(connector)
@@ -587,7 +618,7 @@ func initNSXVersionVMC(clients interface{}) {
 	if isNotFoundError(err) {
 		// search API not supported
 		log.Printf("[INFO] Assuming NSX version < 3.0.0 in VMC environment")
-		nsxVersion = "2.5.0"
+		setProviderNSXVersion(clients, "2.5.0")
 		return
 	}
 
@@ -595,10 +626,18 @@ func initNSXVersionVMC(clients interface{}) {
 	log.Printf("[ERROR] Failed to determine NSX version in VMC environment: %s", err)
 }
 
-func nsxVersionLower(ver string) bool {
+func nsxVersionLower(clients interface{}, ver string) bool {
+	return versionLower(getProviderNSXVersion(clients), ver)
+}
+
+func nsxVersionHigherOrEqual(clients interface{}, ver string) bool {
+	return versionHigherOrEqual(getProviderNSXVersion(clients), ver)
+}
+
+func versionLower(current string, ver string) bool {
 
 	requestedVersion, err1 := version.NewVersion(ver)
-	currentVersion, err2 := version.NewVersion(nsxVersion)
+	currentVersion, err2 := version.NewVersion(current)
 	if err1 != nil || err2 != nil {
 		log.Printf("[ERROR] Failed perform version check for version %s", ver)
 		return true
@@ -606,10 +645,10 @@ func nsxVersionLower(ver string) bool {
 	return currentVersion.LessThan(requestedVersion)
 }
 
-func nsxVersionHigherOrEqual(ver string) bool {
+func versionHigherOrEqual(current string, ver string) bool {
 
 	requestedVersion, err1 := version.NewVersion(ver)
-	currentVersion, err2 := version.NewVersion(nsxVersion)
+	currentVersion, err2 := version.NewVersion(current)
 	if err1 != nil || err2 != nil {
 		log.Printf("[ERROR] Failed perform version check for version %s", ver)
 		return false
@@ -698,6 +737,12 @@ func getContextSchema() *schema.Schema {
 					ForceNew:     true,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
+				"org_id": {
+					Type:        schema.TypeString,
+					Description: "Id of the organization which the project belongs to. Default organization is used if not specified.",
+					Optional:    true,
+					ForceNew:    true,
+				},
 			},
 		},
 	}
@@ -733,10 +778,27 @@ func setCustomizedMPTagsInSchema(d *schema.ResourceData, tags []mp_model.Tag, sc
 	}
 }
 
-func getMPTagsFromSchema(d *schema.ResourceData) []mp_model.Tag {
-	return getCustomizedMPTagsFromSchema(d, "tag")
+func getMPTagsFromSchema(d *schema.ResourceData, m interface{}) []mp_model.Tag {
+	tagList := make([]mp_model.Tag, 0)
+	for _, tag := range getAllTagsFromSchema(d, m) {
+		scope := tag.Scope
+		tag := tag.Tag
+		tagList = append(tagList, mp_model.Tag{Scope: &scope, Tag: &tag})
+	}
+	return tagList
 }
 
-func setMPTagsInSchema(d *schema.ResourceData, tags []mp_model.Tag) {
-	setCustomizedMPTagsInSchema(d, tags, "tag")
+func setMPTagsInSchema(d *schema.ResourceData, tags []mp_model.Tag, m interface{}) {
+	var tagList []common.Tag
+	for _, tag := range tags {
+		elem := common.Tag{}
+		if tag.Scope != nil {
+			elem.Scope = *tag.Scope
+		}
+		if tag.Tag != nil {
+			elem.Tag = *tag.Tag
+		}
+		tagList = append(tagList, elem)
+	}
+	setAllTagsInSchema(d, tagList, m)
 }
@@ -64,32 +64,37 @@ const doubleTags string = `
     tag   = "tag2"
   }`
 
-var randomized = false
+var testRand *rand.Rand
 var letterRunes = []rune("abcdefghijklmnopqrstuvwxyz")
 
+// Test object names end up in NSX API requests, hence random seed is kept in
+// cassette, so that replayed tests generate same names as recorded ones
 func initRand() {
-	if randomized {
+	if testRand != nil {
 		return
 	}
-	rand.Seed(time.Now().UnixNano())
-	randomized = true
+	seed := time.Now().UnixNano()
+	if cassette, _ := getHTTPCassette(); cassette != nil {
+		seed = cassette.getRandomSeed(seed)
+	}
+	testRand = rand.New(rand.NewSource(seed))
 }
 
 func getAccTestDataSourceName() string {
 	initRand()
-	return fmt.Sprintf("%s-%d", testAccDataSourceName, rand.Intn(100000))
+	return fmt.Sprintf("%s-%d", testAccDataSourceName, testRand.Intn(100000))
 }
 
 func getAccTestResourceName() string {
 	initRand()
-	return fmt.Sprintf("%s-%d", testAccResourceName, rand.Intn(100000))
+	return fmt.Sprintf("%s-%d", testAccResourceName, testRand.Intn(100000))
 }
 
 func getAccTestRandomString(length int) string {
 	initRand()
 	b := make([]rune, length)
 	for i := range b {
-		b[i] = letterRunes[rand.Intn(length)]
+		b[i] = letterRunes[testRand.Intn(length)]
 	}
 	return string(b)
 }
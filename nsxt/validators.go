@@ -394,6 +394,23 @@ func validatePolicyPath() schema.SchemaValidateFunc {
 	}
 }
 
+// Validates path of Tier0 or Tier1 gateway, or of an object under gateway, such as interface
+func validatePolicyGatewayScopePath() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if !isPolicyPath(v) || (!strings.Contains(v, "/tier-0s/") && !strings.Contains(v, "/tier-1s/")) {
+			es = append(es, fmt.Errorf("expected %s to contain a Tier0 or Tier1 gateway path. Got: %s", k, v))
+		}
+
+		return
+	}
+}
+
 func validateVLANId(i interface{}, k string) (s []string, es []error) {
 	var vlan int
 	vlan, ok := i.(int)
@@ -464,13 +481,19 @@ func validateNsxtProviderHostFormat() schema.SchemaValidateFunc {
 			return
 		}
 
-		withSchema := v
-		if !strings.HasPrefix(v, "https://") {
-			// Add schema for validation
-			withSchema = fmt.Sprintf("https://%s", v)
-		}
+		// Multiple managers can be specified as comma-separated list
+		for _, host := range strings.Split(v, ",") {
+			host = strings.TrimSpace(host)
+			withSchema := host
+			if !strings.HasPrefix(host, "https://") {
+				// Add schema for validation
+				withSchema = fmt.Sprintf("https://%s", host)
+			}
 
-		s, es = validation.IsURLWithHTTPS(withSchema, k)
+			hostWarnings, hostErrors := validation.IsURLWithHTTPS(withSchema, k)
+			s = append(s, hostWarnings...)
+			es = append(es, hostErrors...)
+		}
 		return
 	}
 }
@@ -75,7 +75,7 @@ def api_func_call_setup(api, subs_dict):
     g = parse_api_call(subs_dict['func_def'])
     arg_list = get_arglist(g[2])
     if subs_dict['type'] == "Multitenancy":
-        arg_list = ['utl.DefaultOrgID', 'c.ProjectID'] + arg_list
+        arg_list = ['c.OrgID', 'c.ProjectID'] + arg_list
     return '%s(%s)' % (g[1], ', '.join(arg_list))
 
 
@@ -87,7 +87,7 @@ def patch_func_call_setup(api, subs_dict):
             if arg_list[n] == subs_dict['var_name']:
                 arg_list[n] = 'gmObj.(%s.%s)' % (subs_dict['model_import'], subs_dict['model_name'])
     elif subs_dict['type'] == "Multitenancy":
-        arg_list = ['utl.DefaultOrgID', 'c.ProjectID'] + arg_list
+        arg_list = ['c.OrgID', 'c.ProjectID'] + arg_list
     return '%s(%s)' % (g[1], ', '.join(arg_list))
 
 